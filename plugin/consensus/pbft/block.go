@@ -24,13 +24,13 @@ type Client struct {
 	*drivers.BaseClient
 	replyChan   chan *types.ClientReply
 	requestChan chan *types.Request
-	isPrimary   bool
+	replica     *Replica
 }
 
 // NewBlockstore create Pbft Client
-func NewBlockstore(cfg *types.Consensus, replyChan chan *types.ClientReply, requestChan chan *types.Request, isPrimary bool) *Client {
+func NewBlockstore(cfg *types.Consensus, replica *Replica) *Client {
 	c := drivers.NewBaseClient(cfg)
-	client := &Client{BaseClient: c, replyChan: replyChan, requestChan: requestChan, isPrimary: isPrimary}
+	client := &Client{BaseClient: c, replyChan: replica.replyChan, requestChan: replica.requestChan, replica: replica}
 	c.SetChild(client)
 	return client
 }
@@ -67,14 +67,16 @@ func (client *Client) SetQueueClient(c queue.Client) {
 // CreateBlock method
 func (client *Client) CreateBlock() {
 	issleep := true
-	if !client.isPrimary {
-		return
-	}
 	cfg := client.GetQueueClient().GetConfig()
 	for {
 		if issleep {
 			time.Sleep(10 * time.Second)
 		}
+		// a backup starts creating blocks once it becomes the primary after view change
+		if !client.replica.IsPrimary() {
+			issleep = true
+			continue
+		}
 		plog.Info("=============start get tx===============")
 		lastBlock := client.GetCurrentBlock()
 		txs := client.RequestTx(int(cfg.GetP(lastBlock.Height+1).MaxTxNumber), nil)
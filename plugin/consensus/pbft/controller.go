@@ -6,6 +6,7 @@ package pbft
 
 import (
 	"strings"
+	"time"
 
 	log "github.com/33cn/chain33/common/log/log15"
 	"github.com/33cn/chain33/queue"
@@ -25,6 +26,8 @@ type subConfig struct {
 	NodeID           int64  `json:"nodeID"`
 	PeersURL         string `json:"peersURL"`
 	ClientAddr       string `json:"clientAddr"`
+	// ViewChangeTimeout request timeout in seconds before a backup starts a view change
+	ViewChangeTimeout int64 `json:"viewChangeTimeout"`
 }
 
 // NewPbft create pbft cluster
@@ -48,7 +51,8 @@ func NewPbft(cfg *pb.Consensus, sub []byte) queue.Module {
 	clientAddr = subcfg.ClientAddr
 
 	var c *Client
-	replyChan, requestChan, isPrimary := NewReplica(uint32(subcfg.NodeID), subcfg.PeersURL, subcfg.ClientAddr)
-	c = NewBlockstore(cfg, replyChan, requestChan, isPrimary)
+	timeout := time.Duration(subcfg.ViewChangeTimeout) * time.Second
+	replica := NewReplica(uint32(subcfg.NodeID), subcfg.PeersURL, subcfg.ClientAddr, timeout)
+	c = NewBlockstore(cfg, replica)
 	return c
 }
@@ -8,6 +8,8 @@ import (
 	"errors"
 	"net"
 	"strings"
+	"sync"
+	"time"
 
 	pb "github.com/33cn/chain33/types"
 	"github.com/golang/protobuf/proto"
@@ -17,6 +19,8 @@ import (
 const (
 	CheckPointPeriod uint32 = 128
 	ConstantFactor   uint32 = 2
+	// DefaultViewChangeTimeout request timeout before a backup starts a view change
+	DefaultViewChangeTimeout = 30 * time.Second
 )
 
 // Replica struct
@@ -36,36 +40,55 @@ type Replica struct {
 	pendingVC   []*pb.Request
 	executed    []uint32
 	checkpoints []*pb.Checkpoint
+
+	// requests are handled one by one, the timers share the same lock
+	mtx     sync.Mutex
+	ln      net.Listener
+	stopped bool
+	// the request timer, restarted as the new-view timer during a view change
+	timeout        time.Duration
+	vcTimer        *time.Timer
+	timerSeq       uint64
+	lastActiveView uint32
+	// digests of the client requests received but not executed yet
+	waiting map[string]bool
 }
 
 // NewReplica create Replica instance
-func NewReplica(id uint32, PeersURL string, addr string) (chan *pb.ClientReply, chan *pb.Request, bool) {
-	replyChan := make(chan *pb.ClientReply)
-	requestChan := make(chan *pb.Request)
+func NewReplica(id uint32, PeersURL string, addr string, timeout time.Duration) *Replica {
+	pn := newReplica(id, PeersURL, timeout)
+	pn.Startnode(addr)
+	return pn
+}
+
+func newReplica(id uint32, PeersURL string, timeout time.Duration) *Replica {
+	if timeout <= 0 {
+		timeout = DefaultViewChangeTimeout
+	}
 	pn := &Replica{
-		ID:          id,
-		replicas:    make(map[uint32]string),
-		activeView:  true,
-		view:        1,
-		sequence:    0,
-		requestChan: requestChan,
-		replyChan:   replyChan,
-		errChan:     make(chan error),
-		requests:    make(map[string][]*pb.Request),
-		replies:     make(map[string][]*pb.ClientReply),
-		doneChan:    make(chan string),
-		lastReply:   nil,
-		pendingVC:   make([]*pb.Request, 10),
-		executed:    make([]uint32, 10),
+		ID:             id,
+		replicas:       make(map[uint32]string),
+		activeView:     true,
+		view:           1,
+		sequence:       0,
+		requestChan:    make(chan *pb.Request),
+		replyChan:      make(chan *pb.ClientReply),
+		errChan:        make(chan error),
+		requests:       make(map[string][]*pb.Request),
+		replies:        make(map[string][]*pb.ClientReply),
+		doneChan:       make(chan string),
+		lastReply:      nil,
+		executed:       make([]uint32, 10),
+		timeout:        timeout,
+		lastActiveView: 1,
+		waiting:        make(map[string]bool),
 	}
 	peers := strings.Split(PeersURL, ",")
 	for num, peer := range peers {
 		pn.replicas[uint32(num)] = peer
 	}
 	pn.checkpoints = []*pb.Checkpoint{ToCheckpoint(0, []byte(""))}
-	pn.Startnode(addr)
-	return replyChan, requestChan, pn.isPrimary(pn.ID)
-
+	return pn
 }
 
 // Startnode method
@@ -73,14 +96,49 @@ func (rep *Replica) Startnode(addr string) {
 	rep.acceptConnections(addr)
 }
 
+// Stop close the listener and drop all the messages, the replica behaves as crashed
+func (rep *Replica) Stop() {
+	rep.mtx.Lock()
+	defer rep.mtx.Unlock()
+	rep.stopped = true
+	rep.stopTimer()
+	if rep.ln != nil {
+		rep.ln.Close()
+	}
+}
+
+func (rep *Replica) isStopped() bool {
+	rep.mtx.Lock()
+	defer rep.mtx.Unlock()
+	return rep.stopped
+}
+
+// IsPrimary whether the replica is the primary of an active view
+func (rep *Replica) IsPrimary() bool {
+	rep.mtx.Lock()
+	defer rep.mtx.Unlock()
+	return rep.activeView && rep.isPrimary(rep.ID)
+}
+
 // Basic operations
 
+// replica IDs start from 1, replicas[ID-1] is the address of replica ID
+func (rep *Replica) replicaAddr(ID uint32) string {
+	if ID == 0 {
+		return ""
+	}
+	return rep.replicas[ID-1]
+}
+
 func (rep *Replica) primary() uint32 {
-	return rep.view % uint32(len(rep.replicas)+1)
+	return rep.newPrimary(rep.view)
 }
 
 func (rep *Replica) newPrimary(view uint32) uint32 {
-	return view % uint32(len(rep.replicas)+1)
+	if view == 0 {
+		return 0
+	}
+	return (view-1)%uint32(len(rep.replicas)) + 1
 }
 
 func (rep *Replica) isPrimary(ID uint32) bool {
@@ -119,6 +177,29 @@ func (rep *Replica) lastExecuted() uint32 {
 	return rep.executed[len(rep.executed)-1]
 }
 
+func (rep *Replica) hasExecuted(sequence uint32) bool {
+	for _, s := range rep.executed {
+		if s == sequence && s != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// prepared whether 2f+1 replicas prepared the request in the view
+func (rep *Replica) prepared(view, sequence uint32, digest []byte) bool {
+	replicas := make(map[uint32]bool)
+	for _, req := range rep.requests["prepare"] {
+		v := req.GetPrepare().View
+		s := req.GetPrepare().Sequence
+		d := req.GetPrepare().Digest
+		if v == view && s == sequence && EQ(d, digest) {
+			replicas[req.GetPrepare().Replica] = true
+		}
+	}
+	return rep.overTwoThirds(len(replicas))
+}
+
 func (rep *Replica) lastStable() *pb.Checkpoint {
 	return rep.checkpoints[len(rep.checkpoints)-1]
 }
@@ -157,18 +238,26 @@ func (rep *Replica) acceptConnections(addr string) {
 	ln, err := net.Listen("tcp", addr)
 	if err != nil {
 		plog.Error("tcp connect error", "err", err)
+		return
 	}
+	rep.ln = ln
 	go rep.sendRoutine()
 	go func() {
 		for {
 			conn, err := ln.Accept()
 			if err != nil {
-				plog.Error("Accept error")
+				if rep.isStopped() {
+					return
+				}
+				plog.Error("Accept error", "err", err)
+				continue
 			}
 			req := &pb.Request{}
 			err = ReadMessage(conn, req)
+			conn.Close()
 			if err != nil {
 				plog.Error("readmessage error", "err", err)
+				continue
 			}
 			rep.handleRequest(req)
 		}
@@ -177,23 +266,28 @@ func (rep *Replica) acceptConnections(addr string) {
 
 // Sends
 
+// a crashed replica should not stop the others from receiving the message
 func (rep *Replica) multicast(REQ proto.Message) error {
+	var errRet error
 	for _, replica := range rep.replicas {
 		err := WriteMessage(replica, REQ)
 		if err != nil {
-			return err
+			errRet = err
 		}
 	}
-	return nil
+	return errRet
 }
 
 func (rep *Replica) sendRoutine() {
 	for REQ := range rep.requestChan {
+		if rep.isStopped() {
+			continue
+		}
 		switch REQ.Value.(type) {
 		case *pb.Request_Ack:
 			view := REQ.GetAck().View
 			primaryID := rep.newPrimary(view)
-			primary := rep.replicas[primaryID]
+			primary := rep.replicaAddr(primaryID)
 			if primary == "" {
 				plog.Error("primary not exeist")
 				continue
@@ -277,12 +371,12 @@ func (rep *Replica) hasRequest(REQ *pb.Request) bool {
 		return rep.hasRequestPrepare(REQ)
 	case *pb.Request_Commit:
 		return rep.hasRequestCommit(REQ)
-	//case *pb.Request_Viewchange:
-	//	return rep.hasRequestViewChange(REQ)
-	//case *pb.Request_Ack:
-	//	return rep.hasRequestAck(REQ)
-	//case *pb.Request_Newview:
-	//	return rep.hasRequestNewView(REQ)
+	case *pb.Request_Viewchange:
+		return rep.hasRequestViewChange(REQ)
+	case *pb.Request_Ack:
+		return rep.hasRequestAck(REQ)
+	case *pb.Request_Newview:
+		return rep.hasRequestNewView(REQ)
 	default:
 		return false
 	}
@@ -335,44 +429,44 @@ func (rep *Replica) hasRequestCommit(REQ *pb.Request) bool {
 	return false
 }
 
-//func (rep *Replica) hasRequestViewChange(REQ *pb.Request) bool {
-//	view := REQ.GetViewchange().View
-//	replica := REQ.GetViewchange().Replica
-//	for _, req := range rep.requests["view-change"] {
-//		v := req.GetViewchange().View
-//		r := req.GetViewchange().Replica
-//		if v == view && r == replica {
-//			return true
-//		}
-//	}
-//	return false
-//}
+func (rep *Replica) hasRequestViewChange(REQ *pb.Request) bool {
+	view := REQ.GetViewchange().View
+	replica := REQ.GetViewchange().Replica
+	for _, req := range rep.requests["view-change"] {
+		v := req.GetViewchange().View
+		r := req.GetViewchange().Replica
+		if v == view && r == replica {
+			return true
+		}
+	}
+	return false
+}
 
-//func (rep *Replica) hasRequestAck(REQ *pb.Request) bool {
-//	view := REQ.GetAck().View
-//	replica := REQ.GetAck().Replica
-//	viewchanger := REQ.GetAck().Viewchanger
-//	for _, req := range rep.requests["ack"] {
-//		v := req.GetAck().View
-//		r := req.GetAck().Replica
-//		vc := req.GetAck().Viewchanger
-//		if v == view && r == replica && vc == viewchanger {
-//			return true
-//		}
-//	}
-//	return false
-//}
+func (rep *Replica) hasRequestAck(REQ *pb.Request) bool {
+	view := REQ.GetAck().View
+	replica := REQ.GetAck().Replica
+	viewchanger := REQ.GetAck().Viewchanger
+	for _, req := range rep.requests["ack"] {
+		v := req.GetAck().View
+		r := req.GetAck().Replica
+		vc := req.GetAck().Viewchanger
+		if v == view && r == replica && vc == viewchanger {
+			return true
+		}
+	}
+	return false
+}
 
-//func (rep *Replica) hasRequestNewView(REQ *pb.Request) bool {
-//	view := REQ.GetNewview().View
-//	for _, req := range rep.requests["new-view"] {
-//		v := req.GetNewview().View
-//		if v == view {
-//			return true
-//		}
-//	}
-//	return false
-//}
+func (rep *Replica) hasRequestNewView(REQ *pb.Request) bool {
+	view := REQ.GetNewview().View
+	for _, req := range rep.requests["new-view"] {
+		v := req.GetNewview().View
+		if v == view {
+			return true
+		}
+	}
+	return false
+}
 
 // Clear requests
 
@@ -493,6 +587,11 @@ func (rep *Replica) hasRequestCommit(REQ *pb.Request) bool {
 // Handle requests
 
 func (rep *Replica) handleRequest(REQ *pb.Request) {
+	rep.mtx.Lock()
+	defer rep.mtx.Unlock()
+	if rep.stopped {
+		return
+	}
 
 	switch REQ.Value.(type) {
 	case *pb.Request_Client:
@@ -515,13 +614,17 @@ func (rep *Replica) handleRequest(REQ *pb.Request) {
 	//
 	//	rep.handleRequestCheckpoint(REQ)
 	//
-	//case *pb.Request_Viewchange:
-	//
-	//	rep.handleRequestViewChange(REQ)
-	//
-	//case *pb.Request_Ack:
-	//
-	//	rep.handleRequestAck(REQ)
+	case *pb.Request_Viewchange:
+
+		rep.handleRequestViewChange(REQ)
+
+	case *pb.Request_Ack:
+
+		rep.handleRequestAck(REQ)
+
+	case *pb.Request_Newview:
+
+		rep.handleRequestNewView(REQ)
 
 	default:
 		plog.Info("Replica %d received unrecognized request type\n", rep.ID)
@@ -544,7 +647,15 @@ func (rep *Replica) handleRequestClient(REQ *pb.Request) {
 		}
 	}
 	rep.logRequest(REQ)
+	rep.waiting[string(ReqDigest(REQ))] = true
+	if !rep.activeView {
+		return
+	}
 	if !rep.isPrimary(rep.ID) {
+		// the backup waits for the request to be executed, or asks for a new primary
+		if rep.vcTimer == nil {
+			rep.startTimer(rep.timeout)
+		}
 		return
 	}
 	req := ToRequestPreprepare(rep.view, rep.sequence, ReqDigest(REQ), rep.ID)
@@ -582,7 +693,13 @@ func (rep *Replica) handleRequestPreprepare(REQ *pb.Request) {
 	if !accept {
 		return
 	}
-	rep.logRequest(REQ)
+	if !rep.hasRequest(REQ) {
+		rep.logRequest(REQ)
+	}
+	// pre-prepares arrived before the new-view are prepared after entering the view
+	if !rep.activeView {
+		return
+	}
 	plog.Info("pre-prepare done")
 	req := ToRequestPrepare(view, sequence, digest, rep.ID)
 	if rep.hasRequest(req) {
@@ -596,12 +713,6 @@ func (rep *Replica) handleRequestPreprepare(REQ *pb.Request) {
 
 func (rep *Replica) handleRequestPrepare(REQ *pb.Request) {
 
-	replica := REQ.GetPrepare().Replica
-
-	//if rep.isPrimary(replica) {
-	//return
-	//}
-
 	view := REQ.GetPrepare().View
 
 	if rep.view != view {
@@ -616,39 +727,11 @@ func (rep *Replica) handleRequestPrepare(REQ *pb.Request) {
 
 	digest := REQ.GetPrepare().Digest
 
-	rep.logRequest(REQ)
-
-	//prePrepared := make(chan bool, 1)
-	twoThirds := make(chan bool, 1)
-
-	//go func() {
-	//prePrepared <- rep.hasRequest(REQ)
-	//}()
-
-	go func() {
-		count := 0
-		for _, req := range rep.requests["prepare"] {
-			v := req.GetPrepare().View
-			s := req.GetPrepare().Sequence
-			d := req.GetPrepare().Digest
-			r := req.GetPrepare().Replica
-			if v != view || s != sequence || !EQ(d, digest) {
-				continue
-			}
-			if r == replica {
-				plog.Debug("multiple prepare requests", "Replica ", replica, " sent multiple prepare requests")
-				//continue
-			}
-			count++
-			if rep.overTwoThirds(count) {
-				twoThirds <- true
-				return
-			}
-			twoThirds <- false
-		}
-	}()
+	if !rep.hasRequest(REQ) {
+		rep.logRequest(REQ)
+	}
 
-	if !<-twoThirds {
+	if !rep.prepared(view, sequence, digest) {
 		return
 	}
 
@@ -678,32 +761,23 @@ func (rep *Replica) handleRequestCommit(REQ *pb.Request) {
 		return
 	}
 
-	replica := REQ.GetCommit().Replica
+	if !rep.hasRequest(REQ) {
+		rep.logRequest(REQ)
+	}
 
-	rep.logRequest(REQ)
-	twoThirds := make(chan bool, 1)
-	go func() {
-		count := 0
-		for _, req := range rep.requests["commit"] {
-			v := req.GetCommit().View
-			s := req.GetCommit().Sequence
-			rr := req.GetCommit().Replica
-			if v != view || s != sequence {
-				continue
-			}
-			if rr == replica {
-				plog.Debug("multiple commit requests", "Replica ", replica, " sent multiple commit requests")
-				//continue
-			}
-			count++
-			if rep.overTwoThirds(count) {
-				twoThirds <- true
-				return
-			}
+	replicas := make(map[uint32]bool)
+	for _, req := range rep.requests["commit"] {
+		v := req.GetCommit().View
+		s := req.GetCommit().Sequence
+		if v == view && s == sequence {
+			replicas[req.GetCommit().Replica] = true
 		}
-		twoThirds <- false
-	}()
-	if !<-twoThirds {
+	}
+	if !rep.overTwoThirds(len(replicas)) {
+		return
+	}
+	// the request may be committed again in a new view
+	if rep.hasExecuted(sequence) {
 		return
 	}
 	//log.Println(count)
@@ -741,6 +815,7 @@ func (rep *Replica) handleRequestCommit(REQ *pb.Request) {
 		result := &pb.Result{Value: op.Value}
 
 		rep.executed = append(rep.executed, sequence)
+		rep.requestExecuted(d)
 		reply := ToReply(view, timestamp, client, rep.ID, result)
 
 		rep.logReply(client, reply)
@@ -802,805 +877,3 @@ func (rep *Replica) handleRequestCommit(REQ *pb.Request) {
 //	}
 //
 //}
-
-//func (rep *Replica) handleRequestViewChange(REQ *pb.Request) {
-//
-//	view := REQ.GetViewchange().View
-//
-//	if view < rep.view {
-//		return
-//	}
-//
-//	reqViewChange := REQ.GetViewchange()
-//
-//	for _, prep := range reqViewChange.GetPreps() {
-//		v := prep.View
-//		s := prep.Sequence
-//		if v >= view || !rep.sequenceInRange(s) {
-//			return
-//		}
-//	}
-//
-//	for _, prePrep := range reqViewChange.GetPrepreps() {
-//		v := prePrep.View
-//		s := prePrep.Sequence
-//		if v >= view || !rep.sequenceInRange(s) {
-//			return
-//		}
-//	}
-//
-//	for _, checkpoint := range reqViewChange.GetCheckpoints() {
-//		s := checkpoint.Sequence
-//		if !rep.sequenceInRange(s) {
-//			return
-//		}
-//	}
-//
-//	if rep.hasRequest(REQ) {
-//		return
-//	}
-//
-//	rep.logRequest(REQ)
-//
-//	viewchanger := reqViewChange.Replica
-//
-//	req := ToRequestAck(
-//		view,
-//		rep.ID,
-//		viewchanger,
-//		ReqDigest(REQ))
-//
-//	go func() {
-//		rep.requestChan <- req
-//	}()
-//}
-
-//func (rep *Replica) handleRequestAck(REQ *pb.Request) {
-//
-//	view := REQ.GetAck().View
-//	primaryID := rep.newPrimary(view)
-//
-//	if rep.ID != primaryID {
-//		return
-//	}
-//
-//	if rep.hasRequest(REQ) {
-//		return
-//	}
-//
-//	rep.logRequest(REQ)
-//
-//	replica := REQ.GetAck().Replica
-//	viewchanger := REQ.GetAck().Viewchanger
-//	digest := REQ.GetAck().Digest
-//
-//	reqViewChange := make(chan *pb.Request, 1)
-//	twoThirds := make(chan bool, 1)
-//
-//	go func() {
-//		for _, req := range rep.requests["view-change"] {
-//			v := req.GetViewchange().View
-//			vc := req.GetViewchange().Replica
-//			if v == view && vc == viewchanger {
-//				reqViewChange <- req
-//			}
-//		}
-//		reqViewChange <- nil
-//	}()
-//
-//	go func() {
-//		count := 0
-//		for _, req := range rep.requests["ack"] {
-//			v := req.GetAck().View
-//			r := req.GetAck().Replica
-//			vc := req.GetAck().Viewchanger
-//			d := req.GetAck().Digest
-//			if v != view || vc != viewchanger || !EQ(d, digest) {
-//				continue
-//			}
-//			if r == replica {
-//				plog.Info("Replica %d sent multiple ack requests\n", replica)
-//				continue
-//			}
-//			count++
-//			if rep.twoThirds(count) {
-//				twoThirds <- true
-//				return
-//			}
-//		}
-//		twoThirds <- false
-//	}()
-//
-//	req := <-reqViewChange
-//
-//	if req == nil || !<-twoThirds {
-//		return
-//	}
-//
-//	rep.logPendingVC(req)
-//
-//	// When to send new view?
-//	rep.requestNewView(view)
-//}
-
-//func (rep *Replica) handleRequestNewView(REQ *pb.Request) {
-//
-//	view := REQ.GetNewview().View
-//
-//	if view == 0 || view < rep.view {
-//		return
-//	}
-//
-//	replica := REQ.GetNewview().Replica
-//	primary := rep.newPrimary(view)
-//
-//	if replica != primary {
-//		return
-//	}
-//
-//	if rep.hasRequest(REQ) {
-//		return
-//	}
-//
-//	rep.logRequest(REQ)
-//
-//	rep.processNewView(REQ)
-//}
-
-//func (rep *Replica) correctViewChanges(viewChanges []*pb.ViewChange) (requests []*pb.Request) {
-//
-//	// Returns requests if correct, else returns nil
-//
-//	valid := false
-//	for _, vc := range viewChanges {
-//		for _, req := range rep.requests["view-change"] {
-//			d := ReqDigest(req)
-//			if !EQ(d, vc.Digest) {
-//				continue
-//			}
-//			requests = append(requests, req)
-//			v := req.GetViewchange().View
-//			// VIEW or rep.view??
-//			if v == rep.view {
-//				valid = true
-//				break
-//			}
-//		}
-//		if !valid {
-//			return nil
-//		}
-//	}
-//
-//	if rep.isPrimary(rep.ID) {
-//		reps := make(map[uint32]int)
-//		valid = false
-//		for _, req := range rep.requests["ack"] {
-//			reqAck := req.GetAck()
-//			reps[reqAck.Replica]++
-//			if rep.twoThirds(reps[reqAck.Replica]) { //-2
-//				valid = true
-//				break
-//			}
-//		}
-//		if !valid {
-//			return nil
-//		}
-//	}
-//
-//	return
-//}
-
-//func (rep *Replica) correctSummaries(requests []*pb.Request, summaries []*pb.Summary) (correct bool) {
-//
-//	// Verify SUMMARIES
-//
-//	var start uint32
-//	var digest []byte
-//	digests := make(map[uint32][]byte)
-//
-//	for _, summary := range summaries {
-//		s := summary.Sequence
-//		d := summary.Digest
-//		if _d, ok := digests[s]; ok && !EQ(_d, d) {
-//			return
-//		} else if !ok {
-//			digests[s] = d
-//		}
-//		if s < start || start == uint32(0) {
-//			start = s
-//			digest = d
-//		}
-//	}
-//
-//	var A1 []*pb.Request
-//	var A2 []*pb.Request
-//
-//	valid := false
-//	for _, req := range requests {
-//		reqViewChange := req.GetViewchange()
-//		s := reqViewChange.Sequence
-//		if s <= start {
-//			A1 = append(A1, req)
-//		}
-//		checkpoints := reqViewChange.GetCheckpoints()
-//		for _, checkpoint := range checkpoints {
-//			if checkpoint.Sequence == start && EQ(checkpoint.Digest, digest) {
-//				A2 = append(A2, req)
-//				break
-//			}
-//		}
-//		if rep.twoThirds(len(A1)) && rep.oneThird(len(A2)) {
-//			valid = true
-//			break
-//		}
-//	}
-//
-//	if !valid {
-//		return
-//	}
-//
-//	end := start + CheckPointPeriod*ConstantFactor
-//
-//	for seq := start; seq <= end; seq++ {
-//
-//		valid = false
-//
-//		for _, summary := range summaries {
-//
-//			if summary.Sequence != seq {
-//				continue
-//			}
-//
-//			if summary.Digest != nil {
-//
-//				var view uint32
-//
-//				for _, req := range requests {
-//					reqViewChange := req.GetViewchange()
-//					preps := reqViewChange.GetPreps()
-//					for _, prep := range preps {
-//						s := prep.Sequence
-//						d := prep.Digest
-//						if s != summary.Sequence || !EQ(d, summary.Digest) {
-//							continue
-//						}
-//						v := prep.View
-//						if v > view {
-//							view = v
-//						}
-//					}
-//				}
-//
-//				verifiedA1 := make(chan bool, 1)
-//
-//				// Verify A1
-//				go func() {
-//
-//					var A1 []*pb.Request
-//
-//				FOR_LOOP:
-//					for _, req := range requests {
-//						reqViewChange := req.GetViewchange()
-//						s := reqViewChange.Sequence
-//						if s >= summary.Sequence {
-//							continue
-//						}
-//						preps := reqViewChange.GetPreps()
-//						for _, prep := range preps {
-//							s = prep.Sequence
-//							if s != summary.Sequence {
-//								continue
-//							}
-//							d := prep.Digest
-//							v := prep.View
-//							if v > view || (v == view && !EQ(d, summary.Digest)) {
-//								continue FOR_LOOP
-//							}
-//						}
-//						A1 = append(A1, req)
-//						if rep.twoThirds(len(A1)) {
-//							verifiedA1 <- true
-//							return
-//						}
-//					}
-//					verifiedA1 <- false
-//				}()
-//
-//				verifiedA2 := make(chan bool, 1)
-//
-//				// Verify A2
-//				go func() {
-//
-//					var A2 []*pb.Request
-//
-//					for _, req := range requests {
-//						reqViewChange := req.GetViewchange()
-//						prePreps := reqViewChange.GetPrepreps()
-//						for _, prePrep := range prePreps {
-//							s := prePrep.Sequence
-//							d := prePrep.Digest
-//							v := prePrep.View
-//							if s == summary.Sequence && EQ(d, summary.Digest) && v >= view {
-//								A2 = append(A2, req)
-//								break
-//							}
-//						}
-//						if rep.oneThird(len(A2)) {
-//							verifiedA2 <- true
-//							return
-//						}
-//					}
-//					verifiedA2 <- false
-//				}()
-//
-//				if !<-verifiedA1 || !<-verifiedA2 {
-//					continue
-//				}
-//
-//				valid = true
-//				break
-//
-//			} else {
-//
-//				var A1 []*pb.Request
-//
-//			FOR_LOOP:
-//
-//				for _, req := range requests {
-//
-//					reqViewChange := req.GetViewchange()
-//
-//					s := reqViewChange.Sequence
-//
-//					if s >= summary.Sequence {
-//						continue
-//					}
-//
-//					preps := reqViewChange.GetPreps()
-//					for _, prep := range preps {
-//						if prep.Sequence == summary.Sequence {
-//							continue FOR_LOOP
-//						}
-//					}
-//
-//					A1 = append(A1, req)
-//					if rep.twoThirds(len(A1)) {
-//						valid = true
-//						break
-//					}
-//				}
-//				if valid {
-//					break
-//				}
-//			}
-//		}
-//		if !valid {
-//			return
-//		}
-//	}
-//
-//	return true
-//}
-
-//func (rep *Replica) processNewView(REQ *pb.Request) (success bool) {
-//
-//	if rep.activeView {
-//		return
-//	}
-//
-//	reqNewView := REQ.GetNewview()
-//
-//	viewChanges := reqNewView.GetViewchanges()
-//	requests := rep.correctViewChanges(viewChanges)
-//
-//	if requests == nil {
-//		return
-//	}
-//
-//	summaries := reqNewView.GetSummaries()
-//	correct := rep.correctSummaries(requests, summaries)
-//
-//	if !correct {
-//		return
-//	}
-//
-//	var h uint32
-//	for _, checkpoint := range rep.checkpoints {
-//		if checkpoint.Sequence < h || h == uint32(0) {
-//			h = checkpoint.Sequence
-//		}
-//	}
-//
-//	var s uint32
-//	for _, summary := range summaries {
-//		if summary.Sequence < s || s == uint32(0) {
-//			s = summary.Sequence
-//		}
-//		if summary.Sequence > h {
-//			valid := false
-//			for _, req := range rep.requests["view-change"] { //in
-//				if EQ(ReqDigest(req), summary.Digest) {
-//					valid = true
-//					break
-//				}
-//			}
-//			if !valid {
-//				return
-//			}
-//		}
-//	}
-//
-//	if h < s {
-//		return
-//	}
-//
-//	// Process new view
-//	rep.activeView = true
-//
-//	for _, summary := range summaries {
-//
-//		if rep.ID != reqNewView.Replica {
-//			req := ToRequestPrepare(
-//				reqNewView.View,
-//				summary.Sequence,
-//				summary.Digest,
-//				rep.ID) // the backup sends/logs prepare
-//
-//			go func() {
-//				if !rep.hasRequest(req) {
-//					rep.requestChan <- req
-//				}
-//			}()
-//			if summary.Sequence <= h {
-//				continue
-//			}
-//
-//			if !rep.hasRequest(req) {
-//				rep.logRequest(req)
-//			}
-//		} else {
-//			if summary.Sequence <= h {
-//				break
-//			}
-//		}
-//
-//		req := ToRequestPreprepare(
-//			reqNewView.View,
-//			summary.Sequence,
-//			summary.Digest,
-//			reqNewView.Replica) // new primary pre-prepares
-//
-//		if !rep.hasRequest(req) {
-//			rep.logRequest(req)
-//		}
-//	}
-//
-//	var maxSequence uint32
-//	for _, req := range rep.requests["pre-prepare"] {
-//		reqPrePrepare := req.GetPreprepare()
-//		if reqPrePrepare.Sequence > maxSequence {
-//			maxSequence = reqPrePrepare.Sequence
-//		}
-//	}
-//	rep.sequence = maxSequence
-//	return true
-//}
-
-//func (rep *Replica) prePrepBySequence(sequence uint32) []*pb.Entry {
-//	var view uint32
-//	var requests []*pb.Request
-//	for _, req := range rep.requests["pre-prepare"] {
-//		v := req.GetPreprepare().View
-//		s := req.GetPreprepare().Sequence
-//		if v >= view && s == sequence {
-//			view = v
-//			requests = append(requests, req)
-//		}
-//	}
-//	if requests == nil {
-//		return nil
-//	}
-//	var prePreps []*pb.Entry
-//	for _, req := range requests {
-//		v := req.GetPreprepare().View
-//		if v == view {
-//			s := req.GetPreprepare().Sequence
-//			d := req.GetPreprepare().Digest
-//			prePrep := ToEntry(s, d, v)
-//			prePreps = append(prePreps, prePrep)
-//		}
-//	}
-//FOR_LOOP:
-//	for _, prePrep := range prePreps {
-//		for _, req := range rep.allRequests() { //TODO: optimize
-//			if EQ(ReqDigest(req), prePrep.Digest) {
-//				continue FOR_LOOP
-//			}
-//		}
-//		return nil
-//	}
-//	return prePreps
-//}
-//
-//func (rep *Replica) prepBySequence(sequence uint32) ([]*pb.Entry, []*pb.Entry) {
-//
-//	prePreps := rep.prePrepBySequence(sequence)
-//
-//	if prePreps == nil {
-//		return nil, nil
-//	}
-//
-//	var preps []*pb.Entry
-//
-//FOR_LOOP:
-//	for _, prePrep := range prePreps {
-//
-//		view := prePrep.View
-//		digest := prePrep.Digest
-//
-//		replicas := make(map[uint32]int)
-//		for _, req := range rep.requests["prepare"] {
-//			reqPrepare := req.GetPrepare()
-//			v := reqPrepare.View
-//			s := reqPrepare.Sequence
-//			d := reqPrepare.Digest
-//			if v == view && s == sequence && EQ(d, digest) {
-//				r := reqPrepare.Replica
-//				replicas[r]++
-//				if rep.twoThirds(replicas[r]) {
-//					prep := ToEntry(s, d, v)
-//					preps = append(preps, prep)
-//					continue FOR_LOOP
-//				}
-//			}
-//		}
-//		return prePreps, nil
-//	}
-//
-//	return prePreps, preps
-//}
-
-/*
-func (rep *Replica) prepBySequence(sequence uint32) *pb.Entry {
-	var REQ *pb.Request
-	var view uint32
-	// Looking for a commit that
-	// replica sent with sequence#
-	for _, req := range rep.requests["commit"] {
-		v := req.GetCommit().View
-		s := req.GetCommit().Sequence
-		r := req.GetCommit().Replica
-		if v >= view && s == sequence && r == rep.ID {
-			REQ = req
-			view = v
-		}
-	}
-	if REQ == nil {
-		return nil
-	}
-	entry := pb.ToEntry(sequence, nil, view)
-	return entry
-}
-
-func (rep *Replica) prePrepBySequence(sequence uint32) *pb.Entry {
-	var REQ *pb.Request
-	var view uint32
-	// Looking for prepare/pre-prepare that
-	// replica sent with matching sequence
-	for _, req := range rep.requests["pre-prepare"] {
-		v := req.GetPreprepare().View
-		s := req.GetPreprepare().Sequence
-		r := req.GetPreprepare().Replica
-		if v >= view && s == sequence && r == rep.ID {
-			REQ = req
-			view = v
-		}
-	}
-	for _, req := range rep.requests["prepare"] {
-		v := req.GetPreprepare().View
-		s := req.GetPreprepare().Sequence
-		r := req.GetPreprepare().Replica
-		if v >= view && s == sequence && r == rep.ID {
-			REQ = req
-			view = v
-		}
-	}
-	if REQ == nil {
-		return nil
-	}
-	var digest []byte
-	switch REQ.Value.(type) {
-	case *pb.Request_Preprepare:
-		digest = REQ.GetPreprepare().Digest
-	case *pb.Request_Prepare:
-		digest = REQ.GetPrepare().Digest
-	}
-	entry := pb.ToEntry(sequence, digest, view)
-	return entry
-}
-*/
-
-//func (rep *Replica) requestViewChange(view uint32) {
-//
-//	if view != rep.view+1 {
-//		return
-//	}
-//	rep.view = view
-//	rep.activeView = false
-//
-//	var prePreps []*pb.Entry
-//	var preps []*pb.Entry
-//
-//	start := rep.lowWaterMark() + 1
-//	end := rep.highWaterMark()
-//
-//	for s := start; s <= end; s++ {
-//		_prePreps, _preps := rep.prepBySequence(s)
-//		if _prePreps != nil {
-//			prePreps = append(prePreps, _prePreps...)
-//		}
-//		if _preps != nil {
-//			preps = append(preps, _preps...)
-//		}
-//	}
-//
-//	sequence := rep.lowWaterMark()
-//
-//	req := ToRequestViewChange(
-//		view,
-//		sequence,
-//		rep.checkpoints, //
-//		preps,
-//		prePreps,
-//		rep.ID)
-//
-//	rep.logRequest(req)
-//
-//	go func() {
-//		rep.requestChan <- req
-//	}()
-//
-//	rep.clearRequestsByView(view)
-//}
-//
-//func (rep *Replica) createNewView(view uint32) (request *pb.Request) {
-//
-//	// Returns RequestNewView if successful, else returns nil
-//	// create viewChanges
-//	viewChanges := make([]*pb.ViewChange, len(rep.pendingVC))
-//
-//	for idx := range viewChanges {
-//		req := rep.pendingVC[idx]
-//		viewchanger := req.GetViewchange().Replica
-//		vc := ToViewChange(viewchanger, ReqDigest(req))
-//		viewChanges[idx] = vc
-//	}
-//
-//	var summaries []*pb.Summary
-//	var summary *pb.Summary
-//
-//	start := rep.lowWaterMark() + 1
-//	end := rep.highWaterMark()
-//
-//	// select starting checkpoint
-//FOR_LOOP_1:
-//	for seq := start; seq <= end; seq++ {
-//
-//		overLWM := 0
-//		var digest []byte
-//		digests := make(map[string]int)
-//
-//		for _, req := range rep.pendingVC {
-//			reqViewChange := req.GetViewchange()
-//			if reqViewChange.Sequence <= seq {
-//				overLWM++
-//			}
-//			for _, checkpoint := range reqViewChange.GetCheckpoints() {
-//				if checkpoint.Sequence == seq {
-//					d := checkpoint.Digest
-//					digests[string(d)]++
-//					if rep.oneThird(digests[string(d)]) {
-//						digest = d
-//						break
-//					}
-//				}
-//			}
-//			if rep.twoThirds(overLWM) && rep.oneThird(digests[string(digest)]) {
-//				summary = ToSummary(seq, digest)
-//				continue FOR_LOOP_1
-//			}
-//		}
-//	}
-//
-//	if summary == nil {
-//		return
-//	}
-//
-//	summaries = append(summaries, summary)
-//
-//	start = summary.Sequence
-//	end = start + CheckPointPeriod*ConstantFactor
-//
-//	// select summaries
-//	// TODO: optimize
-//FOR_LOOP_2:
-//	for seq := start; seq <= end; seq++ {
-//
-//		for _, REQ := range rep.pendingVC {
-//
-//			sequence := REQ.GetViewchange().Sequence
-//
-//			if sequence != seq {
-//				continue
-//			}
-//
-//			var A1 []*pb.Request
-//			var A2 []*pb.Request
-//
-//			view := REQ.GetViewchange().View
-//			digest := ReqDigest(REQ)
-//
-//		FOR_LOOP_3:
-//			for _, req := range rep.pendingVC {
-//
-//				reqViewChange := req.GetViewchange()
-//
-//				if reqViewChange.Sequence < sequence {
-//					preps := reqViewChange.GetPreps()
-//					for _, prep := range preps {
-//						if prep.Sequence != sequence {
-//							continue
-//						}
-//						if prep.View > view || (prep.View == view && !EQ(prep.Digest, digest)) {
-//							continue FOR_LOOP_3
-//						}
-//					}
-//					A1 = append(A1, req)
-//				}
-//				prePreps := reqViewChange.GetPrepreps()
-//				for _, prePrep := range prePreps {
-//					if prePrep.Sequence != sequence {
-//						continue
-//					}
-//					if prePrep.View >= view && EQ(prePrep.Digest, digest) {
-//						A2 = append(A2, req)
-//						continue FOR_LOOP_3
-//					}
-//				}
-//			}
-//
-//			if rep.twoThirds(len(A1)) && rep.oneThird(len(A2)) {
-//				summary = ToSummary(sequence, digest)
-//				summaries = append(summaries, summary)
-//				continue FOR_LOOP_2
-//			}
-//		}
-//	}
-//
-//	request = ToRequestNewView(view, viewChanges, summaries, rep.ID)
-//	return
-//}
-//
-//func (rep *Replica) requestNewView(view uint32) {
-//
-//	req := rep.createNewView(view)
-//
-//	if req == nil || rep.hasRequest(req) {
-//		return
-//	}
-//
-//	// Process new view
-//
-//	success := rep.processNewView(req)
-//
-//	if !success {
-//		return
-//	}
-//
-//	rep.logRequest(req)
-//
-//	go func() {
-//		rep.requestChan <- req
-//	}()
-//
-//}
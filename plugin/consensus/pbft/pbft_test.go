@@ -8,8 +8,10 @@ import (
 	"flag"
 	"fmt"
 	"math/rand"
+	"net"
 	"os"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -25,6 +27,8 @@ import (
 	cty "github.com/33cn/chain33/system/dapp/coins/types"
 	"github.com/33cn/chain33/types"
 	"github.com/33cn/chain33/wallet"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	_ "github.com/33cn/chain33/system"
 	_ "github.com/33cn/plugin/plugin/dapp/init"
@@ -154,3 +158,135 @@ func clearTestData() {
 	}
 	fmt.Println("test data clear successfully!")
 }
+
+func startReplicas(t *testing.T, n int, timeout time.Duration) []*Replica {
+	addrs := make([]string, n)
+	for i := range addrs {
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		require.Nil(t, err)
+		addrs[i] = ln.Addr().String()
+		ln.Close()
+	}
+	peers := strings.Join(addrs, ",")
+	reps := make([]*Replica, n)
+	for i := range reps {
+		reps[i] = NewReplica(uint32(i+1), peers, addrs[i], timeout)
+	}
+	return reps
+}
+
+func stopReplicas(reps []*Replica) {
+	for _, rep := range reps {
+		rep.Stop()
+	}
+}
+
+func sendClientRequest(rep *Replica, timestamp string) {
+	op := &types.Operation{Value: &types.Block{Height: 1}}
+	rep.requestChan <- ToRequestClient(op, timestamp, "client")
+}
+
+func waitReply(t *testing.T, rep *Replica, timestamp string, timeout time.Duration) *types.ClientReply {
+	timer := time.After(timeout)
+	for {
+		select {
+		case reply := <-rep.replyChan:
+			if reply.Timestamp == timestamp {
+				return reply
+			}
+		case <-timer:
+			t.Fatalf("replica %d wait reply %s timeout", rep.ID, timestamp)
+		}
+	}
+}
+
+func TestViewChangeOnPrimaryFailure(t *testing.T) {
+	reps := startReplicas(t, 4, 500*time.Millisecond)
+	defer stopReplicas(reps)
+	assert.True(t, reps[0].IsPrimary())
+
+	sendClientRequest(reps[0], "1")
+	for _, rep := range reps {
+		reply := waitReply(t, rep, "1", 5*time.Second)
+		assert.Equal(t, uint32(1), reply.View)
+	}
+
+	// the request reaches the backups only, they elect replica 2 after timeout
+	reps[0].Stop()
+	sendClientRequest(reps[1], "2")
+	for _, rep := range reps[1:] {
+		reply := waitReply(t, rep, "2", 10*time.Second)
+		assert.Equal(t, uint32(2), reply.View)
+	}
+	assert.True(t, reps[1].IsPrimary())
+	assert.False(t, reps[2].IsPrimary())
+
+	sendClientRequest(reps[1], "3")
+	for _, rep := range reps[1:] {
+		reply := waitReply(t, rep, "3", 5*time.Second)
+		assert.Equal(t, uint32(2), reply.View)
+	}
+}
+
+func TestViewChangeSkipFailedPrimary(t *testing.T) {
+	reps := startReplicas(t, 7, 500*time.Millisecond)
+	defer stopReplicas(reps)
+
+	// the primaries of view 1 and view 2 are both down
+	reps[0].Stop()
+	reps[1].Stop()
+	sendClientRequest(reps[2], "1")
+	for _, rep := range reps[2:] {
+		reply := waitReply(t, rep, "1", 15*time.Second)
+		assert.Equal(t, uint32(3), reply.View)
+	}
+	assert.True(t, reps[2].IsPrimary())
+}
+
+func TestNewViewPreparedCertificate(t *testing.T) {
+	d1, d2, d3 := []byte("digest1"), []byte("digest2"), []byte("digest3")
+	checkpoints := []*types.Checkpoint{ToCheckpoint(0, []byte(""))}
+	vc2 := ToRequestViewChange(2, 0, checkpoints,
+		[]*types.Entry{ToEntry(1, d1, 1)},
+		[]*types.Entry{ToEntry(1, d1, 1), ToEntry(2, d2, 1)}, 2)
+	vc3 := ToRequestViewChange(2, 0, checkpoints,
+		nil,
+		[]*types.Entry{ToEntry(1, d1, 1)}, 3)
+	// replica 4 makes up a prepared certificate nobody else has seen
+	vc4 := ToRequestViewChange(2, 0, checkpoints,
+		[]*types.Entry{ToEntry(3, d3, 1)},
+		[]*types.Entry{ToEntry(3, d3, 1)}, 4)
+
+	rep := newReplica(3, "127.0.0.1:1,127.0.0.1:2,127.0.0.1:3,127.0.0.1:4", time.Second)
+	for _, req := range []*types.Request{vc2, vc3, vc4} {
+		assert.True(t, rep.validViewChange(req.GetViewchange()))
+		rep.logRequest(req)
+	}
+	// a prepared certificate without the pre-prepare
+	invalid := ToRequestViewChange(2, 0, checkpoints, []*types.Entry{ToEntry(1, d1, 1)}, nil, 1)
+	assert.False(t, rep.validViewChange(invalid.GetViewchange()))
+
+	summaries := rep.summaries([]*types.Request{vc2, vc3, vc4})
+	require.Equal(t, 1, len(summaries))
+	assert.Equal(t, uint32(1), summaries[0].Sequence)
+	assert.Equal(t, d1, summaries[0].Digest)
+
+	viewChanges := []*types.ViewChange{
+		ToViewChange(2, ReqDigest(vc2)),
+		ToViewChange(3, ReqDigest(vc3)),
+		ToViewChange(4, ReqDigest(vc4)),
+	}
+	forged := ToRequestNewView(2, viewChanges, append(summaries, ToSummary(3, d3)), 2)
+	assert.False(t, rep.processNewView(forged))
+	assert.False(t, rep.activeView && rep.view == 2)
+
+	missing := ToRequestNewView(2, viewChanges[:2], summaries, 2)
+	assert.False(t, rep.processNewView(missing))
+
+	newView := ToRequestNewView(2, viewChanges, summaries, 2)
+	assert.True(t, rep.processNewView(newView))
+	assert.True(t, rep.activeView)
+	assert.Equal(t, uint32(2), rep.view)
+	assert.True(t, rep.hasRequest(ToRequestPreprepare(2, 1, d1, 2)))
+	assert.True(t, rep.hasRequest(ToRequestPrepare(2, 1, d1, 3)))
+}
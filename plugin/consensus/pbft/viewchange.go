@@ -0,0 +1,551 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pbft
+
+import (
+	"sort"
+	"time"
+
+	pb "github.com/33cn/chain33/types"
+)
+
+// View change
+//
+// A backup starts the request timer when it receives a client request, and
+// stops it once all the received requests are executed. When the timer
+// expires the backup stops accepting pre-prepares of the current view and
+// multicasts a view-change for the next view, carrying its stable checkpoint
+// and prepared certificates. Every replica acks the view-changes it receives
+// to the new primary. After the new primary collects 2f+1 acknowledged
+// view-changes, it multicasts a new-view which references the view-changes by
+// digest, together with the requests it re-proposes. Backups recompute the
+// re-proposed requests from the referenced view-changes before entering the
+// new view. If no valid new-view arrives in time, the replica moves on to the
+// next view with a doubled timeout.
+
+// max times the view change timeout is doubled
+const maxTimeoutShift = 5
+
+func (rep *Replica) startTimer(timeout time.Duration) {
+	rep.stopTimer()
+	rep.timerSeq++
+	seq := rep.timerSeq
+	rep.vcTimer = time.AfterFunc(timeout, func() {
+		rep.mtx.Lock()
+		defer rep.mtx.Unlock()
+		// stopped or restarted after the timer fired
+		if rep.stopped || seq != rep.timerSeq {
+			return
+		}
+		rep.vcTimer = nil
+		plog.Info("view change timeout", "replica", rep.ID, "view", rep.view)
+		rep.requestViewChange(rep.view + 1)
+	})
+}
+
+func (rep *Replica) stopTimer() {
+	if rep.vcTimer != nil {
+		rep.vcTimer.Stop()
+		rep.vcTimer = nil
+	}
+	rep.timerSeq++
+}
+
+// requestExecuted stop the request timer when there is no request waiting,
+// otherwise restart it for the remaining requests
+func (rep *Replica) requestExecuted(digest []byte) {
+	delete(rep.waiting, string(digest))
+	if !rep.activeView {
+		return
+	}
+	if len(rep.waiting) == 0 {
+		rep.stopTimer()
+		return
+	}
+	if !rep.isPrimary(rep.ID) {
+		rep.startTimer(rep.timeout)
+	}
+}
+
+// certificates collect the latest pre-prepare and the latest prepared
+// certificate of each sequence between the water marks
+func (rep *Replica) certificates() (preps []*pb.Entry, prePreps []*pb.Entry) {
+	latestPrePrep := make(map[uint32]*pb.Entry)
+	latestPrep := make(map[uint32]*pb.Entry)
+	for _, req := range rep.requests["pre-prepare"] {
+		v := req.GetPreprepare().View
+		s := req.GetPreprepare().Sequence
+		d := req.GetPreprepare().Digest
+		if !rep.sequenceInRange(s) {
+			continue
+		}
+		if e, ok := latestPrePrep[s]; !ok || v > e.View {
+			latestPrePrep[s] = ToEntry(s, d, v)
+		}
+		if !rep.prepared(v, s, d) {
+			continue
+		}
+		if e, ok := latestPrep[s]; !ok || v > e.View {
+			latestPrep[s] = ToEntry(s, d, v)
+		}
+	}
+	for _, e := range latestPrePrep {
+		prePreps = append(prePreps, e)
+	}
+	for _, e := range latestPrep {
+		preps = append(preps, e)
+	}
+	sortEntries(prePreps)
+	sortEntries(preps)
+	return preps, prePreps
+}
+
+func sortEntries(entries []*pb.Entry) {
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Sequence < entries[j].Sequence
+	})
+}
+
+// requestViewChange leave the current view and ask for a new primary
+func (rep *Replica) requestViewChange(view uint32) {
+	if view <= rep.view {
+		return
+	}
+	rep.view = view
+	rep.activeView = false
+
+	preps, prePreps := rep.certificates()
+	req := ToRequestViewChange(
+		view,
+		rep.lowWaterMark(),
+		[]*pb.Checkpoint{rep.lastStable()},
+		preps,
+		prePreps,
+		rep.ID)
+
+	// the new-view timer, doubled every time a view change fails
+	shift := view - rep.lastActiveView - 1
+	if shift > maxTimeoutShift {
+		shift = maxTimeoutShift
+	}
+	rep.startTimer(rep.timeout << shift)
+
+	plog.Info("request view change", "replica", rep.ID, "view", view)
+	go func() {
+		rep.requestChan <- req
+	}()
+}
+
+func (rep *Replica) validViewChange(reqViewChange *pb.RequestViewChange) bool {
+	view := reqViewChange.View
+	sequence := reqViewChange.Sequence
+	if rep.replicaAddr(reqViewChange.Replica) == "" {
+		return false
+	}
+	inRange := func(s uint32) bool {
+		return s > sequence && s <= sequence+CheckPointPeriod*ConstantFactor
+	}
+
+	stable := false
+	for _, checkpoint := range reqViewChange.GetCheckpoints() {
+		if checkpoint.Sequence == sequence {
+			stable = true
+		}
+	}
+	if !stable {
+		return false
+	}
+
+	for _, prePrep := range reqViewChange.GetPrepreps() {
+		if prePrep.View >= view || !inRange(prePrep.Sequence) {
+			return false
+		}
+	}
+
+	// a prepared certificate should come with the pre-prepare of it
+	for _, prep := range reqViewChange.GetPreps() {
+		if prep.View >= view || !inRange(prep.Sequence) {
+			return false
+		}
+		matched := false
+		for _, prePrep := range reqViewChange.GetPrepreps() {
+			if prePrep.Sequence == prep.Sequence && prePrep.View >= prep.View {
+				matched = prePrep.View > prep.View || EQ(prePrep.Digest, prep.Digest)
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
+func (rep *Replica) handleRequestViewChange(REQ *pb.Request) {
+
+	reqViewChange := REQ.GetViewchange()
+	view := reqViewChange.View
+
+	if view < rep.view || (view == rep.view && rep.activeView) {
+		return
+	}
+
+	if !rep.validViewChange(reqViewChange) {
+		plog.Error("invalid view change", "replica", reqViewChange.Replica, "view", view)
+		return
+	}
+
+	if rep.hasRequest(REQ) {
+		return
+	}
+
+	rep.logRequest(REQ)
+
+	req := ToRequestAck(
+		view,
+		rep.ID,
+		reqViewChange.Replica,
+		ReqDigest(REQ))
+
+	go func() {
+		rep.requestChan <- req
+	}()
+
+	rep.joinViewChange()
+
+	if rep.ID == rep.newPrimary(view) {
+		rep.acceptViewChange(view, reqViewChange.Replica)
+		return
+	}
+
+	// the new-view may arrive before the view-changes it references
+	for _, req := range rep.requests["new-view"] {
+		if req.GetNewview().View == view {
+			rep.processNewView(req)
+			return
+		}
+	}
+}
+
+// joinViewChange move to the smallest view when f+1 replicas are changing
+// to views higher than the current one, no need to wait for the timer
+func (rep *Replica) joinViewChange() {
+	var view uint32
+	replicas := make(map[uint32]bool)
+	for _, req := range rep.requests["view-change"] {
+		v := req.GetViewchange().View
+		if v <= rep.view {
+			continue
+		}
+		replicas[req.GetViewchange().Replica] = true
+		if view == 0 || v < view {
+			view = v
+		}
+	}
+	if rep.overOneThird(len(replicas)) {
+		rep.requestViewChange(view)
+	}
+}
+
+func (rep *Replica) handleRequestAck(REQ *pb.Request) {
+
+	view := REQ.GetAck().View
+
+	if rep.ID != rep.newPrimary(view) {
+		return
+	}
+
+	if view < rep.view || (view == rep.view && rep.activeView) {
+		return
+	}
+
+	if rep.hasRequest(REQ) {
+		return
+	}
+
+	rep.logRequest(REQ)
+
+	rep.acceptViewChange(view, REQ.GetAck().Viewchanger)
+}
+
+// acceptViewChange the new primary accepts a view-change once 2f replicas
+// acked the same message, and sends new-view after 2f+1 are accepted
+func (rep *Replica) acceptViewChange(view, viewchanger uint32) {
+
+	for _, req := range rep.pendingVC {
+		if req.GetViewchange().View == view && req.GetViewchange().Replica == viewchanger {
+			return
+		}
+	}
+
+	var reqViewChange *pb.Request
+	for _, req := range rep.requests["view-change"] {
+		v := req.GetViewchange().View
+		vc := req.GetViewchange().Replica
+		if v == view && vc == viewchanger {
+			reqViewChange = req
+			break
+		}
+	}
+	if reqViewChange == nil {
+		return
+	}
+
+	digest := ReqDigest(reqViewChange)
+	replicas := make(map[uint32]bool)
+	for _, req := range rep.requests["ack"] {
+		v := req.GetAck().View
+		vc := req.GetAck().Viewchanger
+		d := req.GetAck().Digest
+		if v == view && vc == viewchanger && EQ(d, digest) {
+			replicas[req.GetAck().Replica] = true
+		}
+	}
+	if !rep.twoThirds(len(replicas)) {
+		return
+	}
+
+	if err := rep.logPendingVC(reqViewChange); err != nil {
+		plog.Error("logPendingVC", "err", err)
+		return
+	}
+
+	rep.requestNewView(view)
+}
+
+func (rep *Replica) requestNewView(view uint32) {
+
+	if view != rep.view || rep.activeView {
+		return
+	}
+
+	var requests []*pb.Request
+	for _, req := range rep.pendingVC {
+		if req.GetViewchange().View == view {
+			requests = append(requests, req)
+		}
+	}
+	if !rep.overTwoThirds(len(requests)) {
+		return
+	}
+
+	sort.Slice(requests, func(i, j int) bool {
+		return requests[i].GetViewchange().Replica < requests[j].GetViewchange().Replica
+	})
+	viewChanges := make([]*pb.ViewChange, len(requests))
+	for idx, req := range requests {
+		viewChanges[idx] = ToViewChange(req.GetViewchange().Replica, ReqDigest(req))
+	}
+	req := ToRequestNewView(view, viewChanges, rep.summaries(requests), rep.ID)
+
+	if rep.hasRequest(req) {
+		return
+	}
+
+	rep.logRequest(req)
+
+	rep.processNewView(req)
+}
+
+// summaries select the requests to re-propose in the new view. For each
+// sequence after the highest stable checkpoint, the prepared certificate with
+// the highest view is selected, as long as f+1 view-changes carry the same
+// pre-prepare, so that a faulty replica can not make up a certificate.
+func (rep *Replica) summaries(requests []*pb.Request) []*pb.Summary {
+	var start uint32
+	for _, req := range requests {
+		if s := req.GetViewchange().Sequence; s > start {
+			start = s
+		}
+	}
+
+	selected := make(map[uint32]*pb.Entry)
+	for _, req := range requests {
+		for _, prep := range req.GetViewchange().GetPreps() {
+			if prep.Sequence <= start {
+				continue
+			}
+			if e, ok := selected[prep.Sequence]; ok && e.View >= prep.View {
+				continue
+			}
+			count := 0
+			for _, other := range requests {
+				for _, prePrep := range other.GetViewchange().GetPrepreps() {
+					if prePrep.Sequence == prep.Sequence && prePrep.View == prep.View && EQ(prePrep.Digest, prep.Digest) {
+						count++
+						break
+					}
+				}
+			}
+			if rep.overOneThird(count) {
+				selected[prep.Sequence] = prep
+			}
+		}
+	}
+
+	summaries := make([]*pb.Summary, 0, len(selected))
+	for s, e := range selected {
+		summaries = append(summaries, ToSummary(s, e.Digest))
+	}
+	sort.Slice(summaries, func(i, j int) bool {
+		return summaries[i].Sequence < summaries[j].Sequence
+	})
+	return summaries
+}
+
+// correctViewChanges returns the view-changes referenced by the new-view, or
+// nil if any of them is not received yet
+func (rep *Replica) correctViewChanges(reqNewView *pb.RequestNewView) []*pb.Request {
+	var requests []*pb.Request
+	viewchangers := make(map[uint32]bool)
+	for _, vc := range reqNewView.GetViewchanges() {
+		if viewchangers[vc.Viewchanger] {
+			return nil
+		}
+		viewchangers[vc.Viewchanger] = true
+		var found *pb.Request
+		for _, req := range rep.requests["view-change"] {
+			v := req.GetViewchange().View
+			r := req.GetViewchange().Replica
+			if v == reqNewView.View && r == vc.Viewchanger && EQ(ReqDigest(req), vc.Digest) {
+				found = req
+				break
+			}
+		}
+		if found == nil {
+			return nil
+		}
+		requests = append(requests, found)
+	}
+	if !rep.overTwoThirds(len(requests)) {
+		return nil
+	}
+	return requests
+}
+
+func (rep *Replica) correctSummaries(requests []*pb.Request, summaries []*pb.Summary) bool {
+	expected := rep.summaries(requests)
+	if len(expected) != len(summaries) {
+		return false
+	}
+	for idx, summary := range summaries {
+		if summary.Sequence != expected[idx].Sequence || !EQ(summary.Digest, expected[idx].Digest) {
+			return false
+		}
+	}
+	return true
+}
+
+func (rep *Replica) handleRequestNewView(REQ *pb.Request) {
+
+	reqNewView := REQ.GetNewview()
+	view := reqNewView.View
+
+	if view < rep.view || (view == rep.view && rep.activeView) {
+		return
+	}
+
+	if reqNewView.Replica != rep.newPrimary(view) {
+		return
+	}
+
+	if rep.hasRequest(REQ) {
+		return
+	}
+
+	rep.logRequest(REQ)
+
+	rep.processNewView(REQ)
+}
+
+// processNewView verify the new-view and enter the view, the prepares of the
+// re-proposed requests are sent after the new-view by the new primary
+func (rep *Replica) processNewView(REQ *pb.Request) bool {
+
+	reqNewView := REQ.GetNewview()
+	view := reqNewView.View
+
+	if view < rep.view || (view == rep.view && rep.activeView) {
+		return false
+	}
+
+	requests := rep.correctViewChanges(reqNewView)
+	if requests == nil {
+		return false
+	}
+
+	if !rep.correctSummaries(requests, reqNewView.GetSummaries()) {
+		plog.Error("invalid new view summaries", "primary", reqNewView.Replica, "view", view)
+		return false
+	}
+
+	rep.view = view
+	rep.activeView = true
+	rep.lastActiveView = view
+	rep.stopTimer()
+
+	var pendingVC []*pb.Request
+	for _, req := range rep.pendingVC {
+		if req.GetViewchange().View > view {
+			pendingVC = append(pendingVC, req)
+		}
+	}
+	rep.pendingVC = pendingVC
+
+	var msgs []*pb.Request
+	if rep.isPrimary(rep.ID) {
+		msgs = append(msgs, REQ)
+	}
+
+	proposed := make(map[string]bool)
+	for _, summary := range reqNewView.GetSummaries() {
+		proposed[string(summary.Digest)] = true
+		if summary.Sequence > rep.sequence {
+			rep.sequence = summary.Sequence
+		}
+		prePrep := ToRequestPreprepare(view, summary.Sequence, summary.Digest, reqNewView.Replica)
+		if !rep.hasRequest(prePrep) {
+			rep.logRequest(prePrep)
+		}
+	}
+
+	if rep.isPrimary(rep.ID) {
+		// re-propose the client requests the old primary left behind
+		for _, req := range rep.requests["client"] {
+			d := ReqDigest(req)
+			if !rep.waiting[string(d)] || proposed[string(d)] {
+				continue
+			}
+			proposed[string(d)] = true
+			rep.sequence++
+			prePrep := ToRequestPreprepare(view, rep.sequence, d, rep.ID)
+			rep.logRequest(prePrep)
+			msgs = append(msgs, prePrep)
+		}
+	} else if len(rep.waiting) > 0 {
+		rep.startTimer(rep.timeout)
+	}
+
+	// prepare the re-proposed requests and the pre-prepares arrived before the new-view
+	for _, req := range rep.requests["pre-prepare"] {
+		reqPrePrepare := req.GetPreprepare()
+		if reqPrePrepare.View != view || reqPrePrepare.Replica != reqNewView.Replica {
+			continue
+		}
+		prep := ToRequestPrepare(view, reqPrePrepare.Sequence, reqPrePrepare.Digest, rep.ID)
+		if !rep.hasRequest(prep) {
+			rep.logRequest(prep)
+			msgs = append(msgs, prep)
+		}
+	}
+
+	plog.Info("new view done", "replica", rep.ID, "view", view)
+	go func() {
+		for _, req := range msgs {
+			rep.requestChan <- req
+		}
+	}()
+	return true
+}
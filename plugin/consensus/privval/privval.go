@@ -0,0 +1,390 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package privval implements the remote signer shared by qbft and tendermint.
+// The validator key is kept by the remote signer only, validator nodes ask it
+// to sign votes, proposals and heartbeats over an authenticated connection.
+package privval
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/common/log/log15"
+)
+
+var plog = log15.New("module", "privval")
+
+const (
+	signerDialTimeout = 3 * time.Second
+	signerReadTimeout = 5 * time.Second
+	maxSignerMsgSize  = 1024 * 1024
+)
+
+// ErrSignerPubKey remote signer is not the validator
+var ErrSignerPubKey = errors.New("remote signer pubkey not match")
+
+// Msg is the request and response between validator node and remote signer.
+// Request carries one of vote, proposal and heartbeat encoded by the consensus, response carries the signature or the error.
+type Msg struct {
+	ChainID   string          `json:"chainID,omitempty"`
+	Vote      json.RawMessage `json:"vote,omitempty"`
+	Proposal  json.RawMessage `json:"proposal,omitempty"`
+	Heartbeat json.RawMessage `json:"heartbeat,omitempty"`
+	Signature []byte          `json:"signature,omitempty"`
+	Error     string          `json:"error,omitempty"`
+}
+
+// Conn is the authenticated connection between validator node and remote signer
+type Conn interface {
+	io.ReadWriteCloser
+	SetDeadline(t time.Time) error
+	RemotePubKey() crypto.PubKey
+}
+
+// Handshake authenticates the connection by the local key, each consensus uses its own secret connection
+type Handshake func(conn net.Conn, key crypto.PrivKey) (Conn, error)
+
+// SignFunc signs the request by the validator key and returns the signature
+type SignFunc func(req *Msg) ([]byte, error)
+
+func writeMsg(w io.Writer, msg *Msg) error {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	buf := make([]byte, 4+len(data))
+	binary.BigEndian.PutUint32(buf, uint32(len(data)))
+	copy(buf[4:], data)
+	_, err = w.Write(buf)
+	return err
+}
+
+func readMsg(r io.Reader) (*Msg, error) {
+	var head [4]byte
+	if _, err := io.ReadFull(r, head[:]); err != nil {
+		return nil, err
+	}
+	size := binary.BigEndian.Uint32(head[:])
+	if size > maxSignerMsgSize {
+		return nil, fmt.Errorf("signer msg too large: %d", size)
+	}
+	data := make([]byte, size)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, err
+	}
+	msg := &Msg{}
+	if err := json.Unmarshal(data, msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+func splitAddr(addr string) (string, string) {
+	if parts := strings.SplitN(addr, "://", 2); len(parts) == 2 {
+		return parts[0], parts[1]
+	}
+	return "tcp", addr
+}
+
+// Listen listens on tcp://host:port, unix:///path or host:port
+func Listen(addr string) (net.Listener, error) {
+	network, address := splitAddr(addr)
+	if network == "unix" {
+		// remove the socket file left by last run
+		_ = os.Remove(address)
+	}
+	return net.Listen(network, address)
+}
+
+// keyText is the same as KeyText of the consensus priv_validator.json
+type keyText struct {
+	Kind string `json:"type"`
+	Data string `json:"data"`
+}
+
+// LoadOrGenNodeKey loads the node key from the filePath or else generates a new one by cr.
+// The node key is used for p2p connection and the remote signer connection, it can't sign votes.
+func LoadOrGenNodeKey(filePath string, cr crypto.Crypto, cryptoName string) (crypto.PrivKey, error) {
+	if data, err := ioutil.ReadFile(filePath); err == nil {
+		var key keyText
+		if err := json.Unmarshal(data, &key); err != nil {
+			return nil, err
+		}
+		bkey, err := hex.DecodeString(key.Data)
+		if err != nil {
+			return nil, err
+		}
+		return cr.PrivKeyFromBytes(bkey)
+	}
+	priv, err := cr.GenKey()
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(&keyText{Kind: cryptoName, Data: fmt.Sprintf("%X", priv.Bytes())})
+	if err != nil {
+		return nil, err
+	}
+	if err := writeFileAtomic(filePath, data, 0600); err != nil {
+		return nil, err
+	}
+	return priv, nil
+}
+
+func writeFileAtomic(filePath string, data []byte, mode os.FileMode) error {
+	f, err := ioutil.TempFile(filepath.Dir(filePath), "")
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(data)
+	if err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if permErr := os.Chmod(f.Name(), mode); err == nil {
+		err = permErr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), filePath)
+	}
+	if err != nil {
+		_ = os.Remove(f.Name())
+	}
+	return err
+}
+
+// Client sends the sign requests to the remote signer and records the last signed height/round/step.
+// It keeps no validator key, the remote signer is responsible for preventing double signing.
+type Client struct {
+	signerAddr string
+	nodeKey    crypto.PrivKey
+	pubKey     crypto.PubKey
+	handshake  Handshake
+
+	mtx        sync.Mutex
+	conn       Conn
+	lastHeight int64
+	lastRound  int
+	lastStep   int8
+}
+
+// NewClient returns a Client, the remote signer must hold the private key of pubKey.
+// The connection is made at the first signing.
+func NewClient(signerAddr string, pubKey crypto.PubKey, nodeKey crypto.PrivKey, handshake Handshake) *Client {
+	return &Client{
+		signerAddr: signerAddr,
+		nodeKey:    nodeKey,
+		pubKey:     pubKey,
+		handshake:  handshake,
+	}
+}
+
+// GetPubKey returns the public key of the validator.
+func (c *Client) GetPubKey() crypto.PubKey {
+	return c.pubKey
+}
+
+// SignerAddr returns the address of the remote signer.
+func (c *Client) SignerAddr() string {
+	return c.signerAddr
+}
+
+// GetLastHeight ...
+func (c *Client) GetLastHeight() int64 {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	return c.lastHeight
+}
+
+// GetLastRound ...
+func (c *Client) GetLastRound() int {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	return c.lastRound
+}
+
+// GetLastStep ...
+func (c *Client) GetLastStep() int8 {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	return c.lastStep
+}
+
+// ResetLastHeight only resets the local record, the remote signer keeps its own height/round/step.
+func (c *Client) ResetLastHeight(height int64) {
+	c.SetLastHRS(height, 0, 0)
+}
+
+// SetLastHRS records the last signed height/round/step.
+func (c *Client) SetLastHRS(height int64, round int, step int8) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.lastHeight = height
+	c.lastRound = round
+	c.lastStep = step
+}
+
+// Sign sends the request to remote signer and returns the signature, reconnects once if the connection is broken.
+func (c *Client) Sign(req *Msg) ([]byte, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	var resp *Msg
+	var err error
+	for i := 0; i < 2; i++ {
+		if c.conn == nil {
+			if err = c.connect(); err != nil {
+				plog.Error("connect remote signer fail", "addr", c.signerAddr, "err", err)
+				continue
+			}
+		}
+		resp, err = c.roundTrip(req)
+		if err == nil {
+			break
+		}
+		plog.Error("request remote signer fail", "addr", c.signerAddr, "err", err)
+		c.conn.Close()
+		c.conn = nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if resp.Error != "" {
+		return nil, errors.New(resp.Error)
+	}
+	return resp.Signature, nil
+}
+
+func (c *Client) connect() error {
+	network, address := splitAddr(c.signerAddr)
+	conn, err := net.DialTimeout(network, address, signerDialTimeout)
+	if err != nil {
+		return err
+	}
+	sc, err := c.handshake(conn, c.nodeKey)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	if !bytes.Equal(sc.RemotePubKey().Bytes(), c.pubKey.Bytes()) {
+		sc.Close()
+		return ErrSignerPubKey
+	}
+	c.conn = sc
+	return nil
+}
+
+func (c *Client) roundTrip(req *Msg) (*Msg, error) {
+	err := c.conn.SetDeadline(time.Now().Add(signerReadTimeout))
+	if err != nil {
+		return nil, err
+	}
+	if err := writeMsg(c.conn, req); err != nil {
+		return nil, err
+	}
+	return readMsg(c.conn)
+}
+
+// Server holds the validator key and serves the sign requests of authorized validator nodes.
+type Server struct {
+	privKey   crypto.PrivKey
+	chainID   string
+	allowed   map[string]bool
+	handshake Handshake
+	sign      SignFunc
+}
+
+// NewServer returns a Server, allowed is the list of node pubkeys which can connect to the signer.
+// Only the requests of chainID are signed, sign must persist the last signed height/round/step.
+func NewServer(privKey crypto.PrivKey, chainID string, allowed []string, handshake Handshake, sign SignFunc) *Server {
+	s := &Server{
+		privKey:   privKey,
+		chainID:   chainID,
+		allowed:   make(map[string]bool),
+		handshake: handshake,
+		sign:      sign,
+	}
+	for _, pub := range allowed {
+		s.allowed[strings.ToUpper(pub)] = true
+	}
+	return s
+}
+
+// Serve accepts the connections from validator nodes until the listener is closed.
+func (s *Server) Serve(ln net.Listener) error {
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			return err
+		}
+		go s.handleConn(conn)
+	}
+}
+
+func (s *Server) handleConn(conn net.Conn) {
+	defer conn.Close()
+	// the signer connection is authenticated by the validator key
+	sc, err := s.handshake(conn, s.privKey)
+	if err != nil {
+		plog.Error("remote signer handshake fail", "remote", conn.RemoteAddr(), "err", err)
+		return
+	}
+	remote := sc.RemotePubKey().KeyString()
+	if !s.allowed[strings.ToUpper(remote)] {
+		plog.Error("remote signer reject connection", "remote", conn.RemoteAddr(), "pubkey", remote)
+		return
+	}
+	plog.Info("remote signer accept connection", "remote", conn.RemoteAddr(), "pubkey", remote)
+	for {
+		req, err := readMsg(sc)
+		if err != nil {
+			if err != io.EOF {
+				plog.Error("remote signer read fail", "remote", conn.RemoteAddr(), "err", err)
+			}
+			return
+		}
+		resp := s.handleRequest(req)
+		if err := writeMsg(sc, resp); err != nil {
+			plog.Error("remote signer write fail", "remote", conn.RemoteAddr(), "err", err)
+			return
+		}
+	}
+}
+
+func (s *Server) handleRequest(req *Msg) (resp *Msg) {
+	resp = &Msg{}
+	// never crash the signer by a malformed request
+	defer func() {
+		if r := recover(); r != nil {
+			plog.Error("remote signer sign panic", "err", r)
+			resp = &Msg{Error: fmt.Sprintf("sign panic: %v", r)}
+		}
+	}()
+	if req.ChainID != s.chainID {
+		resp.Error = fmt.Sprintf("invalid chainID %v", req.ChainID)
+		return resp
+	}
+	sig, err := s.sign(req)
+	if err != nil {
+		plog.Error("remote signer sign fail", "err", err)
+		resp.Error = err.Error()
+		return resp
+	}
+	resp.Signature = sig
+	return resp
+}
@@ -0,0 +1,122 @@
+package privval
+
+import (
+	"errors"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	_ "github.com/33cn/chain33/system/crypto/init"
+	_ "github.com/33cn/plugin/plugin/crypto/init"
+)
+
+type plainConn struct {
+	net.Conn
+	remote crypto.PubKey
+}
+
+func (c *plainConn) RemotePubKey() crypto.PubKey {
+	return c.remote
+}
+
+// plainHandshake 只交换公钥, 用于测试
+func plainHandshake(cr crypto.Crypto) Handshake {
+	return func(conn net.Conn, key crypto.PrivKey) (Conn, error) {
+		local := key.PubKey().Bytes()
+		if _, err := conn.Write(local); err != nil {
+			return nil, err
+		}
+		remote := make([]byte, len(local))
+		if _, err := conn.Read(remote); err != nil {
+			return nil, err
+		}
+		pub, err := cr.PubKeyFromBytes(remote)
+		if err != nil {
+			return nil, err
+		}
+		return &plainConn{Conn: conn, remote: pub}, nil
+	}
+}
+
+func TestRemoteSigner(t *testing.T) {
+	cr, err := crypto.Load(types.GetSignName("", types.ED25519), -1)
+	require.Nil(t, err)
+	dir, err := ioutil.TempDir("", "privval")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	nodeKey, err := LoadOrGenNodeKey(filepath.Join(dir, "node_key.json"), cr, "ed25519")
+	require.Nil(t, err)
+	// 重新加载得到同一个节点私钥
+	key, err := LoadOrGenNodeKey(filepath.Join(dir, "node_key.json"), cr, "ed25519")
+	require.Nil(t, err)
+	assert.Equal(t, nodeKey.Bytes(), key.Bytes())
+
+	privKey, err := cr.GenKey()
+	require.Nil(t, err)
+	chainID := "chain33-test"
+	var signed []*Msg
+	server := NewServer(privKey, chainID, []string{nodeKey.PubKey().KeyString()}, plainHandshake(cr),
+		func(req *Msg) ([]byte, error) {
+			if string(req.Vote) == `"conflict"` {
+				return nil, errors.New("conflicting vote")
+			}
+			if len(req.Heartbeat) > 0 {
+				panic("bad heartbeat")
+			}
+			signed = append(signed, req)
+			return privKey.Sign(req.Vote).Bytes(), nil
+		})
+	ln, err := Listen("tcp://127.0.0.1:0")
+	require.Nil(t, err)
+	defer ln.Close()
+	go server.Serve(ln)
+	addr := "tcp://" + ln.Addr().String()
+
+	client := NewClient(addr, privKey.PubKey(), nodeKey, plainHandshake(cr))
+	sig, err := client.Sign(&Msg{ChainID: chainID, Vote: []byte(`"vote"`)})
+	require.Nil(t, err)
+	assert.Equal(t, privKey.Sign([]byte(`"vote"`)).Bytes(), sig)
+	require.Len(t, signed, 1)
+	assert.Equal(t, chainID, signed[0].ChainID)
+
+	// 签名函数返回的错误和异常都返回给客户端, 连接依然可用
+	_, err = client.Sign(&Msg{ChainID: chainID, Vote: []byte(`"conflict"`)})
+	assert.EqualError(t, err, "conflicting vote")
+	_, err = client.Sign(&Msg{ChainID: chainID, Heartbeat: []byte(`"heartbeat"`)})
+	assert.NotNil(t, err)
+	// 其他链或者未指定链的请求
+	_, err = client.Sign(&Msg{ChainID: "chain33-other", Vote: []byte(`"vote"`)})
+	assert.NotNil(t, err)
+	_, err = client.Sign(&Msg{Vote: []byte(`"vote"`)})
+	assert.NotNil(t, err)
+	assert.Len(t, signed, 1)
+
+	client.SetLastHRS(10, 1, 2)
+	assert.Equal(t, int64(10), client.GetLastHeight())
+	assert.Equal(t, 1, client.GetLastRound())
+	assert.Equal(t, int8(2), client.GetLastStep())
+	client.ResetLastHeight(5)
+	assert.Equal(t, int64(5), client.GetLastHeight())
+	assert.Equal(t, int8(0), client.GetLastStep())
+
+	// 未授权的节点
+	otherKey, err := cr.GenKey()
+	require.Nil(t, err)
+	client = NewClient(addr, privKey.PubKey(), otherKey, plainHandshake(cr))
+	_, err = client.Sign(&Msg{ChainID: chainID, Vote: []byte(`"vote"`)})
+	assert.NotNil(t, err)
+
+	// 签名服务不是配置的验证者
+	client = NewClient(addr, otherKey.PubKey(), nodeKey, plainHandshake(cr))
+	_, err = client.Sign(&Msg{ChainID: chainID, Vote: []byte(`"vote"`)})
+	assert.Equal(t, ErrSignerPubKey, err)
+	assert.Len(t, signed, 1)
+}
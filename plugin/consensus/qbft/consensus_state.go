@@ -65,6 +65,7 @@ type ConsensusState struct {
 	// services for creating and executing blocks
 	// TODO: encapsulate all of this in one "BlockManager"
 	blockExec *BlockExecutor
+	evpool    *EvidencePool
 
 	// internal state
 	mtx siasync.TryMutex
@@ -101,6 +102,7 @@ func NewConsensusState(client *Client, state State, blockExec *BlockExecutor) *C
 	cs := &ConsensusState{
 		client:           client,
 		blockExec:        blockExec,
+		evpool:           blockExec.evpool,
 		peerMsgQueue:     make(chan MsgInfo, msgQueueSize),
 		internalMsgQueue: make(chan MsgInfo, msgQueueSize),
 		timeoutTicker:    NewTimeoutTicker(),
@@ -506,6 +508,8 @@ func (cs *ConsensusState) handleMsg(mi MsgInfo) {
 		// We could make note of this and help filter in broadcastHasVoteMessage().
 	case *tmtypes.QbftAggVote:
 		err = cs.tryAddAggVote(msg, peerID)
+	case *tmtypes.QbftDuplicateVoteEvidence:
+		err = cs.tryAddEvidence(&ttypes.DuplicateVoteEvidence{QbftDuplicateVoteEvidence: msg}, peerIP)
 	default:
 		qbftlog.Error("Unknown msg type", msg.String(), "peerid", peerID, "peerip", peerIP)
 	}
@@ -848,7 +852,8 @@ func (cs *ConsensusState) createProposalBlock() (block *ttypes.QbftBlock) {
 	}
 
 	proposerAddr := cs.privValidator.GetAddress()
-	block = cs.state.MakeBlock(cs.Height, int64(cs.Round), pblock, commit, proposerAddr)
+	evidence := cs.evpool.PendingEvidence(cs.state)
+	block = cs.state.MakeBlock(cs.Height, int64(cs.Round), pblock, commit, evidence, proposerAddr)
 	baseTx := cs.createBaseTx(block.QbftBlock)
 	if baseTx == nil {
 		qbftlog.Error("createProposalBlock createBaseTx fail")
@@ -1270,20 +1275,37 @@ func (cs *ConsensusState) finalizeCommit(height int64) {
 	stateCopy.LastResultsHash = reqblock.Hash(cs.client.GetAPI().GetConfig())
 
 	//check whether need update validator nodes
+	var updates []*tmtypes.QbftNode
 	qbftNodes, err := cs.client.QueryValidatorsByHeight(block.Header.Height)
 	if err == nil && qbftNodes != nil {
-		if len(qbftNodes.Nodes) > 0 {
-			qbftlog.Info("finalizeCommit validators of statecopy update", "update-qbftNodes", qbftNodes)
-			prevValSet := stateCopy.LastValidators.Copy()
-			nextValSet := prevValSet.Copy()
-			err := updateValidators(nextValSet, qbftNodes.Nodes)
-			if err != nil {
-				qbftlog.Error("Error changing validator set", "error", err)
+		updates = append(updates, qbftNodes.Nodes...)
+	}
+	// validators slashed for double sign are recorded in the receipt of block info tx
+	slashed, err := cs.client.QuerySlashedByHeight(block.Header.Height)
+	if err != nil {
+		qbftlog.Error("finalizeCommit QuerySlashedByHeight fail", "height", block.Header.Height, "err", err)
+	}
+	for _, node := range slashed {
+		// slashing overrides the update of the same validator in this block
+		for i := 0; i < len(updates); i++ {
+			if updates[i].PubKey == node.PubKey {
+				updates = append(updates[:i], updates[i+1:]...)
+				i--
 			}
-			// change results from this height but only applies to the next height
-			stateCopy.LastHeightValidatorsChanged = block.Header.Height + 1
-			stateCopy.Validators = nextValSet
 		}
+		updates = append(updates, node)
+	}
+	if len(updates) > 0 {
+		qbftlog.Info("finalizeCommit validators of statecopy update", "update-qbftNodes", updates)
+		prevValSet := stateCopy.LastValidators.Copy()
+		nextValSet := prevValSet.Copy()
+		err := updateValidators(nextValSet, updates)
+		if err != nil {
+			qbftlog.Error("Error changing validator set", "error", err)
+		}
+		// change results from this height but only applies to the next height
+		stateCopy.LastHeightValidatorsChanged = block.Header.Height + 1
+		stateCopy.Validators = nextValSet
 	}
 	qbftlog.Debug("finalizeCommit validators of statecopy", "validators", stateCopy.Validators.String())
 
@@ -1596,12 +1618,13 @@ func (cs *ConsensusState) tryAddVote(voteRaw *tmtypes.QbftVote, peerID string, p
 		// If it's otherwise invalid, punish peer.
 		if err == ErrVoteHeightMismatch {
 			return err
-		} else if err == ttypes.ErrVoteConflict {
+		} else if voteErr, ok := err.(*ttypes.ErrVoteConflictingVotes); ok {
 			if bytes.Equal(vote.ValidatorAddress, cs.privValidator.GetAddress()) {
 				qbftlog.Error("Found conflicting vote from ourselves. Did you unsafe_reset a validator?",
 					"height", vote.Height, "round", vote.Round, "type", vote.Type)
 				return err
 			}
+			return cs.tryAddEvidence(voteErr.DuplicateVoteEvidence, peerIP)
 		} else {
 			// Probably an invalid signature / Bad peer.
 			// Seems this can also err sometimes with "Unexpected step" - perhaps not from a bad peer ?
@@ -1612,6 +1635,19 @@ func (cs *ConsensusState) tryAddVote(voteRaw *tmtypes.QbftVote, peerID string, p
 	return nil
 }
 
+// Add the evidence to the pool and gossip it if it is new
+func (cs *ConsensusState) tryAddEvidence(evidence *ttypes.DuplicateVoteEvidence, peerIP string) error {
+	added, err := cs.evpool.AddEvidence(evidence)
+	if err != nil {
+		qbftlog.Error("Error attempting to add evidence", "err", err, "peerip", peerIP)
+		return err
+	}
+	if added {
+		cs.broadcastChannel <- MsgInfo{TypeID: ttypes.EvidenceID, Msg: evidence.QbftDuplicateVoteEvidence, PeerID: "", PeerIP: ""}
+	}
+	return nil
+}
+
 //-----------------------------------------------------------------------------
 
 func (cs *ConsensusState) addVote(vote *ttypes.Vote, peerID string, peerIP string) (added bool, err error) {
@@ -0,0 +1,135 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package qbft
+
+import (
+	"fmt"
+	"sync"
+
+	ttypes "github.com/33cn/plugin/plugin/consensus/qbft/types"
+	tmtypes "github.com/33cn/plugin/plugin/dapp/qbftNode/types"
+)
+
+// maxEvidencePerBlock 单个区块最多打包的双签证据数量
+const maxEvidencePerBlock = 10
+
+// EvidencePool maintains a pool of valid evidence to be broadcasted and committed
+type EvidencePool struct {
+	stateDB *CSStateDB
+
+	mtx sync.Mutex
+	// 等待打包的证据, 按收到的顺序打包
+	pending     []*ttypes.DuplicateVoteEvidence
+	pendingKeys map[string]bool
+	// 已经打包的证据及其双签高度, 超过MaxAge之后清理
+	committed map[string]int64
+}
+
+// NewEvidencePool returns a new EvidencePool
+func NewEvidencePool(stateDB *CSStateDB) *EvidencePool {
+	return &EvidencePool{
+		stateDB:     stateDB,
+		pendingKeys: make(map[string]bool),
+		committed:   make(map[string]int64),
+	}
+}
+
+// AddEvidence checks the evidence is valid and adds it to the pool.
+// Returns added=false if the evidence is already known.
+func (evpool *EvidencePool) AddEvidence(evidence *ttypes.DuplicateVoteEvidence) (added bool, err error) {
+	key := string(evidence.Hash())
+	evpool.mtx.Lock()
+	defer evpool.mtx.Unlock()
+	if evpool.pendingKeys[key] {
+		return false, nil
+	}
+	if _, ok := evpool.committed[key]; ok {
+		return false, nil
+	}
+	if err := verifyEvidence(evpool.stateDB.GetState(), evidence); err != nil {
+		return false, err
+	}
+	evpool.pending = append(evpool.pending, evidence)
+	evpool.pendingKeys[key] = true
+	qbftlog.Info("Add duplicate vote evidence", "evidence", evidence)
+	return true, nil
+}
+
+// PendingEvidence returns the evidence which is still valid for the next block.
+func (evpool *EvidencePool) PendingEvidence(s State) []*tmtypes.QbftDuplicateVoteEvidence {
+	evpool.mtx.Lock()
+	defer evpool.mtx.Unlock()
+	var list []*tmtypes.QbftDuplicateVoteEvidence
+	valid := evpool.pending[:0]
+	for _, evidence := range evpool.pending {
+		if err := verifyEvidence(s, evidence); err != nil {
+			qbftlog.Info("Remove invalid evidence from pool", "evidence", evidence, "err", err)
+			delete(evpool.pendingKeys, string(evidence.Hash()))
+			continue
+		}
+		valid = append(valid, evidence)
+		if len(list) < maxEvidencePerBlock {
+			list = append(list, evidence.QbftDuplicateVoteEvidence)
+		}
+	}
+	evpool.pending = valid
+	return list
+}
+
+// IsCommitted returns true if the evidence has already been committed.
+func (evpool *EvidencePool) IsCommitted(evidence *ttypes.DuplicateVoteEvidence) bool {
+	evpool.mtx.Lock()
+	defer evpool.mtx.Unlock()
+	_, ok := evpool.committed[string(evidence.Hash())]
+	return ok
+}
+
+// MarkEvidenceAsCommitted marks all the evidence in the block as committed and removes it from the pending list.
+func (evpool *EvidencePool) MarkEvidenceAsCommitted(s State, block *ttypes.QbftBlock) {
+	evpool.mtx.Lock()
+	defer evpool.mtx.Unlock()
+	for _, item := range block.Evidence {
+		evidence := &ttypes.DuplicateVoteEvidence{QbftDuplicateVoteEvidence: item}
+		evpool.committed[string(evidence.Hash())] = evidence.Height()
+	}
+	pending := evpool.pending[:0]
+	for _, evidence := range evpool.pending {
+		key := string(evidence.Hash())
+		if _, ok := evpool.committed[key]; ok {
+			delete(evpool.pendingKeys, key)
+			continue
+		}
+		pending = append(pending, evidence)
+	}
+	evpool.pending = pending
+
+	maxAge := s.ConsensusParams.EvidenceParams.MaxAge
+	if maxAge <= 0 {
+		return
+	}
+	for key, height := range evpool.committed {
+		if block.Header.Height-height > maxAge {
+			delete(evpool.committed, key)
+		}
+	}
+}
+
+// verifyEvidence verifies the evidence against the validators of the next block.
+func verifyEvidence(s State, evidence *ttypes.DuplicateVoteEvidence) error {
+	height := s.LastBlockHeight + 1
+	evHeight := evidence.Height()
+	if evHeight > height {
+		return fmt.Errorf("Evidence from future height %d, current height %d", evHeight, height)
+	}
+	maxAge := s.ConsensusParams.EvidenceParams.MaxAge
+	if maxAge > 0 && height-evHeight > maxAge {
+		return fmt.Errorf("Evidence from height %d is too old. Min height is %d", evHeight, height-maxAge)
+	}
+	_, val := s.Validators.GetByAddress(evidence.Address())
+	if val == nil {
+		return fmt.Errorf("Address %X is not a validator at height %d", evidence.Address(), height)
+	}
+	return evidence.Verify(s.ChainID, val.PubKey)
+}
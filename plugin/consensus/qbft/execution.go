@@ -23,13 +23,17 @@ import (
 type BlockExecutor struct {
 	// save state, validators, consensus params, abci responses here
 	db *CSStateDB
+
+	// manage the evidence of double sign
+	evpool *EvidencePool
 }
 
 // NewBlockExecutor returns a new BlockExecutor with a NopEventBus.
 // Call SetEventBus to provide one.
-func NewBlockExecutor(db *CSStateDB) *BlockExecutor {
+func NewBlockExecutor(db *CSStateDB, evpool *EvidencePool) *BlockExecutor {
 	return &BlockExecutor{
-		db: db,
+		db:     db,
+		evpool: evpool,
 	}
 }
 
@@ -38,7 +42,16 @@ func NewBlockExecutor(db *CSStateDB) *BlockExecutor {
 // Validation does not mutate state, but does require historical information from the stateDB,
 // ie. to verify evidence from a validator at an old height.
 func (blockExec *BlockExecutor) ValidateBlock(s State, block *ttypes.QbftBlock) error {
-	return validateBlock(blockExec.db, s, block)
+	if err := validateBlock(blockExec.db, s, block); err != nil {
+		return err
+	}
+	for _, item := range block.Evidence {
+		evidence := &ttypes.DuplicateVoteEvidence{QbftDuplicateVoteEvidence: item}
+		if blockExec.evpool.IsCommitted(evidence) {
+			return fmt.Errorf("Evidence was already committed: %v", evidence)
+		}
+	}
+	return nil
 }
 
 // ApplyBlock validates the block against the state, executes it against the app,
@@ -58,6 +71,7 @@ func (blockExec *BlockExecutor) ApplyBlock(s State, blockID ttypes.BlockID, bloc
 	}
 
 	blockExec.db.SaveState(s)
+	blockExec.evpool.MarkEvidenceAsCommitted(s, block)
 	return s, nil
 }
 
@@ -255,5 +269,22 @@ func validateBlock(stateDB *CSStateDB, s State, b *ttypes.QbftBlock) error {
 		}
 	}
 
+	// Validate all evidence.
+	if len(b.Evidence) > maxEvidencePerBlock {
+		return fmt.Errorf("Too much evidence. Max %d, got %d", maxEvidencePerBlock, len(b.Evidence))
+	}
+	evidenceKeys := make(map[string]bool)
+	for _, item := range b.Evidence {
+		evidence := &ttypes.DuplicateVoteEvidence{QbftDuplicateVoteEvidence: item}
+		key := string(evidence.Hash())
+		if evidenceKeys[key] {
+			return fmt.Errorf("Duplicate evidence in block: %v", evidence)
+		}
+		evidenceKeys[key] = true
+		if err := verifyEvidence(s, evidence); err != nil {
+			return fmt.Errorf("Invalid evidence: %v", err)
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,147 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package qbft
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/plugin/plugin/consensus/privval"
+	ttypes "github.com/33cn/plugin/plugin/consensus/qbft/types"
+)
+
+// signerHandshake authenticates the remote signer connection by the qbft secret connection
+func signerHandshake(conn net.Conn, key crypto.PrivKey) (privval.Conn, error) {
+	return MakeSecretConnection(conn, key)
+}
+
+// LoadOrGenNodeKey loads the node key from the filePath or else generates a new one.
+func LoadOrGenNodeKey(filePath string) (crypto.PrivKey, error) {
+	return privval.LoadOrGenNodeKey(filePath, ttypes.ConsensusCrypto, ttypes.CryptoName)
+}
+
+// PrivValidatorSocket implements PrivValidator by asking the remote signer to sign.
+type PrivValidatorSocket struct {
+	*privval.Client
+	address []byte
+}
+
+// NewPrivValidatorSocket returns a PrivValidatorSocket, the remote signer must hold the private key of signerPubKey.
+func NewPrivValidatorSocket(signerAddr string, signerPubKey string, nodeKey crypto.PrivKey) (*PrivValidatorSocket, error) {
+	pubKey, err := ttypes.PubKeyFromString(signerPubKey)
+	if err != nil {
+		return nil, err
+	}
+	return &PrivValidatorSocket{
+		Client:  privval.NewClient(signerAddr, pubKey, nodeKey, signerHandshake),
+		address: ttypes.GenAddressByPubKey(pubKey),
+	}, nil
+}
+
+// GetAddress returns the address of the validator.
+func (pv *PrivValidatorSocket) GetAddress() []byte {
+	return pv.address
+}
+
+// SignVote asks the remote signer to sign the vote.
+func (pv *PrivValidatorSocket) SignVote(chainID string, vote *ttypes.Vote) error {
+	data, err := json.Marshal(vote)
+	if err != nil {
+		return err
+	}
+	sig, err := pv.Sign(&privval.Msg{ChainID: chainID, Vote: data})
+	if err != nil {
+		return fmt.Errorf("Error signing vote: %v", err)
+	}
+	vote.Signature = sig
+	// step of prevote and precommit is vote type + 1, the same as local PrivValidator
+	pv.SetLastHRS(vote.Height, int(vote.Round), int8(vote.Type)+1)
+	return nil
+}
+
+// SignProposal asks the remote signer to sign the proposal.
+func (pv *PrivValidatorSocket) SignProposal(chainID string, proposal *ttypes.Proposal) error {
+	data, err := json.Marshal(proposal)
+	if err != nil {
+		return err
+	}
+	sig, err := pv.Sign(&privval.Msg{ChainID: chainID, Proposal: data})
+	if err != nil {
+		return fmt.Errorf("Error signing proposal: %v", err)
+	}
+	proposal.Signature = sig
+	pv.SetLastHRS(proposal.Height, int(proposal.Round), 1)
+	return nil
+}
+
+// SignHeartbeat asks the remote signer to sign the heartbeat.
+func (pv *PrivValidatorSocket) SignHeartbeat(chainID string, heartbeat *ttypes.Heartbeat) error {
+	data, err := json.Marshal(heartbeat)
+	if err != nil {
+		return err
+	}
+	sig, err := pv.Sign(&privval.Msg{ChainID: chainID, Heartbeat: data})
+	if err != nil {
+		return fmt.Errorf("Error signing heartbeat: %v", err)
+	}
+	heartbeat.Signature = sig
+	return nil
+}
+
+// String returns a string representation of the PrivValidatorSocket.
+func (pv *PrivValidatorSocket) String() string {
+	return fmt.Sprintf("PrivValidatorSocket{%X %v}", pv.address, pv.SignerAddr())
+}
+
+// NewRemoteSigner returns the remote signer holding the validator key, allowed is the list of node pubkeys which can connect to it.
+// It persists the last signed height/round/step, so it never signs conflicting votes or proposals.
+func NewRemoteSigner(privValidator *ttypes.PrivValidatorImp, chainID string, allowed []string) *privval.Server {
+	privValidator.SetPersistSigned(true)
+	return privval.NewServer(privValidator.PrivKey, chainID, allowed, signerHandshake, func(req *privval.Msg) ([]byte, error) {
+		return signRequest(privValidator, req)
+	})
+}
+
+func signRequest(pv *ttypes.PrivValidatorImp, req *privval.Msg) ([]byte, error) {
+	switch {
+	case len(req.Vote) > 0:
+		vote := &ttypes.Vote{}
+		if err := json.Unmarshal(req.Vote, vote); err != nil {
+			return nil, err
+		}
+		if vote.QbftVote == nil || !ttypes.IsVoteTypeValid(byte(vote.Type)) {
+			return nil, errors.New("invalid vote")
+		}
+		if err := pv.SignVote(req.ChainID, vote); err != nil {
+			return nil, err
+		}
+		return vote.Signature, nil
+	case len(req.Proposal) > 0:
+		proposal := &ttypes.Proposal{}
+		if err := json.Unmarshal(req.Proposal, proposal); err != nil {
+			return nil, err
+		}
+		if err := pv.SignProposal(req.ChainID, proposal); err != nil {
+			return nil, err
+		}
+		return proposal.Signature, nil
+	case len(req.Heartbeat) > 0:
+		heartbeat := &ttypes.Heartbeat{}
+		if err := json.Unmarshal(req.Heartbeat, heartbeat); err != nil {
+			return nil, err
+		}
+		if heartbeat.QbftHeartbeat == nil {
+			return nil, errors.New("invalid heartbeat")
+		}
+		if err := pv.SignHeartbeat(req.ChainID, heartbeat); err != nil {
+			return nil, err
+		}
+		return heartbeat.Signature, nil
+	}
+	return nil, errors.New("empty sign request")
+}
@@ -50,6 +50,9 @@ var (
 	emptyBlockInterval    atomic.Value // 0  second
 	genesisFile                        = "genesis.json"
 	privFile                           = "priv_validator.json"
+	nodeKeyFile                        = "node_key.json"
+	signerAddr                         = ""
+	signerPubKey                       = ""
 	dbPath                             = fmt.Sprintf("datadir%sqbft", string(os.PathSeparator))
 	port                  int32        = DefaultQbftPort
 	validatorNodes                     = []string{"127.0.0.1:33001"}
@@ -116,6 +119,9 @@ type subConfig struct {
 	MessageInterval       int32    `json:"messageInterval"`
 	DetachExecution       bool     `json:"detachExecution"`
 	SameBlocktime         bool     `json:"sameBlocktime"`
+	SignerAddr            string   `json:"signerAddr"`
+	SignerPubKey          string   `json:"signerPubKey"`
+	NodeKeyFile           string   `json:"nodeKeyFile"`
 }
 
 func applyConfig(cfg *types.Consensus, sub []byte) {
@@ -146,6 +152,12 @@ func applyConfig(cfg *types.Consensus, sub []byte) {
 	if subcfg.PrivFile != "" {
 		privFile = subcfg.PrivFile
 	}
+	// 配置了远程签名服务时, 验证者私钥不保存在本节点
+	signerAddr = subcfg.SignerAddr
+	signerPubKey = subcfg.SignerPubKey
+	if subcfg.NodeKeyFile != "" {
+		nodeKeyFile = subcfg.NodeKeyFile
+	}
 	if subcfg.DbPath != "" {
 		dbPath = subcfg.DbPath
 	}
@@ -234,14 +246,31 @@ func New(cfg *types.Consensus, sub []byte) queue.Module {
 		qbftlog.Error("load genesis file fail", "error", err)
 		return nil
 	}
-	privValidator := ttypes.LoadPrivValidatorFS(privFile)
-	if privValidator == nil {
-		qbftlog.Error("load priv_validator file fail")
-		return nil
+	var privValidator ttypes.PrivValidator
+	var privKey crypto.PrivKey
+	if signerAddr != "" {
+		// the node key signs p2p handshake and blockInfo tx, votes are signed by the remote signer
+		privKey, err = LoadOrGenNodeKey(nodeKeyFile)
+		if err != nil {
+			qbftlog.Error("load node key file fail", "err", err)
+			return nil
+		}
+		privValidator, err = NewPrivValidatorSocket(signerAddr, signerPubKey, privKey)
+		if err != nil {
+			qbftlog.Error("create remote signer validator fail", "err", err)
+			return nil
+		}
+	} else {
+		privValidatorFS := ttypes.LoadPrivValidatorFS(privFile)
+		if privValidatorFS == nil {
+			qbftlog.Error("load priv_validator file fail")
+			return nil
+		}
+		privValidator, privKey = privValidatorFS, privValidatorFS.PrivKey
 	}
 
 	qbftlog.Info("show qbft info", "version", qbftVersion, "sign", ttypes.CryptoName, "useAggSig", UseAggSig(),
-		"detachExec", DetachExec(), "genesisFile", genesisFile, "privFile", privFile)
+		"detachExec", DetachExec(), "genesisFile", genesisFile, "privFile", privFile, "signerAddr", signerAddr)
 
 	ttypes.InitMessageMap()
 
@@ -253,7 +282,7 @@ func New(cfg *types.Consensus, sub []byte) queue.Module {
 		BaseClient:    c,
 		genesisDoc:    genDoc,
 		privValidator: privValidator,
-		privKey:       privValidator.PrivKey,
+		privKey:       privKey,
 		csStore:       NewConsensusStore(),
 		txsAvailable:  make(chan int64, 1),
 		ctx:           ctx,
@@ -372,8 +401,11 @@ OuterLoop:
 
 	stateDB := NewStateDB(client, state)
 
+	// make evidence pool for double sign of validators
+	evpool := NewEvidencePool(stateDB)
+
 	// make block executor for consensus and blockchain reactors to execute blocks
-	blockExec := NewBlockExecutor(stateDB)
+	blockExec := NewBlockExecutor(stateDB, evpool)
 
 	// Make ConsensusReactor
 	csState := NewConsensusState(client, state, blockExec)
@@ -622,6 +654,40 @@ func (client *Client) QueryValidatorsByHeight(height int64) (*tmtypes.QbftNodes,
 	return msg.GetData().(types.Message).(*tmtypes.QbftNodes), nil
 }
 
+// QuerySlashedByHeight 从区块的执行回执中获取因双签被处罚的验证者, 投票权设置为0
+func (client *Client) QuerySlashedByHeight(height int64) ([]*tmtypes.QbftNode, error) {
+	details, err := client.GetAPI().GetBlocks(&types.ReqBlocks{Start: height, End: height, IsDetail: true})
+	if err != nil {
+		qbftlog.Error("QuerySlashedByHeight GetBlocks", "height", height, "err", err)
+		return nil, err
+	}
+	if len(details.GetItems()) != 1 {
+		return nil, types.ErrBlockNotFound
+	}
+	detail := details.Items[0]
+	var nodes []*tmtypes.QbftNode
+	for i, receipt := range detail.GetReceipts() {
+		if i >= len(detail.Block.Txs) || string(detail.Block.Txs[i].Execer) != "qbftNode" {
+			continue
+		}
+		for _, log := range receipt.GetLogs() {
+			if log.Ty != tmtypes.TyLogQbftNodeSlash {
+				continue
+			}
+			var slashed tmtypes.QbftEvidenceList
+			err = types.Decode(log.Log, &slashed)
+			if err != nil {
+				qbftlog.Error("QuerySlashedByHeight decode", "height", height, "err", err)
+				return nil, err
+			}
+			for _, evidence := range slashed.Evidence {
+				nodes = append(nodes, &tmtypes.QbftNode{PubKey: evidence.GetPubKey(), Power: 0})
+			}
+		}
+	}
+	return nodes, nil
+}
+
 // QueryBlockInfoByHeight get blockInfo and block by height
 func (client *Client) QueryBlockInfoByHeight(height int64) (*tmtypes.QbftBlockInfo, *types.Block, error) {
 	if height < 1 {
@@ -2,7 +2,9 @@ package qbft
 
 import (
 	"fmt"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -16,6 +18,8 @@ import (
 	"github.com/33cn/chain33/types"
 	"github.com/33cn/chain33/util"
 	"github.com/33cn/chain33/util/testnode"
+	"github.com/33cn/plugin/plugin/consensus/privval"
+	ttypes "github.com/33cn/plugin/plugin/consensus/qbft/types"
 	vty "github.com/33cn/plugin/plugin/dapp/qbftNode/types"
 	"github.com/stretchr/testify/assert"
 
@@ -66,6 +70,153 @@ func TestQbft(t *testing.T) {
 	time.Sleep(2 * time.Second)
 }
 
+func TestEvidencePool(t *testing.T) {
+	cr, err := crypto.Load(types.GetSignName("", ttypes.SignMap["ed25519"]), -1)
+	require.Nil(t, err)
+	defer func(c crypto.Crypto) { ttypes.ConsensusCrypto = c }(ttypes.ConsensusCrypto)
+	ttypes.ConsensusCrypto = cr
+	priv, err := cr.GenKey()
+	require.Nil(t, err)
+	val := ttypes.NewValidator(priv.PubKey(), 10)
+	state := State{
+		ChainID:         "chain33-qbft-test",
+		LastBlockHeight: 9,
+		Validators:      ttypes.NewValidatorSet([]*ttypes.Validator{val}),
+		ConsensusParams: *ttypes.DefaultConsensusParams(),
+	}
+	evpool := NewEvidencePool(NewStateDB(nil, state))
+
+	signVote := func(height int64, hash []byte) *ttypes.Vote {
+		vote := &ttypes.Vote{QbftVote: &vty.QbftVote{
+			ValidatorAddress: val.Address,
+			Height:           height,
+			Round:            0,
+			Type:             uint32(ttypes.VoteTypePrevote),
+			BlockID:          &vty.QbftBlockID{Hash: hash},
+		}}
+		vote.Signature = priv.Sign(ttypes.SignBytes(state.ChainID, vote)).Bytes()
+		return vote
+	}
+
+	// 同一个区块的两次投票不是双签
+	evidence := ttypes.NewDuplicateVoteEvidence(val.PubKey, signVote(10, []byte("block1")), signVote(10, []byte("block1")))
+	_, err = evpool.AddEvidence(evidence)
+	assert.Equal(t, ttypes.ErrEvidenceSameBlock, err)
+
+	// 签名无效
+	voteB := signVote(9, []byte("block2"))
+	voteB.Height = 10
+	evidence = ttypes.NewDuplicateVoteEvidence(val.PubKey, signVote(10, []byte("block1")), voteB)
+	_, err = evpool.AddEvidence(evidence)
+	assert.Equal(t, ttypes.ErrVoteInvalidSignature, err)
+
+	// 超过MaxAge的证据
+	state.ConsensusParams.EvidenceParams.MaxAge = 5
+	evpool = NewEvidencePool(NewStateDB(nil, state))
+	evidence = ttypes.NewDuplicateVoteEvidence(val.PubKey, signVote(2, []byte("block1")), signVote(2, []byte("block2")))
+	_, err = evpool.AddEvidence(evidence)
+	assert.NotNil(t, err)
+
+	evidence = ttypes.NewDuplicateVoteEvidence(val.PubKey, signVote(10, []byte("block2")), signVote(10, []byte("block1")))
+	added, err := evpool.AddEvidence(evidence)
+	assert.Nil(t, err)
+	assert.True(t, added)
+	added, err = evpool.AddEvidence(evidence)
+	assert.Nil(t, err)
+	assert.False(t, added)
+	pending := evpool.PendingEvidence(state)
+	assert.Len(t, pending, 1)
+
+	block := state.MakeBlock(1, 0, &types.Block{Height: 1}, &vty.QbftCommit{}, pending, val.Address)
+	assert.Equal(t, ttypes.EvidenceHash(pending), block.Header.EvidenceHash)
+	assert.Nil(t, block.ValidateBasic())
+	block.Evidence = nil
+	assert.NotNil(t, block.ValidateBasic())
+	block.Evidence = pending
+
+	evpool.MarkEvidenceAsCommitted(state, block)
+	assert.True(t, evpool.IsCommitted(evidence))
+	assert.Len(t, evpool.PendingEvidence(state), 0)
+	added, err = evpool.AddEvidence(evidence)
+	assert.Nil(t, err)
+	assert.False(t, added)
+}
+
+func TestRemoteSigner(t *testing.T) {
+	cr, err := crypto.Load(types.GetSignName("", ttypes.SignMap["ed25519"]), -1)
+	require.Nil(t, err)
+	defer func(c crypto.Crypto) { ttypes.ConsensusCrypto = c }(ttypes.ConsensusCrypto)
+	ttypes.ConsensusCrypto = cr
+	dir, err := ioutil.TempDir("", "qbft-signer")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	pv := ttypes.GenPrivValidatorImp(filepath.Join(dir, "priv_validator.json"))
+	nodeKey, err := LoadOrGenNodeKey(filepath.Join(dir, "node_key.json"))
+	require.Nil(t, err)
+	// 重新加载得到同一个节点私钥
+	key, err := LoadOrGenNodeKey(filepath.Join(dir, "node_key.json"))
+	require.Nil(t, err)
+	assert.Equal(t, nodeKey.Bytes(), key.Bytes())
+
+	chainID := "chain33-qbft-test"
+	signer := NewRemoteSigner(pv, chainID, []string{nodeKey.PubKey().KeyString()})
+	ln, err := privval.Listen("tcp://127.0.0.1:0")
+	require.Nil(t, err)
+	defer ln.Close()
+	go signer.Serve(ln)
+	addr := "tcp://" + ln.Addr().String()
+
+	newVote := func(hash []byte) *ttypes.Vote {
+		return &ttypes.Vote{QbftVote: &vty.QbftVote{
+			ValidatorAddress: pv.GetAddress(),
+			Height:           10,
+			Round:            0,
+			Timestamp:        time.Now().UnixNano(),
+			Type:             uint32(ttypes.VoteTypePrevote),
+			BlockID:          &vty.QbftBlockID{Hash: hash},
+		}}
+	}
+
+	pvs, err := NewPrivValidatorSocket(addr, pv.GetPubKey().KeyString(), nodeKey)
+	require.Nil(t, err)
+	assert.Equal(t, pv.GetAddress(), pvs.GetAddress())
+	vote := newVote([]byte("block1"))
+	require.Nil(t, pvs.SignVote(chainID, vote))
+	assert.Nil(t, vote.Verify(chainID, pv.GetPubKey()))
+	assert.Equal(t, int64(10), pvs.GetLastHeight())
+	assert.Equal(t, int8(2), pvs.GetLastStep())
+
+	// 同一高度轮次对不同区块投票, 签名服务拒绝签名
+	assert.NotNil(t, pvs.SignVote(chainID, newVote([]byte("block2"))))
+	// 其他链的投票
+	assert.NotNil(t, pvs.SignVote("chain33-other", newVote([]byte("block1"))))
+
+	// 签名服务重启后依然拒绝冲突的投票
+	signer2 := NewRemoteSigner(ttypes.LoadPrivValidatorFS(filepath.Join(dir, "priv_validator.json")), chainID,
+		[]string{nodeKey.PubKey().KeyString()})
+	ln2, err := privval.Listen("tcp://127.0.0.1:0")
+	require.Nil(t, err)
+	defer ln2.Close()
+	go signer2.Serve(ln2)
+	pvs, err = NewPrivValidatorSocket("tcp://"+ln2.Addr().String(), pv.GetPubKey().KeyString(), nodeKey)
+	require.Nil(t, err)
+	assert.NotNil(t, pvs.SignVote(chainID, newVote([]byte("block2"))))
+
+	// 未授权的节点
+	otherKey, err := cr.GenKey()
+	require.Nil(t, err)
+	pvs, err = NewPrivValidatorSocket(addr, pv.GetPubKey().KeyString(), otherKey)
+	require.Nil(t, err)
+	assert.NotNil(t, pvs.SignVote(chainID, newVote([]byte("block3"))))
+
+	// 签名服务不是配置的验证者
+	pvs, err = NewPrivValidatorSocket(addr, otherKey.PubKey().KeyString(), nodeKey)
+	require.Nil(t, err)
+	err = pvs.SignVote(chainID, newVote([]byte("block3")))
+	assert.NotNil(t, err)
+}
+
 func startNode(t *testing.T) {
 	cfg2 := types.NewChain33Config(types.ReadFile("chain33.qbft.toml"))
 	sub := cfg2.GetSubConfig()
@@ -150,9 +150,9 @@ func (s State) StringIndented(indent string) string {
 // Create a block from the latest state
 
 // MakeBlock builds a block with the given txs and commit from the current state.
-func (s State) MakeBlock(height int64, round int64, pblock *types.Block, commit *tmtypes.QbftCommit, proposerAddr []byte) *ttypes.QbftBlock {
+func (s State) MakeBlock(height int64, round int64, pblock *types.Block, commit *tmtypes.QbftCommit, evidence []*tmtypes.QbftDuplicateVoteEvidence, proposerAddr []byte) *ttypes.QbftBlock {
 	// build base block
-	block := ttypes.MakeBlock(height, round, pblock, commit)
+	block := ttypes.MakeBlock(height, round, pblock, commit, evidence)
 
 	// fill header with state data
 	block.Header.ChainID = s.ChainID
@@ -264,6 +264,13 @@ func (csdb *CSStateDB) SaveState(state State) {
 	csdb.state = state.Copy()
 }
 
+// GetState from state cache
+func (csdb *CSStateDB) GetState() State {
+	csdb.mtx.Lock()
+	defer csdb.mtx.Unlock()
+	return csdb.state.Copy()
+}
+
 // LoadState convert external state to internal state
 func LoadState(state *tmtypes.QbftState) State {
 	stateTmp := State{
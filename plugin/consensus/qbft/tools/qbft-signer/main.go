@@ -0,0 +1,59 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// qbft-signer 是qbft验证者的远程签名服务, 验证者私钥只保存在签名服务所在的主机,
+// 验证者节点配置signerAddr和signerPubKey后通过认证加密的连接请求签名,
+// 每次签名后持久化最新的高度, 轮次和阶段, 重启后也不会对冲突的投票或提议签名
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/plugin/plugin/consensus/privval"
+	"github.com/33cn/plugin/plugin/consensus/qbft"
+	ttypes "github.com/33cn/plugin/plugin/consensus/qbft/types"
+
+	_ "github.com/33cn/chain33/system/crypto/init"
+	_ "github.com/33cn/plugin/plugin/crypto/init"
+)
+
+func main() {
+	privFile := flag.String("priv", "priv_validator.json", "validator key file, generated if not exist")
+	laddr := flag.String("laddr", "tcp://127.0.0.1:33010", "listen address, tcp://host:port or unix:///path")
+	allow := flag.String("allow", "", "comma separated pubkeys of the validator nodes allowed to connect")
+	signName := flag.String("sign", "ed25519", "sign type, the same as signName of qbft config")
+	chainID := flag.String("chain", "", "the chain id to sign for, required")
+	flag.Parse()
+
+	if *chainID == "" {
+		log.Fatal("Must provide the chain id by -chain")
+	}
+	if *allow == "" {
+		log.Fatal("Must provide the pubkeys of validator nodes by -allow")
+	}
+	signType, ok := ttypes.SignMap[*signName]
+	if !ok {
+		log.Fatalf("Invalid sign name %v", *signName)
+	}
+	ttypes.CryptoName = types.GetSignName("", signType)
+	cr, err := crypto.Load(ttypes.CryptoName, -1)
+	if err != nil {
+		log.Fatalf("Load crypto %v failed: %v", ttypes.CryptoName, err)
+	}
+	ttypes.ConsensusCrypto = cr
+
+	privValidator := ttypes.LoadOrGenPrivValidatorFS(*privFile)
+	signer := qbft.NewRemoteSigner(privValidator, *chainID, strings.Split(*allow, ","))
+	ln, err := privval.Listen(*laddr)
+	if err != nil {
+		log.Fatalf("Listen %v failed: %v", *laddr, err)
+	}
+	fmt.Printf("qbft signer listen on %v, validator pubkey %v\n", *laddr, privValidator.GetPubKey().KeyString())
+	log.Fatal(signer.Serve(ln))
+}
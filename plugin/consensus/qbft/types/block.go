@@ -59,7 +59,7 @@ type QbftBlock struct {
 
 // MakeBlock returns a new block with an empty header, except what can be computed from itself.
 // It populates the same set of fields validated by ValidateBasic
-func MakeBlock(height int64, round int64, pblock *types.Block, commit *tmtypes.QbftCommit) *QbftBlock {
+func MakeBlock(height int64, round int64, pblock *types.Block, commit *tmtypes.QbftCommit, evidence []*tmtypes.QbftDuplicateVoteEvidence) *QbftBlock {
 	block := &QbftBlock{
 		&tmtypes.QbftBlock{
 			Header: &tmtypes.QbftBlockHeader{
@@ -70,6 +70,7 @@ func MakeBlock(height int64, round int64, pblock *types.Block, commit *tmtypes.Q
 			},
 			Data:       pblock,
 			LastCommit: commit,
+			Evidence:   evidence,
 		},
 	}
 	block.FillHeader()
@@ -111,6 +112,10 @@ func (b *QbftBlock) ValidateBasic() error {
 		}
 	}
 
+	if !bytes.Equal(b.Header.EvidenceHash, EvidenceHash(b.Evidence)) {
+		return fmt.Errorf("Wrong Header.EvidenceHash.  Expected %v, got %v", b.Header.EvidenceHash, EvidenceHash(b.Evidence))
+	}
+
 	return nil
 }
 
@@ -122,6 +127,9 @@ func (b *QbftBlock) FillHeader() {
 		}
 		b.Header.LastCommitHash = lastCommit.Hash()
 	}
+	if b.Header.EvidenceHash == nil {
+		b.Header.EvidenceHash = EvidenceHash(b.Evidence)
+	}
 }
 
 // Hash computes and returns the block hash.
@@ -0,0 +1,140 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/33cn/chain33/common/crypto"
+	tmtypes "github.com/33cn/plugin/plugin/dapp/qbftNode/types"
+)
+
+// error defines
+var (
+	ErrEvidenceNil          = errors.New("Nil evidence")
+	ErrEvidenceInvalidVotes = errors.New("Invalid evidence votes")
+	ErrEvidenceSameBlock    = errors.New("Evidence votes for the same block")
+	ErrEvidencePubKey       = errors.New("Evidence pubkey not match validator")
+)
+
+// ErrVoteConflictingVotes is returned by VoteSet.AddVote when a validator signs two different votes.
+type ErrVoteConflictingVotes struct {
+	*DuplicateVoteEvidence
+}
+
+func (err *ErrVoteConflictingVotes) Error() string {
+	return fmt.Sprintf("Conflicting votes from validator %X", err.Address())
+}
+
+// NewConflictingVoteError ...
+func NewConflictingVoteError(val *Validator, voteA, voteB *Vote) *ErrVoteConflictingVotes {
+	return &ErrVoteConflictingVotes{
+		DuplicateVoteEvidence: NewDuplicateVoteEvidence(val.PubKey, voteA, voteB),
+	}
+}
+
+// DuplicateVoteEvidence contains evidence a validator signed two conflicting votes.
+type DuplicateVoteEvidence struct {
+	*tmtypes.QbftDuplicateVoteEvidence
+}
+
+// NewDuplicateVoteEvidence orders the votes by block hash, so the same pair always makes the same evidence.
+func NewDuplicateVoteEvidence(pubKey []byte, voteA, voteB *Vote) *DuplicateVoteEvidence {
+	if bytes.Compare(voteA.GetBlockID().GetHash(), voteB.GetBlockID().GetHash()) > 0 {
+		voteA, voteB = voteB, voteA
+	}
+	return &DuplicateVoteEvidence{
+		&tmtypes.QbftDuplicateVoteEvidence{
+			PubKey: hex.EncodeToString(pubKey),
+			VoteA:  voteA.QbftVote,
+			VoteB:  voteB.QbftVote,
+		},
+	}
+}
+
+// Height returns the height the duplicate votes were signed at.
+func (evidence *DuplicateVoteEvidence) Height() int64 {
+	return evidence.GetVoteA().GetHeight()
+}
+
+// Address returns the address of the validator.
+func (evidence *DuplicateVoteEvidence) Address() []byte {
+	return evidence.GetVoteA().GetValidatorAddress()
+}
+
+// Hash returns the hash of the evidence.
+func (evidence *DuplicateVoteEvidence) Hash() []byte {
+	if evidence.QbftDuplicateVoteEvidence == nil {
+		return nil
+	}
+	bytes, err := json.Marshal(evidence.QbftDuplicateVoteEvidence)
+	if err != nil {
+		ttlog.Error("evidence hash marshal failed", "err", err)
+		return nil
+	}
+	return crypto.Ripemd160(bytes)
+}
+
+// String returns a string representation of the evidence.
+func (evidence *DuplicateVoteEvidence) String() string {
+	voteA := &Vote{QbftVote: evidence.GetVoteA()}
+	voteB := &Vote{QbftVote: evidence.GetVoteB()}
+	return fmt.Sprintf("DuplicateVoteEvidence{VoteA: %v, VoteB: %v}", voteA, voteB)
+}
+
+// Verify returns an error if the two votes aren't conflicting.
+// To be conflicting, they must be from the same validator, for the same H/R/S, but for different blocks.
+func (evidence *DuplicateVoteEvidence) Verify(chainID string, pubKey []byte) error {
+	if evidence.QbftDuplicateVoteEvidence == nil {
+		return ErrEvidenceNil
+	}
+	voteA, voteB := evidence.GetVoteA(), evidence.GetVoteB()
+	if voteA == nil || voteB == nil {
+		return ErrEvidenceNil
+	}
+	if voteA.Height != voteB.Height || voteA.Round != voteB.Round || voteA.Type != voteB.Type {
+		return errors.New("Evidence votes have different H/R/S")
+	}
+	if !bytes.Equal(voteA.ValidatorAddress, voteB.ValidatorAddress) || voteA.ValidatorIndex != voteB.ValidatorIndex {
+		return ErrEvidenceInvalidVotes
+	}
+	blockA := BlockID{QbftBlockID: voteA.BlockID}
+	blockB := BlockID{QbftBlockID: voteB.BlockID}
+	if blockA.Equals(blockB) {
+		return ErrEvidenceSameBlock
+	}
+	// votes must be ordered, otherwise one conflict could be committed twice
+	if bytes.Compare(voteA.GetBlockID().GetHash(), voteB.GetBlockID().GetHash()) > 0 {
+		return ErrEvidenceInvalidVotes
+	}
+	if evidence.PubKey != hex.EncodeToString(pubKey) {
+		return ErrEvidencePubKey
+	}
+
+	pub, err := ConsensusCrypto.PubKeyFromBytes(pubKey)
+	if err != nil {
+		return err
+	}
+	if err := (&Vote{QbftVote: voteA}).Verify(chainID, pub); err != nil {
+		return err
+	}
+	return (&Vote{QbftVote: voteB}).Verify(chainID, pub)
+}
+
+// EvidenceHash returns the hash of the evidence list in block, nil if there is no evidence.
+func EvidenceHash(evidence []*tmtypes.QbftDuplicateVoteEvidence) []byte {
+	if len(evidence) == 0 {
+		return nil
+	}
+	hashes := make([]byte, 0, len(evidence)*20)
+	for _, item := range evidence {
+		hashes = append(hashes, (&DuplicateVoteEvidence{QbftDuplicateVoteEvidence: item}).Hash()...)
+	}
+	return crypto.Ripemd160(hashes)
+}
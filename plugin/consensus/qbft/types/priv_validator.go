@@ -96,6 +96,9 @@ type PrivValidatorImp struct {
 	// Overloaded for testing.
 	filePath string
 	mtx      sync.Mutex
+
+	// persist every signed height/round/step, used by the remote signer
+	persistSigned bool
 }
 
 // Signer is an interface that defines how to sign messages.
@@ -317,6 +320,14 @@ func (pv *PrivValidatorImp) save() {
 	}
 }
 
+// SetPersistSigned sets whether to persist the height/round/step after signing.
+// The remote signer must persist them to prevent double signing after restart.
+func (pv *PrivValidatorImp) SetPersistSigned(persist bool) {
+	pv.mtx.Lock()
+	defer pv.mtx.Unlock()
+	pv.persistSigned = persist
+}
+
 // Reset resets all fields in the PrivValidatorFS.
 // NOTE: Unsafe!
 func (pv *PrivValidatorImp) Reset() {
@@ -412,6 +423,9 @@ func (pv *PrivValidatorImp) signBytesHRS(height int64, round int, step int8,
 		return nil, err
 	}
 	//pv.saveSigned(height, round, step, signBytes, sig)
+	if pv.persistSigned {
+		pv.saveSigned(height, round, step, signBytes, sig)
+	}
 	return sig, nil
 }
 
@@ -45,6 +45,7 @@ const (
 	ProposalBlockID     = byte(0x09)
 	ValidBlockID        = byte(0x0a)
 	AggVoteID           = byte(0x0b)
+	EvidenceID          = byte(0x0c)
 )
 
 // InitMessageMap ...
@@ -61,6 +62,7 @@ func InitMessageMap() {
 		ProposalBlockID:     reflect.TypeOf(tmtypes.QbftBlock{}),
 		ValidBlockID:        reflect.TypeOf(tmtypes.QbftValidBlockMsg{}),
 		AggVoteID:           reflect.TypeOf(tmtypes.QbftAggVote{}),
+		EvidenceID:          reflect.TypeOf(tmtypes.QbftDuplicateVoteEvidence{}),
 	}
 }
 
@@ -5,7 +5,10 @@
 package raft
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/33cn/chain33/common"
@@ -15,7 +18,11 @@ import (
 	cty "github.com/33cn/chain33/system/dapp/coins/types"
 	"github.com/33cn/chain33/types"
 	"github.com/coreos/etcd/snap"
-	"github.com/golang/protobuf/proto"
+)
+
+var (
+	errSnapshotHash     = errors.New("raft snapshot header hash mismatch")
+	errSnapshotMismatch = errors.New("local chain does not match raft snapshot")
 )
 
 func init() {
@@ -33,6 +40,10 @@ type Client struct {
 	validatorC  <-chan bool
 	ctx         context.Context
 	cancel      context.CancelFunc
+	//尚未校验的snapshot区块头, 等待本地链追上snapshot高度, 只在readCommits中访问
+	snapHeader *types.Header
+	//已校验的snapshot高度, 之前的raft区块已经在本地链中
+	snapHeight int64
 }
 
 // NewBlockstore create Raft Client
@@ -76,18 +87,108 @@ func (client *Client) CheckBlock(parent *types.Block, current *types.BlockDetail
 	return nil
 }
 
+// getSnapshot raft snapshot记录当前区块头, 区块头中的StateHash指向store中对应的状态
 func (client *Client) getSnapshot() ([]byte, error) {
-	return proto.Marshal(client.GetCurrentBlock())
+	block := client.GetCurrentBlock()
+	if block == nil {
+		var err error
+		block, err = client.RequestLastBlock()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return types.Encode(block.GetHeader(client.GetAPI().GetConfig())), nil
+}
+
+// decodeSnapshot 解析snapshot中的区块头, 兼容旧版本直接保存区块的snapshot
+func decodeSnapshot(cfg *types.Chain33Config, data []byte) (*types.Header, error) {
+	header := &types.Header{}
+	if err := types.Decode(data, header); err != nil {
+		return nil, err
+	}
+	if len(header.Hash) == 0 {
+		block := &types.Block{}
+		if err := types.Decode(data, block); err != nil {
+			return nil, err
+		}
+		return block.GetHeader(cfg), nil
+	}
+	if !bytes.Equal(headerHash(cfg, header), header.Hash) {
+		return nil, errSnapshotHash
+	}
+	return header, nil
+}
+
+// headerHash 按照Block.Hash的规则由区块头计算区块哈希
+func headerHash(cfg *types.Chain33Config, header *types.Header) []byte {
+	head := &types.Header{
+		Version:    header.Version,
+		ParentHash: header.ParentHash,
+		TxHash:     header.TxHash,
+		BlockTime:  header.BlockTime,
+		Height:     header.Height,
+	}
+	if cfg.IsFork(header.Height, "ForkBlockHash") {
+		head.Difficulty = header.Difficulty
+		head.StateHash = header.StateHash
+		head.TxCount = header.TxCount
+	}
+	return common.Sha256(types.Encode(head))
 }
 
+// recoverFromSnapshot 校验本地链与snapshot区块头一致, snapshot只记录区块头和状态哈希, 不包含状态数据.
+// 本地链已经包含snapshot高度时立即校验区块哈希和状态哈希, 否则等待blockchain正常同步到snapshot高度后再校验,
+// 新节点仍然需要通过blockchain同步全部区块, 不支持直接从snapshot导入状态快速加入
 func (client *Client) recoverFromSnapshot(snapshot []byte) error {
-	block := &types.Block{}
-	if err := proto.Unmarshal(snapshot, block); err != nil {
+	header, err := decodeSnapshot(client.GetAPI().GetConfig(), snapshot)
+	if err != nil {
+		return err
+	}
+	lastBlock, err := client.RequestLastBlock()
+	if err != nil {
 		return err
 	}
+	client.snapHeader = header
+	if lastBlock.Height >= header.Height {
+		return client.verifySnapshot()
+	}
+	rlog.Info("raft snapshot loaded, verify it after chain reaches snapshot height", "height", header.Height,
+		"blockhash", common.ToHex(header.Hash), "statehash", common.ToHex(header.StateHash), "localHeight", lastBlock.Height)
 	return nil
 }
 
+// verifySnapshot 校验本地snapshot高度的区块哈希和状态哈希与snapshot一致, 校验通过后从snapshot高度继续写入区块
+func (client *Client) verifySnapshot() error {
+	header := client.snapHeader
+	block, err := client.RequestBlock(header.Height)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(block.Hash(client.GetAPI().GetConfig()), header.Hash) || !bytes.Equal(block.StateHash, header.StateHash) {
+		rlog.Error("verifySnapshot", "height", header.Height, "snapHash", common.ToHex(header.Hash),
+			"localHash", common.ToHex(block.Hash(client.GetAPI().GetConfig())), "snapStateHash", common.ToHex(header.StateHash),
+			"localStateHash", common.ToHex(block.StateHash))
+		return errSnapshotMismatch
+	}
+	rlog.Info("raft snapshot verified", "height", header.Height, "statehash", common.ToHex(header.StateHash))
+	client.snapHeight = header.Height
+	client.snapHeader = nil
+	return nil
+}
+
+// loadSnapshot 加载本地最新的snapshot, 包括从leader接收到的snapshot
+func (client *Client) loadSnapshot() error {
+	snapshot, err := client.snapshotter.Load()
+	if err == snap.ErrNoSnapshot {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	rlog.Info(fmt.Sprintf("loading snapshot at term %d and index %d", snapshot.Metadata.Term, snapshot.Metadata.Index))
+	return client.recoverFromSnapshot(snapshot.Data)
+}
+
 // SetQueueClient method
 func (client *Client) SetQueueClient(c queue.Client) {
 	rlog.Info("Enter SetQueue method of raft consensus")
@@ -201,28 +302,21 @@ func (client *Client) propose(block *types.Block) {
 func (client *Client) readCommits(commitC <-chan *types.Block, errorC <-chan error) {
 	var data *types.Block
 	var ok bool
-	cfg := client.GetAPI().GetConfig()
+	if err := client.loadSnapshot(); err != nil {
+		rlog.Error("loadSnapshot fail, stop raft consensus", "err", err)
+		client.Close()
+		return
+	}
 	for {
 		select {
 		case data, ok = <-commitC:
-			if !ok || data == nil {
+			if !ok {
 				break
 			}
-			lastBlock, err := client.RequestLastBlock()
-			if err != nil {
-				rlog.Error("RequestLastBlock fail", "err", err)
-				break
-			}
-			if lastBlock.Height >= data.Height {
-				rlog.Info("already has block", "height", data.Height)
-				break
-			}
-			rlog.Info("Write block", "height", data.Height, "blockhash", common.ToHex(data.Hash(cfg)),
-				"txhash", common.ToHex(data.TxHash))
-			err = client.WriteBlock(nil, data)
-			if err != nil {
-				rlog.Error("WriteBlock fail", "err", err)
-				break
+			if err := client.applyCommit(data); err != nil {
+				rlog.Error("verify raft snapshot fail, stop raft consensus", "err", err)
+				client.Close()
+				return
 			}
 		case err, ok := <-errorC:
 			if ok {
@@ -234,6 +328,45 @@ func (client *Client) readCommits(commitC <-chan *types.Block, errorC <-chan err
 	}
 }
 
+// applyCommit 写入raft提交的区块, 只返回校验snapshot的错误, 写区块失败等待之后的区块或者blockchain同步
+func (client *Client) applyCommit(data *types.Block) error {
+	// raft底层收到leader发送的snapshot
+	if data == nil {
+		return client.loadSnapshot()
+	}
+	if data.Height <= client.snapHeight {
+		rlog.Debug("block already in raft snapshot", "height", data.Height, "snapHeight", client.snapHeight)
+		return nil
+	}
+	lastBlock, err := client.RequestLastBlock()
+	if err != nil {
+		rlog.Error("RequestLastBlock fail", "err", err)
+		return nil
+	}
+	if lastBlock.Height >= data.Height {
+		rlog.Info("already has block", "height", data.Height)
+		return nil
+	}
+	if client.snapHeader != nil {
+		if lastBlock.Height < client.snapHeader.Height {
+			rlog.Info("wait for chain state of raft snapshot", "height", data.Height, "snapHeight", client.snapHeader.Height,
+				"localHeight", lastBlock.Height)
+			return nil
+		}
+		if err := client.verifySnapshot(); err != nil {
+			return err
+		}
+	}
+	cfg := client.GetAPI().GetConfig()
+	rlog.Info("Write block", "height", data.Height, "blockhash", common.ToHex(data.Hash(cfg)),
+		"txhash", common.ToHex(data.TxHash))
+	err = client.WriteBlock(nil, data)
+	if err != nil {
+		rlog.Error("WriteBlock fail", "err", err)
+	}
+	return nil
+}
+
 //轮询任务，去检测本机器是否为validator节点，如果是，则执行打包任务
 func (client *Client) pollingTask() {
 	for {
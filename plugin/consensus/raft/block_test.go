@@ -0,0 +1,185 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package raft
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/types"
+	"github.com/coreos/etcd/raft/raftpb"
+	"github.com/coreos/etcd/snap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeSnapshot(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	block := &types.Block{
+		ParentHash: common.Sha256([]byte("parent")),
+		TxHash:     common.Sha256([]byte("tx")),
+		StateHash:  common.Sha256([]byte("state")),
+		Height:     100,
+		BlockTime:  1600000000,
+		Txs:        []*types.Transaction{{Execer: []byte("none")}},
+	}
+	header := block.GetHeader(cfg)
+
+	decoded, err := decodeSnapshot(cfg, types.Encode(header))
+	require.Nil(t, err)
+	assert.Equal(t, header.Hash, decoded.Hash)
+	assert.Equal(t, block.StateHash, decoded.StateHash)
+	assert.Equal(t, block.Height, decoded.Height)
+
+	// 旧版本snapshot直接保存了区块
+	decoded, err = decodeSnapshot(cfg, types.Encode(block))
+	require.Nil(t, err)
+	assert.Equal(t, header.Hash, decoded.Hash)
+	assert.Equal(t, block.StateHash, decoded.StateHash)
+
+	header.StateHash = common.Sha256([]byte("forged"))
+	_, err = decodeSnapshot(cfg, types.Encode(header))
+	assert.Equal(t, errSnapshotHash, err)
+
+	_, err = decodeSnapshot(cfg, []byte("invalid snapshot"))
+	assert.NotNil(t, err)
+}
+
+// mockChain 模拟blockchain模块, 只处理raft共识用到的消息
+type mockChain struct {
+	sync.Mutex
+	cfg    *types.Chain33Config
+	blocks []*types.Block
+}
+
+func newMockChain(q queue.Queue, blocks []*types.Block) *mockChain {
+	chain := &mockChain{cfg: q.GetConfig(), blocks: blocks}
+	client := q.Client()
+	client.Sub("blockchain")
+	go func() {
+		for msg := range client.Recv() {
+			chain.Lock()
+			switch msg.Ty {
+			case types.EventGetLastBlock:
+				msg.Reply(client.NewMessage("", types.EventBlock, chain.blocks[len(chain.blocks)-1]))
+			case types.EventGetBlocks:
+				req := msg.GetData().(*types.ReqBlocks)
+				if req.Start >= int64(len(chain.blocks)) {
+					msg.Reply(client.NewMessage("", types.EventBlocks, types.ErrBlockNotFound))
+					break
+				}
+				details := &types.BlockDetails{Items: []*types.BlockDetail{{Block: chain.blocks[req.Start]}}}
+				msg.Reply(client.NewMessage("", types.EventBlocks, details))
+			case types.EventAddBlockDetail:
+				detail := msg.GetData().(*types.BlockDetail)
+				tip := chain.blocks[len(chain.blocks)-1]
+				if !bytes.Equal(detail.Block.ParentHash, tip.Hash(chain.cfg)) {
+					msg.Reply(client.NewMessage("", types.EventAddBlockDetail, types.ErrBlockHashNoMatch))
+					break
+				}
+				chain.blocks = append(chain.blocks, detail.Block)
+				msg.Reply(client.NewMessage("", types.EventAddBlockDetail, detail))
+			}
+			chain.Unlock()
+		}
+	}()
+	return chain
+}
+
+func (chain *mockChain) height() int64 {
+	chain.Lock()
+	defer chain.Unlock()
+	return int64(len(chain.blocks)) - 1
+}
+
+func (chain *mockChain) add(block *types.Block) {
+	chain.Lock()
+	defer chain.Unlock()
+	chain.blocks = append(chain.blocks, block)
+}
+
+func genTestBlocks(cfg *types.Chain33Config, n int) []*types.Block {
+	blocks := make([]*types.Block, n)
+	parent := make([]byte, 32)
+	for i := range blocks {
+		blocks[i] = &types.Block{
+			ParentHash: parent,
+			TxHash:     common.Sha256([]byte(fmt.Sprintf("tx%d", i))),
+			StateHash:  common.Sha256([]byte(fmt.Sprintf("state%d", i))),
+			Height:     int64(i),
+			BlockTime:  1600000000 + int64(i),
+		}
+		parent = blocks[i].Hash(cfg)
+	}
+	return blocks
+}
+
+func newSnapshotClient(t *testing.T, localBlocks []*types.Block, snapBlock *types.Block) (*Client, *mockChain) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	q := queue.New("channel")
+	q.SetConfig(cfg)
+	chain := newMockChain(q, localBlocks)
+
+	snapshotter := snap.New(t.TempDir())
+	snapshot := raftpb.Snapshot{Data: types.Encode(snapBlock.GetHeader(cfg))}
+	snapshot.Metadata.Index = 100
+	snapshot.Metadata.Term = 1
+	require.Nil(t, snapshotter.SaveSnap(snapshot))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	client := NewBlockstore(ctx, &types.Consensus{Name: "raft"}, snapshotter, nil, nil, nil, nil, cancel)
+	client.InitClient(q.Client(), func() {})
+	return client, chain
+}
+
+// 本地链已经包含snapshot高度时, 校验通过后从snapshot高度继续写入区块
+func TestRecoverFromSnapshot(t *testing.T) {
+	blocks := genTestBlocks(types.NewChain33Config(types.GetDefaultCfgstring()), 6)
+	client, chain := newSnapshotClient(t, blocks[:4], blocks[3])
+
+	require.Nil(t, client.loadSnapshot())
+	assert.Equal(t, int64(3), client.snapHeight)
+	assert.Nil(t, client.snapHeader)
+
+	// snapshot之前的raft区块已经在本地链中, 不再写入
+	for _, block := range blocks[1:4] {
+		require.Nil(t, client.applyCommit(block))
+	}
+	assert.Equal(t, int64(3), chain.height())
+	require.Nil(t, client.applyCommit(blocks[4]))
+	require.Nil(t, client.applyCommit(blocks[5]))
+	assert.Equal(t, int64(5), chain.height())
+	assert.Equal(t, blocks[5].Hash(client.GetAPI().GetConfig()), client.GetCurrentBlock().Hash(client.GetAPI().GetConfig()))
+}
+
+// 本地链落后于snapshot时等待同步到snapshot高度, 状态不一致时返回错误
+func TestRecoverFromSnapshotWait(t *testing.T) {
+	blocks := genTestBlocks(types.NewChain33Config(types.GetDefaultCfgstring()), 6)
+	client, chain := newSnapshotClient(t, blocks[:3], blocks[3])
+
+	require.Nil(t, client.loadSnapshot())
+	assert.Equal(t, int64(0), client.snapHeight)
+	assert.NotNil(t, client.snapHeader)
+	require.Nil(t, client.applyCommit(blocks[4]))
+	assert.Equal(t, int64(2), chain.height())
+
+	// blockchain同步到snapshot高度后校验并继续写入
+	chain.add(blocks[3])
+	require.Nil(t, client.applyCommit(blocks[4]))
+	assert.Equal(t, int64(3), client.snapHeight)
+	assert.Equal(t, int64(4), chain.height())
+
+	// 本地区块的状态和snapshot不一致时返回错误
+	forged := types.Clone(blocks[3]).(*types.Block)
+	forged.StateHash = common.Sha256([]byte("forged"))
+	client, _ = newSnapshotClient(t, blocks[:4], forged)
+	assert.Equal(t, errSnapshotMismatch, client.loadSnapshot())
+	assert.Equal(t, int64(0), client.snapHeight)
+}
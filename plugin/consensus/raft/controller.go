@@ -43,6 +43,14 @@ type subConfig struct {
 	WriteBlockSeconds  int64  `json:"writeBlockSeconds"`
 	HeartbeatTick      int32  `json:"heartbeatTick"`
 	EmptyBlockInterval int64  `json:"emptyBlockInterval"`
+	// 成员管理接口监听的地址，默认为localhost
+	RaftAPIHost string `json:"raftAPIHost"`
+	// 成员管理接口的TLS证书，配置后成员变更需要使用RaftAPICAFile签发的客户端证书
+	RaftAPICertFile string `json:"raftAPICertFile"`
+	RaftAPIKeyFile  string `json:"raftAPIKeyFile"`
+	RaftAPICAFile   string `json:"raftAPICAFile"`
+	// 允许变更成员的客户端证书CommonName，为空表示不限制
+	RaftAPIAllowedCNs []string `json:"raftAPIAllowedCNs"`
 }
 
 func init() {
@@ -115,9 +123,9 @@ func NewRaftCluster(cfg *types.Consensus, sub []byte) queue.Module {
 	// propose channel
 	proposeC := make(chan *types.Block)
 	confChangeC = make(chan raftpb.ConfChange)
-	commitC, errorC, snapshotterReady, validatorC := NewRaftNode(ctx, int(subcfg.NodeID), subcfg.IsNewJoinNode, peers, readOnlyPeers, addPeers, getSnapshot, proposeC, confChangeC)
-	//启动raft删除节点操作监听
-	go serveHTTPRaftAPI(ctx, int(subcfg.RaftAPIPort), confChangeC, errorC)
+	commitC, errorC, snapshotterReady, validatorC, node := NewRaftNode(ctx, int(subcfg.NodeID), subcfg.IsNewJoinNode, peers, readOnlyPeers, addPeers, getSnapshot, proposeC, confChangeC)
+	//启动raft成员管理接口监听
+	go serveHTTPRaftAPI(ctx, &subcfg, confChangeC, node, errorC)
 	// 监听commit channel,取block
 	b = NewBlockstore(ctx, cfg, <-snapshotterReady, proposeC, commitC, errorC, validatorC, stop)
 	return b
@@ -6,76 +6,280 @@ package raft
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"io/ioutil"
 	"net/http"
+	"net/url"
 	"strconv"
+	"strings"
+	"time"
 
-	"fmt"
-
+	"github.com/coreos/etcd/pkg/idutil"
+	"github.com/coreos/etcd/pkg/wait"
 	"github.com/coreos/etcd/raft/raftpb"
 )
 
+// how long a membership request waits for the conf change to be applied
+var confChangeTimeout = 10 * time.Second
+
+var errConfChangeTimeout = errors.New("conf change not applied before timeout, the result is unknown")
+
+// member of the raft cluster
+type member struct {
+	ID        uint64 `json:"id"`
+	URL       string `json:"url"`
+	IsLearner bool   `json:"isLearner"`
+}
+
+type membersReply struct {
+	Leader  uint64    `json:"leader"`
+	Members []*member `json:"members"`
+}
+
+type confChangeReply struct {
+	Type    string    `json:"type"`
+	NodeID  uint64    `json:"nodeID"`
+	Applied bool      `json:"applied"`
+	Error   string    `json:"error,omitempty"`
+	Members []*member `json:"members,omitempty"`
+}
+
+// raftCluster the membership view of the local raft node
+type raftCluster interface {
+	// members returns the members applied on the local node
+	members() *membersReply
+	// confWait is triggered with the conf change ID once it is applied
+	confWait() wait.Wait
+}
+
 // Handler for a http based httpRaftAPI backed by raft
+//
+//	GET    /members    list the members with their voter/learner status
+//	POST   /<nodeID>   add a voter, the body is the peer URL; add a learner with ?learner=true
+//	PUT    /<nodeID>   promote a learner (read-only peer) to voter
+//	DELETE /<nodeID>   remove a member
+//
+// Membership changes are only allowed with a verified TLS client certificate,
+// and the reply is sent after the conf change is applied on this node.
 type httpRaftAPI struct {
 	confChangeC chan<- raftpb.ConfChange
+	cluster     raftCluster
+	idGen       *idutil.Generator
+	tlsEnabled  bool
+	allowedCNs  map[string]bool
+}
+
+func newHTTPRaftAPI(nodeID int, confChangeC chan<- raftpb.ConfChange, cluster raftCluster, tlsEnabled bool, allowedCNs []string) *httpRaftAPI {
+	h := &httpRaftAPI{
+		confChangeC: confChangeC,
+		cluster:     cluster,
+		idGen:       idutil.NewGenerator(uint16(nodeID), time.Now()),
+		tlsEnabled:  tlsEnabled,
+		allowedCNs:  make(map[string]bool),
+	}
+	for _, cn := range allowedCNs {
+		if cn != "" {
+			h.allowedCNs[cn] = true
+		}
+	}
+	return h
 }
 
 func (h *httpRaftAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	key := r.RequestURI
-	switch {
-	case r.Method == "POST":
-		url, err := ioutil.ReadAll(r.Body)
-		if err != nil {
-			rlog.Error(fmt.Sprintf("Failed to convert ID for conf change (%v)", err.Error()))
-			http.Error(w, "Failed on POST", http.StatusBadRequest)
+	if r.URL.Path == "/members" {
+		if r.Method != "GET" {
+			w.Header().Add("Allow", "GET")
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 			return
 		}
+		writeJSON(w, http.StatusOK, h.cluster.members())
+		return
+	}
+
+	if r.Method != "POST" && r.Method != "PUT" && r.Method != "DELETE" {
+		w.Header().Add("Allow", "POST")
+		w.Header().Add("Allow", "PUT")
+		w.Header().Add("Allow", "DELETE")
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if err := h.authorize(r); err != nil {
+		rlog.Error(fmt.Sprintf("Reject conf change from %s (%v)", r.RemoteAddr, err.Error()))
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+	nodeID, err := strconv.ParseUint(strings.TrimPrefix(r.URL.Path, "/"), 0, 64)
+	if err != nil || nodeID == 0 {
+		rlog.Error(fmt.Sprintf("Failed to convert ID for conf change (%v)", r.URL.Path))
+		http.Error(w, "Invalid node ID", http.StatusBadRequest)
+		return
+	}
 
-		nodeID, err := strconv.ParseUint(key[1:], 0, 64)
+	current := h.findMember(nodeID)
+	cc := raftpb.ConfChange{NodeID: nodeID}
+	switch r.Method {
+	case "POST":
+		body, err := ioutil.ReadAll(r.Body)
 		if err != nil {
-			rlog.Error(fmt.Sprintf("Failed to convert ID for conf change (%v)", err.Error()))
 			http.Error(w, "Failed on POST", http.StatusBadRequest)
 			return
 		}
-
-		cc := raftpb.ConfChange{
-			Type:    raftpb.ConfChangeAddNode,
-			NodeID:  nodeID,
-			Context: url,
+		peerURL := strings.TrimSpace(string(body))
+		if u, err := url.Parse(peerURL); err != nil || u.Host == "" {
+			http.Error(w, "Invalid peer URL", http.StatusBadRequest)
+			return
 		}
-		h.confChangeC <- cc
-		// As above, optimistic that raft will apply the conf change
-		w.WriteHeader(http.StatusCreated)
-	case r.Method == "DELETE":
-		nodeID, err := strconv.ParseUint(key[1:], 0, 64)
-		if err != nil {
-			rlog.Error(fmt.Sprintf("Failed to convert ID for conf change (%v)", err.Error()))
-			http.Error(w, "Failed on DELETE", http.StatusBadRequest)
+		if current != nil {
+			http.Error(w, "Node already exists", http.StatusConflict)
 			return
 		}
-		cc := raftpb.ConfChange{
-			Type:   raftpb.ConfChangeRemoveNode,
-			NodeID: nodeID,
+		cc.Type = raftpb.ConfChangeAddNode
+		if r.URL.Query().Get("learner") == "true" {
+			cc.Type = raftpb.ConfChangeAddLearnerNode
 		}
-		h.confChangeC <- cc
-		// As above, optimistic that raft will apply the conf change
-		w.WriteHeader(http.StatusAccepted)
-	default:
-		w.Header().Add("Allow", "POST")
-		w.Header().Add("Allow", "DELETE")
-		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		cc.Context = []byte(peerURL)
+	case "PUT":
+		if current == nil {
+			http.Error(w, "Node not found", http.StatusNotFound)
+			return
+		}
+		if !current.IsLearner {
+			http.Error(w, "Node is already a voter", http.StatusConflict)
+			return
+		}
+		// adding an existing learner as a node promotes it to voter
+		cc.Type = raftpb.ConfChangeAddNode
+		cc.Context = []byte(current.URL)
+	case "DELETE":
+		if current == nil {
+			http.Error(w, "Node not found", http.StatusNotFound)
+			return
+		}
+		cc.Type = raftpb.ConfChangeRemoveNode
+	}
+
+	reply := &confChangeReply{Type: cc.Type.String(), NodeID: nodeID}
+	if err := h.proposeConfChange(r.Context(), cc); err != nil {
+		rlog.Error(fmt.Sprintf("Conf change %s of node %d failed (%v)", reply.Type, nodeID, err.Error()))
+		reply.Error = err.Error()
+		writeJSON(w, http.StatusGatewayTimeout, reply)
+		return
+	}
+	reply.Applied = true
+	reply.Members = h.cluster.members().Members
+	writeJSON(w, http.StatusOK, reply)
+}
+
+// authorize only the client verified by the configured CA can change the membership
+func (h *httpRaftAPI) authorize(r *http.Request) error {
+	if !h.tlsEnabled {
+		return errors.New("membership change requires TLS client certificate authentication")
+	}
+	if r.TLS == nil || len(r.TLS.VerifiedChains) == 0 || len(r.TLS.VerifiedChains[0]) == 0 {
+		return errors.New("client certificate not verified")
+	}
+	if len(h.allowedCNs) > 0 && !h.allowedCNs[r.TLS.VerifiedChains[0][0].Subject.CommonName] {
+		return errors.New("client certificate not allowed")
+	}
+	return nil
+}
+
+func (h *httpRaftAPI) findMember(nodeID uint64) *member {
+	for _, m := range h.cluster.members().Members {
+		if m.ID == nodeID {
+			return m
+		}
+	}
+	return nil
+}
+
+// proposeConfChange propose the conf change and wait until it is applied
+func (h *httpRaftAPI) proposeConfChange(ctx context.Context, cc raftpb.ConfChange) error {
+	cc.ID = h.idGen.Next()
+	ch := h.cluster.confWait().Register(cc.ID)
+	ctx, cancel := context.WithTimeout(ctx, confChangeTimeout)
+	defer cancel()
+
+	select {
+	case h.confChangeC <- cc:
+	case <-ctx.Done():
+		h.cluster.confWait().Trigger(cc.ID, nil)
+		return errConfChangeTimeout
+	}
+	select {
+	case x := <-ch:
+		if err, ok := x.(error); ok {
+			return err
+		}
+		return nil
+	case <-ctx.Done():
+		h.cluster.confWait().Trigger(cc.ID, nil)
+		return errConfChangeTimeout
+	}
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		rlog.Error(fmt.Sprintf("Failed to write reply (%v)", err.Error()))
 	}
 }
 
-func serveHTTPRaftAPI(ctx context.Context, port int, confChangeC chan<- raftpb.ConfChange, errorC <-chan error) {
+// newRaftAPITLSConfig the server requires client certificates issued by caFile
+func newRaftAPITLSConfig(certFile, keyFile, caFile string) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+	caPEM, err := ioutil.ReadFile(caFile)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPEM) {
+		return nil, fmt.Errorf("no certificate found in %s", caFile)
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+func serveHTTPRaftAPI(ctx context.Context, subcfg *subConfig, confChangeC chan<- raftpb.ConfChange, cluster raftCluster, errorC <-chan error) {
+	host := subcfg.RaftAPIHost
+	if host == "" {
+		host = "localhost"
+	}
+	tlsEnabled := subcfg.RaftAPICertFile != "" || subcfg.RaftAPIKeyFile != "" || subcfg.RaftAPICAFile != ""
 	srv := &http.Server{
-		Addr: "localhost:" + strconv.Itoa(port),
-		Handler: &httpRaftAPI{
-			confChangeC: confChangeC,
-		},
+		Addr:    host + ":" + strconv.Itoa(int(subcfg.RaftAPIPort)),
+		Handler: newHTTPRaftAPI(int(subcfg.NodeID), confChangeC, cluster, tlsEnabled, subcfg.RaftAPIAllowedCNs),
+	}
+	if tlsEnabled {
+		tlsConfig, err := newRaftAPITLSConfig(subcfg.RaftAPICertFile, subcfg.RaftAPIKeyFile, subcfg.RaftAPICAFile)
+		if err != nil {
+			rlog.Error(fmt.Sprintf("Failed to load raft api TLS config (%v)", err.Error()))
+			return
+		}
+		srv.TLSConfig = tlsConfig
+	} else {
+		rlog.Info("raft api TLS is not configured, membership changes are disabled")
 	}
 	go func() {
-		if err := srv.ListenAndServe(); err != nil {
+		var err error
+		if tlsEnabled {
+			err = srv.ListenAndServeTLS("", "")
+		} else {
+			err = srv.ListenAndServe()
+		}
+		if err != nil {
 			rlog.Error(fmt.Sprintf("ListenAndServe have a err: (%v)", err.Error()))
 		}
 	}()
@@ -0,0 +1,251 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package raft
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/coreos/etcd/pkg/wait"
+	"github.com/coreos/etcd/raft/raftpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockCluster 模拟raft节点应用成员变更
+type mockCluster struct {
+	mu      sync.Mutex
+	list    []*member
+	w       wait.Wait
+	dropped bool
+}
+
+func (c *mockCluster) members() *membersReply {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	reply := &membersReply{Leader: 1}
+	for _, m := range c.list {
+		copied := *m
+		reply.Members = append(reply.Members, &copied)
+	}
+	return reply
+}
+
+func (c *mockCluster) confWait() wait.Wait {
+	return c.w
+}
+
+func (c *mockCluster) serve(confChangeC <-chan raftpb.ConfChange) {
+	for cc := range confChangeC {
+		c.mu.Lock()
+		if c.dropped {
+			c.mu.Unlock()
+			continue
+		}
+		switch cc.Type {
+		case raftpb.ConfChangeAddNode, raftpb.ConfChangeAddLearnerNode:
+			found := false
+			for _, m := range c.list {
+				if m.ID == cc.NodeID {
+					m.IsLearner = false
+					found = true
+				}
+			}
+			if !found {
+				c.list = append(c.list, &member{ID: cc.NodeID, URL: string(cc.Context), IsLearner: cc.Type == raftpb.ConfChangeAddLearnerNode})
+			}
+		case raftpb.ConfChangeRemoveNode:
+			for i, m := range c.list {
+				if m.ID == cc.NodeID {
+					c.list = append(c.list[:i], c.list[i+1:]...)
+					break
+				}
+			}
+		}
+		c.mu.Unlock()
+		c.w.Trigger(cc.ID, nil)
+	}
+}
+
+type testCert struct {
+	cert    *x509.Certificate
+	key     *ecdsa.PrivateKey
+	certPEM []byte
+	keyPEM  []byte
+}
+
+func genTestCert(t *testing.T, cn string, isCA bool, parent *testCert) *testCert {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		IsCA:         isCA,
+
+		BasicConstraintsValid: true,
+	}
+	parentCert, parentKey := tmpl, key
+	if parent != nil {
+		parentCert, parentKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, parentCert, &key.PublicKey, parentKey)
+	require.Nil(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.Nil(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.Nil(t, err)
+	return &testCert{
+		cert:    cert,
+		key:     key,
+		certPEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		keyPEM:  pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}),
+	}
+}
+
+func newTestClient(t *testing.T, ca *testCert, client *testCert) *http.Client {
+	pool := x509.NewCertPool()
+	pool.AddCert(ca.cert)
+	tlsConfig := &tls.Config{RootCAs: pool}
+	if client != nil {
+		pair, err := tls.X509KeyPair(client.certPEM, client.keyPEM)
+		require.Nil(t, err)
+		tlsConfig.Certificates = []tls.Certificate{pair}
+	}
+	return &http.Client{Transport: &http.Transport{TLSClientConfig: tlsConfig}}
+}
+
+func doRequest(client *http.Client, method, url, body string) (*http.Response, error) {
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	return client.Do(req)
+}
+
+func TestHTTPRaftAPIMembership(t *testing.T) {
+	ca := genTestCert(t, "raft-ca", true, nil)
+	server := genTestCert(t, "raft-server", false, ca)
+	admin := genTestCert(t, "raft-admin", false, ca)
+	guest := genTestCert(t, "raft-guest", false, ca)
+	rogue := genTestCert(t, "raft-admin", false, genTestCert(t, "rogue-ca", true, nil))
+
+	dir := t.TempDir()
+	files := map[string][]byte{"ca.pem": ca.certPEM, "server.pem": server.certPEM, "server.key": server.keyPEM}
+	for name, data := range files {
+		require.Nil(t, ioutil.WriteFile(filepath.Join(dir, name), data, 0600))
+	}
+	tlsConfig, err := newRaftAPITLSConfig(filepath.Join(dir, "server.pem"), filepath.Join(dir, "server.key"), filepath.Join(dir, "ca.pem"))
+	require.Nil(t, err)
+
+	cluster := &mockCluster{
+		list: []*member{{ID: 1, URL: "http://127.0.0.1:9021"}, {ID: 2, URL: "http://127.0.0.1:9022"}},
+		w:    wait.New(),
+	}
+	confChangeC := make(chan raftpb.ConfChange)
+	defer close(confChangeC)
+	go cluster.serve(confChangeC)
+
+	srv := httptest.NewUnstartedServer(newHTTPRaftAPI(1, confChangeC, cluster, true, []string{"raft-admin"}))
+	srv.TLS = tlsConfig
+	srv.StartTLS()
+	defer srv.Close()
+
+	// 没有客户端证书或证书不是指定CA签发的，无法建立连接
+	_, err = doRequest(newTestClient(t, ca, nil), "GET", srv.URL+"/members", "")
+	assert.NotNil(t, err)
+	_, err = doRequest(newTestClient(t, ca, rogue), "POST", srv.URL+"/3", "http://127.0.0.1:9023")
+	assert.NotNil(t, err)
+
+	// 证书CommonName不在白名单中
+	resp, err := doRequest(newTestClient(t, ca, guest), "POST", srv.URL+"/3", "http://127.0.0.1:9023")
+	require.Nil(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	client := newTestClient(t, ca, admin)
+	checkReply := func(method, path, body string, code int, members int) *confChangeReply {
+		resp, err := doRequest(client, method, srv.URL+path, body)
+		require.Nil(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, code, resp.StatusCode)
+		if code != http.StatusOK {
+			return nil
+		}
+		var reply confChangeReply
+		require.Nil(t, json.NewDecoder(resp.Body).Decode(&reply))
+		assert.True(t, reply.Applied)
+		assert.Equal(t, members, len(reply.Members))
+		return &reply
+	}
+
+	reply := checkReply("POST", "/3?learner=true", "http://127.0.0.1:9023", http.StatusOK, 3)
+	assert.Equal(t, raftpb.ConfChangeAddLearnerNode.String(), reply.Type)
+	assert.True(t, reply.Members[2].IsLearner)
+	checkReply("POST", "/3", "http://127.0.0.1:9023", http.StatusConflict, 0)
+	checkReply("POST", "/4", "not a url", http.StatusBadRequest, 0)
+
+	reply = checkReply("PUT", "/3", "", http.StatusOK, 3)
+	assert.False(t, reply.Members[2].IsLearner)
+	assert.Equal(t, "http://127.0.0.1:9023", reply.Members[2].URL)
+	checkReply("PUT", "/3", "", http.StatusConflict, 0)
+	checkReply("PUT", "/5", "", http.StatusNotFound, 0)
+
+	checkReply("DELETE", "/2", "", http.StatusOK, 2)
+	checkReply("DELETE", "/2", "", http.StatusNotFound, 0)
+
+	resp, err = doRequest(client, "GET", srv.URL+"/members", "")
+	require.Nil(t, err)
+	var members membersReply
+	require.Nil(t, json.NewDecoder(resp.Body).Decode(&members))
+	resp.Body.Close()
+	assert.Equal(t, uint64(1), members.Leader)
+	assert.Equal(t, []*member{{ID: 1, URL: "http://127.0.0.1:9021"}, {ID: 3, URL: "http://127.0.0.1:9023"}}, members.Members)
+
+	// 变更没有生效时返回超时，不能认为已经成功
+	cluster.mu.Lock()
+	cluster.dropped = true
+	cluster.mu.Unlock()
+	timeout := confChangeTimeout
+	confChangeTimeout = 200 * time.Millisecond
+	defer func() { confChangeTimeout = timeout }()
+	checkReply("POST", "/4", "http://127.0.0.1:9024", http.StatusGatewayTimeout, 0)
+}
+
+func TestHTTPRaftAPIWithoutTLS(t *testing.T) {
+	cluster := &mockCluster{list: []*member{{ID: 1, URL: "http://127.0.0.1:9021"}}, w: wait.New()}
+	srv := httptest.NewServer(newHTTPRaftAPI(1, make(chan raftpb.ConfChange), cluster, false, nil))
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/members")
+	require.Nil(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp, err = http.Post(srv.URL+"/2", "text/plain", strings.NewReader("http://127.0.0.1:9022"))
+	require.Nil(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+}
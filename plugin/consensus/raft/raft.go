@@ -21,6 +21,7 @@ import (
 	"github.com/coreos/etcd/etcdserver/stats"
 	"github.com/coreos/etcd/pkg/fileutil"
 	typec "github.com/coreos/etcd/pkg/types"
+	"github.com/coreos/etcd/pkg/wait"
 	"github.com/coreos/etcd/raft"
 	"github.com/coreos/etcd/raft/raftpb"
 	"github.com/coreos/etcd/rafthttp"
@@ -66,6 +67,11 @@ type raftNode struct {
 	validatorC chan bool
 	//用于判断该节点是否重启过
 	restartC chan struct{}
+	//confState和节点地址会被成员管理接口并发读取
+	confMu   sync.RWMutex
+	peerURLs map[uint64]string
+	//成员变更生效后通知等待的请求
+	wait wait.Wait
 }
 
 //Node ...
@@ -75,7 +81,7 @@ type Node struct {
 
 // NewRaftNode create raft node
 func NewRaftNode(ctx context.Context, id int, join bool, peers []string, readOnlyPeers []string, addPeers []string, getSnapshot func() ([]byte, error), proposeC <-chan *types.Block,
-	confChangeC <-chan raftpb.ConfChange) (<-chan *types.Block, <-chan error, <-chan *snap.Snapshotter, <-chan bool) {
+	confChangeC <-chan raftpb.ConfChange) (<-chan *types.Block, <-chan error, <-chan *snap.Snapshotter, <-chan bool, *Node) {
 
 	rlog.Info("Enter consensus raft")
 	// commit channel
@@ -99,10 +105,16 @@ func NewRaftNode(ctx context.Context, id int, join bool, peers []string, readOnl
 		snapshotterReady: make(chan *snap.Snapshotter, 1),
 		restartC:         make(chan struct{}, 1),
 		ctx:              ctx,
+		peerURLs:         make(map[uint64]string),
+		wait:             wait.New(),
+	}
+	// 节点ID与配置中的地址顺序一致
+	for i, peer := range append(append(append([]string{}, peers...), readOnlyPeers...), addPeers...) {
+		rc.peerURLs[uint64(i+1)] = peer
 	}
 	go rc.startRaft()
 
-	return commitC, errorC, rc.snapshotterReady, rc.validatorC
+	return commitC, errorC, rc.snapshotterReady, rc.validatorC, &Node{rc}
 }
 
 //  启动raft节点
@@ -139,6 +151,7 @@ func (rc *raftNode) startRaft() {
 	if len(rc.readOnlyPeers) > 0 && rc.id > len(rc.bootstrapPeers) {
 		rc.join = true
 	}
+	rc.stopMu.Lock()
 	if oldwal {
 		rc.restartC <- struct{}{}
 		rc.node = raft.RestartNode(c)
@@ -149,6 +162,7 @@ func (rc *raftNode) startRaft() {
 		}
 		rc.node = raft.StartNode(c, startPeers)
 	}
+	rc.stopMu.Unlock()
 
 	rc.transport = &rafthttp.Transport{
 		ID:          typec.ID(rc.id),
@@ -216,7 +230,7 @@ func (rc *raftNode) serveChannels() {
 	if err != nil {
 		panic(err)
 	}
-	rc.confState = snapShot.Metadata.ConfState
+	rc.setConfState(snapShot.Metadata.ConfState)
 	rc.snapshotIndex = snapShot.Metadata.Index
 	rc.appliedIndex = snapShot.Metadata.Index
 
@@ -248,8 +262,11 @@ func (rc *raftNode) serveChannels() {
 				if !ok {
 					rc.confChangeC = nil
 				} else {
-					confChangeCount++
-					cc.ID = confChangeCount
+					// 成员管理接口提交的变更自带ID，用于等待变更生效
+					if cc.ID == 0 {
+						confChangeCount++
+						cc.ID = confChangeCount
+					}
 					err = rc.node.ProposeConfChange(context.TODO(), cc)
 					if err != nil {
 						rlog.Error(fmt.Sprintf("rc.node.ProposeConfChange:%v", err.Error()))
@@ -342,6 +359,39 @@ func (rc *raftNode) Status() raft.Status {
 	return rc.node.Status()
 }
 
+func (rc *raftNode) setConfState(cs raftpb.ConfState) {
+	rc.confMu.Lock()
+	defer rc.confMu.Unlock()
+	rc.confState = cs
+}
+
+// members 当前节点上已生效的集群成员
+func (rc *raftNode) members() *membersReply {
+	reply := &membersReply{}
+	rc.stopMu.RLock()
+	if rc.node != nil {
+		reply.Leader = rc.node.Status().Lead
+	}
+	rc.stopMu.RUnlock()
+
+	rc.confMu.RLock()
+	defer rc.confMu.RUnlock()
+	for _, id := range rc.confState.Nodes {
+		reply.Members = append(reply.Members, &member{ID: id, URL: rc.peerURLs[id]})
+	}
+	for _, id := range rc.confState.Learners {
+		reply.Members = append(reply.Members, &member{ID: id, URL: rc.peerURLs[id], IsLearner: true})
+	}
+	sort.Slice(reply.Members, func(i, j int) bool {
+		return reply.Members[i].ID < reply.Members[j].ID
+	})
+	return reply
+}
+
+func (rc *raftNode) confWait() wait.Wait {
+	return rc.wait
+}
+
 func (rc *raftNode) replayWAL() *wal.WAL {
 	rlog.Info(fmt.Sprintf("replaying WAL of member %v", rc.id))
 	snapshot := rc.loadSnapshot()
@@ -433,7 +483,7 @@ func (rc *raftNode) publishSnapshot(snapshotToSave raftpb.Snapshot) {
 	}
 	rc.commitC <- nil // trigger kvstore to load snapshot
 
-	rc.confState = snapshotToSave.Metadata.ConfState
+	rc.setConfState(snapshotToSave.Metadata.ConfState)
 	rc.snapshotIndex = snapshotToSave.Metadata.Index
 	rc.appliedIndex = snapshotToSave.Metadata.Index
 }
@@ -510,8 +560,18 @@ func (rc *raftNode) publishEntries(ents []raftpb.Entry) bool {
 		case raftpb.EntryConfChange:
 			var cc raftpb.ConfChange
 			cc.Unmarshal(ents[i].Data)
+			rc.confMu.Lock()
 			rc.confState = *rc.node.ApplyConfChange(cc)
 			switch cc.Type {
+			case raftpb.ConfChangeAddNode, raftpb.ConfChangeAddLearnerNode:
+				if len(cc.Context) > 0 {
+					rc.peerURLs[cc.NodeID] = string(cc.Context)
+				}
+			case raftpb.ConfChangeRemoveNode:
+				delete(rc.peerURLs, cc.NodeID)
+			}
+			rc.confMu.Unlock()
+			switch cc.Type {
 			case raftpb.ConfChangeAddNode:
 				if len(cc.Context) > 0 {
 					rc.transport.AddPeer(typec.ID(cc.NodeID), []string{string(cc.Context)})
@@ -519,6 +579,7 @@ func (rc *raftNode) publishEntries(ents []raftpb.Entry) bool {
 			case raftpb.ConfChangeRemoveNode:
 				if cc.NodeID == uint64(rc.id) {
 					rlog.Info("I've been removed from the cluster! Shutting down.")
+					rc.wait.Trigger(cc.ID, nil)
 					return false
 				}
 				rc.transport.RemovePeer(typec.ID(cc.NodeID))
@@ -528,6 +589,7 @@ func (rc *raftNode) publishEntries(ents []raftpb.Entry) bool {
 				}
 				isReady = true
 			}
+			rc.wait.Trigger(cc.ID, nil)
 
 		}
 
@@ -55,6 +55,9 @@ var (
 	signName                    = "ed25519"
 	useAggSig                   = false
 	gossipVotes                 atomic.Value
+	signerAddr                  = ""
+	signerPubKey                = ""
+	nodeKeyFile                 = "node_key.json"
 )
 
 func init() {
@@ -98,6 +101,9 @@ type subConfig struct {
 	PreExec                   bool     `json:"preExec"`
 	SignName                  string   `json:"signName"`
 	UseAggregateSignature     bool     `json:"useAggregateSignature"`
+	SignerAddr                string   `json:"signerAddr"`
+	SignerPubKey              string   `json:"signerPubKey"`
+	NodeKeyFile               string   `json:"nodeKeyFile"`
 }
 
 func applyConfig(sub []byte) {
@@ -152,6 +158,12 @@ func applyConfig(sub []byte) {
 		signName = subcfg.SignName
 	}
 	useAggSig = subcfg.UseAggregateSignature
+	// 配置了远程签名服务时, 验证者私钥不保存在本节点
+	signerAddr = subcfg.SignerAddr
+	signerPubKey = subcfg.SignerPubKey
+	if subcfg.NodeKeyFile != "" {
+		nodeKeyFile = subcfg.NodeKeyFile
+	}
 	gossipVotes.Store(true)
 }
 
@@ -196,15 +208,31 @@ func New(cfg *types.Consensus, sub []byte) queue.Module {
 		return nil
 	}
 
-	privValidator := ttypes.LoadOrGenPrivValidatorFS("priv_validator.json")
-	if privValidator == nil {
-		tendermintlog.Error("NewTendermintClient create priv_validator file fail")
-		return nil
+	var privValidator ttypes.PrivValidator
+	var priv crypto.PrivKey
+	if signerAddr != "" {
+		// the node key is only used for p2p handshake, votes are signed by the remote signer
+		priv, err = LoadOrGenNodeKey(nodeKeyFile)
+		if err != nil {
+			tendermintlog.Error("NewTendermintClient load node key file fail", "err", err)
+			return nil
+		}
+		privValidator, err = NewPrivValidatorSocket(signerAddr, signerPubKey, priv)
+		if err != nil {
+			tendermintlog.Error("NewTendermintClient create remote signer validator fail", "err", err)
+			return nil
+		}
+	} else {
+		privValidatorFS := ttypes.LoadOrGenPrivValidatorFS("priv_validator.json")
+		if privValidatorFS == nil {
+			tendermintlog.Error("NewTendermintClient create priv_validator file fail")
+			return nil
+		}
+		privValidator, priv = privValidatorFS, privValidatorFS.PrivKey
 	}
 
 	ttypes.InitMessageMap()
 
-	priv := privValidator.PrivKey
 	pubkey := privValidator.GetPubKey().KeyString()
 	c := drivers.NewBaseClient(cfg)
 	client := &Client{
@@ -198,3 +198,56 @@ func BenchmarkBlsManager_VerifyAggregatedN(b *testing.B) {
 		blsDrv.VerifyAggregatedN(pubs, msgs, asig) //nolint:errcheck
 	}
 }
+
+func TestProofOfPossession(t *testing.T) {
+	sk, _ := blsDrv.GenKey()
+	pk := sk.PubKey()
+	pop, err := GenPoP(sk)
+	assert.NoError(t, err)
+	assert.NoError(t, VerifyPoP(pk, pop))
+
+	// pop of another key
+	sk2, _ := blsDrv.GenKey()
+	pop2, _ := GenPoP(sk2)
+	assert.Error(t, VerifyPoP(pk, pop2))
+	// ordinary signature over public key bytes is not a valid pop
+	assert.Error(t, VerifyPoP(pk, sk.Sign(pk.Bytes())))
+}
+
+func TestThresholdSignature(t *testing.T) {
+	sk, _ := blsDrv.GenKey()
+	pk := sk.PubKey()
+	shares, err := GenKeyShares(nil, sk, 3, 5)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, len(shares))
+
+	msg := []byte("threshold message")
+	var sigs []crypto.Signature
+	var pubs []crypto.PubKey
+	var indexes []uint32
+	for _, i := range []int{4, 1, 3} {
+		sig := shares[i].PrivKey.Sign(msg)
+		assert.True(t, shares[i].PrivKey.PubKey().VerifyBytes(msg, sig))
+		sigs = append(sigs, sig)
+		pubs = append(pubs, shares[i].PrivKey.PubKey())
+		indexes = append(indexes, shares[i].Index)
+	}
+	sig, err := RecoverSignature(sigs, indexes)
+	assert.NoError(t, err)
+	assert.True(t, pk.VerifyBytes(msg, sig))
+	assert.Equal(t, sk.Sign(msg).Bytes(), sig.Bytes())
+
+	recovered, err := RecoverPubKey(pubs, indexes)
+	assert.NoError(t, err)
+	assert.Equal(t, pk.Bytes(), recovered.Bytes())
+
+	// not enough shares
+	sig, err = RecoverSignature(sigs[:2], indexes[:2])
+	assert.NoError(t, err)
+	assert.False(t, pk.VerifyBytes(msg, sig))
+
+	_, err = RecoverSignature(sigs[:2], []uint32{1, 1})
+	assert.Error(t, err)
+	_, err = GenKeyShares(nil, sk, 6, 5)
+	assert.Error(t, err)
+}
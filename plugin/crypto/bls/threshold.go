@@ -0,0 +1,199 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bls
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/phoreproject/bls"
+	"github.com/phoreproject/bls/g1pubs"
+)
+
+// popDomain proof-of-possession uses its own domain so that a PoP can never be
+// replayed as an ordinary signature over the public key bytes.
+var popDomain = [8]byte{'B', 'L', 'S', '_', 'P', 'O', 'P', '_'}
+
+// curveOrder order of the BLS12-381 scalar field
+var curveOrder = bls.RFieldModulus.ToBig()
+
+// GenPoP create proof-of-possession for the public key of priv
+func GenPoP(priv crypto.PrivKey) (crypto.Signature, error) {
+	privBLS, ok := priv.(PrivKeyBLS)
+	if !ok {
+		return nil, errors.New("invalid bls private key")
+	}
+	sk := g1pubs.DeserializeSecretKey(privBLS)
+	sig := g1pubs.SignWithDomain(popMessage(privBLS.PubKey()), sk, popDomain)
+	return SignatureBLS(sig.Serialize()), nil
+}
+
+// VerifyPoP verify proof-of-possession of pub, which should be checked before
+// the public key is used in aggregate verification to prevent rogue-key attack
+func VerifyPoP(pub crypto.PubKey, pop crypto.Signature) error {
+	g1pub, err := ConvertToPublicKey(pub)
+	if err != nil {
+		return err
+	}
+	g1sig, err := ConvertToSignature(pop)
+	if err != nil {
+		return err
+	}
+	if !g1pubs.VerifyWithDomain(popMessage(pub), g1pub, g1sig, popDomain) {
+		return errors.New("bls proof-of-possession mismatch")
+	}
+	return nil
+}
+
+func popMessage(pub crypto.PubKey) [32]byte {
+	return sha256.Sum256(pub.Bytes())
+}
+
+// KeyShare one share of a threshold key, Index starts from 1
+type KeyShare struct {
+	Index   uint32
+	PrivKey crypto.PrivKey
+}
+
+// GenKeyShares split priv into n shares with Shamir secret sharing,
+// any threshold of them can recover signatures of priv
+func GenKeyShares(random io.Reader, priv crypto.PrivKey, threshold, n int) ([]*KeyShare, error) {
+	privBLS, ok := priv.(PrivKeyBLS)
+	if !ok {
+		return nil, errors.New("invalid bls private key")
+	}
+	if threshold <= 0 || threshold > n {
+		return nil, fmt.Errorf("invalid threshold %d of %d", threshold, n)
+	}
+	if random == nil {
+		random = rand.Reader
+	}
+	// f(x) = a0 + a1*x + ... + a(t-1)*x^(t-1), a0 is the secret
+	coefficients := make([]*big.Int, threshold)
+	coefficients[0] = new(big.Int).SetBytes(privBLS[:])
+	for i := 1; i < threshold; i++ {
+		a, err := rand.Int(random, curveOrder)
+		if err != nil {
+			return nil, err
+		}
+		coefficients[i] = a
+	}
+
+	shares := make([]*KeyShare, 0, n)
+	for i := 1; i <= n; i++ {
+		x := big.NewInt(int64(i))
+		y := new(big.Int)
+		for j := threshold - 1; j >= 0; j-- {
+			y.Mul(y, x)
+			y.Add(y, coefficients[j])
+			y.Mod(y, curveOrder)
+		}
+		share, err := scalarToPrivKey(y)
+		if err != nil {
+			return nil, err
+		}
+		shares = append(shares, &KeyShare{Index: uint32(i), PrivKey: share})
+	}
+	return shares, nil
+}
+
+// RecoverSignature recover the signature of the threshold key from partial
+// signatures, sigs[i] is signed by the share with indexes[i].
+// Partial signatures should be verified against the share public keys before recovery.
+func RecoverSignature(sigs []crypto.Signature, indexes []uint32) (crypto.Signature, error) {
+	lambdas, err := lagrangeCoefficients(indexes)
+	if err != nil {
+		return nil, err
+	}
+	if len(sigs) != len(indexes) {
+		return nil, fmt.Errorf("different length of sigs and indexes, %d vs %d", len(sigs), len(indexes))
+	}
+	result := g1pubs.NewAggregateSignature()
+	for i, sig := range sigs {
+		g1sig, err := ConvertToSignature(sig)
+		if err != nil {
+			return nil, fmt.Errorf("%v(index: %d)", err, i)
+		}
+		lambda, err := bls.FRReprFromBigInt(lambdas[i])
+		if err != nil {
+			return nil, err
+		}
+		result.Aggregate(g1pubs.NewSignatureFromG2(g1sig.GetPoint().MulFR(lambda).ToAffine()))
+	}
+	return SignatureBLS(result.Serialize()), nil
+}
+
+// RecoverPubKey recover the public key of the threshold key from share public keys
+func RecoverPubKey(pubs []crypto.PubKey, indexes []uint32) (crypto.PubKey, error) {
+	lambdas, err := lagrangeCoefficients(indexes)
+	if err != nil {
+		return nil, err
+	}
+	if len(pubs) != len(indexes) {
+		return nil, fmt.Errorf("different length of pubs and indexes, %d vs %d", len(pubs), len(indexes))
+	}
+	result := g1pubs.NewAggregatePubkey()
+	for i, pub := range pubs {
+		g1pub, err := ConvertToPublicKey(pub)
+		if err != nil {
+			return nil, fmt.Errorf("%v(index: %d)", err, i)
+		}
+		lambda, err := bls.FRReprFromBigInt(lambdas[i])
+		if err != nil {
+			return nil, err
+		}
+		result.Aggregate(g1pubs.NewPublicKeyFromG1(g1pub.GetPoint().MulFR(lambda).ToAffine()))
+	}
+	return PubKeyBLS(result.Serialize()), nil
+}
+
+// lagrangeCoefficients coefficients at x=0 for the given share indexes
+func lagrangeCoefficients(indexes []uint32) ([]*big.Int, error) {
+	if len(indexes) == 0 {
+		return nil, errors.New("no shares to recover")
+	}
+	seen := make(map[uint32]bool)
+	for _, index := range indexes {
+		if index == 0 {
+			return nil, errors.New("invalid share index 0")
+		}
+		if seen[index] {
+			return nil, fmt.Errorf("duplicate share index %d", index)
+		}
+		seen[index] = true
+	}
+
+	lambdas := make([]*big.Int, len(indexes))
+	for i, xi := range indexes {
+		num := big.NewInt(1)
+		den := big.NewInt(1)
+		for j, xj := range indexes {
+			if i == j {
+				continue
+			}
+			num.Mul(num, big.NewInt(int64(xj)))
+			num.Mod(num, curveOrder)
+			den.Mul(den, new(big.Int).Sub(big.NewInt(int64(xj)), big.NewInt(int64(xi))))
+			den.Mod(den, curveOrder)
+		}
+		den.ModInverse(den, curveOrder)
+		lambdas[i] = num.Mul(num, den).Mod(num, curveOrder)
+	}
+	return lambdas, nil
+}
+
+func scalarToPrivKey(s *big.Int) (crypto.PrivKey, error) {
+	if s.Sign() == 0 {
+		return nil, errors.New("invalid zero bls share")
+	}
+	var privKey PrivKeyBLS
+	s.FillBytes(privKey[:])
+	return privKey, nil
+}
@@ -0,0 +1,202 @@
+package paillier
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/33cn/chain33/common"
+)
+
+var one = big.NewInt(1)
+
+// PublicKey paillier公钥，生成元固定为 G = N + 1
+type PublicKey struct {
+	N *big.Int
+	// NSquare N的平方，加解密时使用
+	NSquare *big.Int
+}
+
+// PrivateKey paillier私钥
+type PrivateKey struct {
+	PublicKey
+	// Lambda (p-1)(q-1)
+	Lambda *big.Int
+	// Mu Lambda模N的逆元
+	Mu *big.Int
+}
+
+// NewPublicKey 根据模数N构造公钥
+func NewPublicKey(n *big.Int) *PublicKey {
+	return &PublicKey{N: n, NSquare: new(big.Int).Mul(n, n)}
+}
+
+// GenerateKey 生成bits位模数的密钥对，random为空时使用crypto/rand
+func GenerateKey(random io.Reader, bits int) (*PrivateKey, error) {
+	if random == nil {
+		random = rand.Reader
+	}
+	if bits < 16 || bits%2 != 0 {
+		return nil, fmt.Errorf("GenerateKey. error bits:%d", bits)
+	}
+	// 序列化时模数长度使用两个字节表示
+	if bits/8 > 0x7fff {
+		return nil, fmt.Errorf("GenerateKey. bits too large:%d", bits)
+	}
+	for {
+		p, err := rand.Prime(random, bits/2)
+		if err != nil {
+			return nil, fmt.Errorf("GenerateKey.Prime. error:%v", err)
+		}
+		q, err := rand.Prime(random, bits/2)
+		if err != nil {
+			return nil, fmt.Errorf("GenerateKey.Prime. error:%v", err)
+		}
+		if p.Cmp(q) == 0 {
+			continue
+		}
+		n := new(big.Int).Mul(p, q)
+		if n.BitLen() != bits {
+			continue
+		}
+		lambda := new(big.Int).Mul(new(big.Int).Sub(p, one), new(big.Int).Sub(q, one))
+		mu := new(big.Int).ModInverse(lambda, n)
+		if mu == nil {
+			continue
+		}
+		return &PrivateKey{PublicKey: *NewPublicKey(n), Lambda: lambda, Mu: mu}, nil
+	}
+}
+
+// Bytes 公钥序列化，格式与密文头部一致：2字节模数长度 + 模数
+func (pub *PublicKey) Bytes() []byte {
+	nBytes := pub.N.Bytes()
+	data := make([]byte, 2+len(nBytes))
+	binary.BigEndian.PutUint16(data[:2], uint16(len(nBytes)))
+	copy(data[2:], nBytes)
+	return data
+}
+
+// Hex 公钥序列化为十六进制字符串
+func (pub *PublicKey) Hex() string {
+	return hex.EncodeToString(pub.Bytes())
+}
+
+// ParsePublicKeyBytes 反序列化公钥
+func ParsePublicKeyBytes(data []byte) (*PublicKey, error) {
+	if len(data) < 2 {
+		return nil, fmt.Errorf("ParsePublicKeyBytes. error param length")
+	}
+	nlen := bytesToInt(data[0:2])
+	if nlen <= 0 || nlen != len(data)-2 {
+		return nil, fmt.Errorf("ParsePublicKeyBytes. error param length")
+	}
+	n := new(big.Int).SetBytes(data[2:])
+	if n.Sign() <= 0 {
+		return nil, fmt.Errorf("ParsePublicKeyBytes. error modulus")
+	}
+	return NewPublicKey(n), nil
+}
+
+// ParsePublicKey 从十六进制字符串反序列化公钥
+func ParsePublicKey(pubkey string) (*PublicKey, error) {
+	data, err := common.FromHex(pubkey)
+	if err != nil {
+		return nil, fmt.Errorf("ParsePublicKey.FromHex. pubkey:%s, error:%v", pubkey, err)
+	}
+	return ParsePublicKeyBytes(data)
+}
+
+// EncryptBytes 加密明文，0 <= plaintext < N，密文格式为 公钥序列化数据 + 密文
+func EncryptBytes(random io.Reader, pub *PublicKey, plaintext *big.Int) ([]byte, error) {
+	if random == nil {
+		random = rand.Reader
+	}
+	if plaintext.Sign() < 0 || plaintext.Cmp(pub.N) >= 0 {
+		return nil, fmt.Errorf("EncryptBytes. plaintext out of range")
+	}
+	var r *big.Int
+	for {
+		var err error
+		r, err = rand.Int(random, pub.N)
+		if err != nil {
+			return nil, fmt.Errorf("EncryptBytes.Int. error:%v", err)
+		}
+		if r.Sign() > 0 && new(big.Int).GCD(nil, nil, r, pub.N).Cmp(one) == 0 {
+			break
+		}
+	}
+	// c = g^m * r^N mod N^2，其中 g^m = 1 + m*N mod N^2
+	c := new(big.Int).Mul(plaintext, pub.N)
+	c.Add(c, one)
+	c.Mul(c, new(big.Int).Exp(r, pub.N, pub.NSquare))
+	c.Mod(c, pub.NSquare)
+	return encodeCiphertext(pub, c), nil
+}
+
+// Encrypt 加密明文，返回十六进制格式的密文
+func Encrypt(pub *PublicKey, plaintext *big.Int) (string, error) {
+	data, err := EncryptBytes(nil, pub, plaintext)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(data), nil
+}
+
+// DecryptBytes 解密密文
+func DecryptBytes(priv *PrivateKey, cipherbytes []byte) (*big.Int, error) {
+	pub, c, err := parseCiphertext(cipherbytes)
+	if err != nil {
+		return nil, fmt.Errorf("DecryptBytes. error:%v", err)
+	}
+	if pub.N.Cmp(priv.N) != 0 {
+		return nil, fmt.Errorf("DecryptBytes. error: ciphertext not encrypted by this key")
+	}
+	if c.Cmp(priv.NSquare) >= 0 {
+		return nil, fmt.Errorf("DecryptBytes. ciphertext out of range")
+	}
+	// m = L(c^lambda mod N^2) * mu mod N，其中 L(x) = (x-1)/N
+	m := new(big.Int).Exp(c, priv.Lambda, priv.NSquare)
+	m.Sub(m, one)
+	m.Div(m, priv.N)
+	m.Mul(m, priv.Mu)
+	m.Mod(m, priv.N)
+	return m, nil
+}
+
+// Decrypt 解密十六进制格式的密文
+func Decrypt(priv *PrivateKey, ciphertext string) (*big.Int, error) {
+	cipherbytes, err := common.FromHex(ciphertext)
+	if err != nil {
+		return nil, fmt.Errorf("Decrypt.FromHex. ciphertext:%s, error:%v", ciphertext, err)
+	}
+	return DecryptBytes(priv, cipherbytes)
+}
+
+func encodeCiphertext(pub *PublicKey, c *big.Int) []byte {
+	header := pub.Bytes()
+	cBytes := c.Bytes()
+	data := make([]byte, len(header)+len(cBytes))
+	copy(data, header)
+	copy(data[len(header):], cBytes)
+	return data
+}
+
+// 解析密文，返回密文中携带的公钥和密文数值
+func parseCiphertext(cipherbytes []byte) (*PublicKey, *big.Int, error) {
+	if len(cipherbytes) < 2 {
+		return nil, nil, fmt.Errorf("error param length")
+	}
+	nlen := bytesToInt(cipherbytes[0:2])
+	if nlen <= 0 || nlen >= len(cipherbytes)-2 {
+		return nil, nil, fmt.Errorf("error param length")
+	}
+	pub, err := ParsePublicKeyBytes(cipherbytes[:2+nlen])
+	if err != nil {
+		return nil, nil, err
+	}
+	return pub, new(big.Int).SetBytes(cipherbytes[2+nlen:]), nil
+}
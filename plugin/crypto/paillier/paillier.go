@@ -72,6 +72,63 @@ func CiphertextAddBytes(cipherbytes1, cipherbytes2 []byte) ([]byte, error) {
 	return data, nil
 }
 
+// CiphertextScalarMul 密文数乘，解密结果为 明文*scalar mod N
+func CiphertextScalarMul(ciphertext string, scalar *big.Int) (string, error) {
+	cipherbytes, err := common.FromHex(ciphertext)
+	if err != nil {
+		return "", fmt.Errorf("CiphertextScalarMul.FromHex. ciphertext:%s, error:%v", ciphertext, err)
+	}
+
+	res, err := CiphertextScalarMulBytes(cipherbytes, scalar)
+	if err != nil {
+		return "", fmt.Errorf("CiphertextScalarMul.CiphertextScalarMulBytes. error:%v", err)
+	}
+
+	return hex.EncodeToString(res), nil
+}
+
+// CiphertextScalarMulBytes 密文数乘，scalar为负数时结果为明文乘以 scalar mod N
+func CiphertextScalarMulBytes(cipherbytes []byte, scalar *big.Int) ([]byte, error) {
+	pub, cipher, err := parseCiphertext(cipherbytes)
+	if err != nil {
+		return nil, fmt.Errorf("CiphertextScalarMulBytes. error:%v", err)
+	}
+
+	k := new(big.Int).Mod(scalar, pub.N)
+	res := new(big.Int).Exp(cipher, k, pub.NSquare)
+	return encodeCiphertext(pub, res), nil
+}
+
+// CiphertextAddPlaintext 密文加明文，解密结果为 明文+plaintext mod N
+func CiphertextAddPlaintext(ciphertext string, plaintext *big.Int) (string, error) {
+	cipherbytes, err := common.FromHex(ciphertext)
+	if err != nil {
+		return "", fmt.Errorf("CiphertextAddPlaintext.FromHex. ciphertext:%s, error:%v", ciphertext, err)
+	}
+
+	res, err := CiphertextAddPlaintextBytes(cipherbytes, plaintext)
+	if err != nil {
+		return "", fmt.Errorf("CiphertextAddPlaintext.CiphertextAddPlaintextBytes. error:%v", err)
+	}
+
+	return hex.EncodeToString(res), nil
+}
+
+// CiphertextAddPlaintextBytes 密文加明文，不需要随机数，结果与原密文使用相同的随机因子
+func CiphertextAddPlaintextBytes(cipherbytes []byte, plaintext *big.Int) ([]byte, error) {
+	pub, cipher, err := parseCiphertext(cipherbytes)
+	if err != nil {
+		return nil, fmt.Errorf("CiphertextAddPlaintextBytes. error:%v", err)
+	}
+
+	// g^m = 1 + m*N mod N^2
+	gm := new(big.Int).Mod(plaintext, pub.N)
+	gm.Mul(gm, pub.N).Add(gm, one)
+	res := new(big.Int).Mul(cipher, gm)
+	res.Mod(res, pub.NSquare)
+	return encodeCiphertext(pub, res), nil
+}
+
 func bytesToInt(cipherbytes []byte) int {
 	bytebuff := bytes.NewBuffer(cipherbytes)
 	var data int16
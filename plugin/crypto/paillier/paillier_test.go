@@ -1,6 +1,7 @@
 package paillier
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -17,3 +18,55 @@ func TestCiphertextAdd(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, c3, data)
 }
+
+func TestHomomorphic(t *testing.T) {
+	priv, err := GenerateKey(nil, 512)
+	assert.Nil(t, err)
+
+	pubkey, err := ParsePublicKey(priv.PublicKey.Hex())
+	assert.Nil(t, err)
+	assert.Equal(t, priv.N, pubkey.N)
+
+	c1, err := Encrypt(pubkey, big.NewInt(100))
+	assert.Nil(t, err)
+	c2, err := Encrypt(pubkey, big.NewInt(23))
+	assert.Nil(t, err)
+	m, err := Decrypt(priv, c1)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(100), m.Int64())
+
+	sum, err := CiphertextAdd(c1, c2)
+	assert.Nil(t, err)
+	m, err = Decrypt(priv, sum)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(123), m.Int64())
+
+	mul, err := CiphertextScalarMul(sum, big.NewInt(3))
+	assert.Nil(t, err)
+	m, err = Decrypt(priv, mul)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(369), m.Int64())
+
+	neg, err := CiphertextScalarMul(c2, big.NewInt(-1))
+	assert.Nil(t, err)
+	diff, err := CiphertextAdd(c1, neg)
+	assert.Nil(t, err)
+	m, err = Decrypt(priv, diff)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(77), m.Int64())
+
+	added, err := CiphertextAddPlaintext(c1, big.NewInt(5))
+	assert.Nil(t, err)
+	m, err = Decrypt(priv, added)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(105), m.Int64())
+
+	_, err = Encrypt(pubkey, priv.N)
+	assert.NotNil(t, err)
+	other, err := GenerateKey(nil, 512)
+	assert.Nil(t, err)
+	_, err = Decrypt(other, c1)
+	assert.NotNil(t, err)
+	_, err = ParsePublicKeyBytes([]byte{0x00, 0x05, 0x01})
+	assert.NotNil(t, err)
+}
@@ -44,6 +44,7 @@ func EvmCmd() *cobra.Command {
 		createContractCmd(),
 		callContractCmd(),
 		queryCmd(),
+		getLogsCmd(),
 		estimateGasCmd(),
 		checkContractAddrCmd(),
 		evmDebugCmd(),
@@ -452,6 +453,56 @@ func queryCmd() *cobra.Command {
 	return cmd
 }
 
+// 按合约地址和topic查询合约event日志
+func getLogsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "logs",
+		Short: "Query evm event logs by contract address and topics",
+		Run:   evmGetLogs,
+	}
+	cmd.Flags().StringSliceP("address", "a", nil, "evm contract addresses, separated by comma")
+	cmd.Flags().StringP("topics", "t", "", "topics by position separated by comma, alternatives separated by |, empty position matches any, like t0a|t0b,,t2")
+	cmd.Flags().Int64P("from", "f", 0, "from height")
+	cmd.Flags().Int64P("to", "e", 0, "to height, 0 means no limit")
+	cmd.Flags().Int32P("count", "n", 0, "max count of logs, default 100")
+	cmd.Flags().StringP("primary", "p", "", "primary key returned by the last query")
+	return cmd
+}
+
+func evmGetLogs(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	addrs, _ := cmd.Flags().GetStringSlice("address")
+	topics, _ := cmd.Flags().GetString("topics")
+	from, _ := cmd.Flags().GetInt64("from")
+	to, _ := cmd.Flags().GetInt64("to")
+	count, _ := cmd.Flags().GetInt32("count")
+	primary, _ := cmd.Flags().GetString("primary")
+
+	var req = evmtypes.EvmGetLogsReq{
+		Addresses:  addrs,
+		FromHeight: from,
+		ToHeight:   to,
+		Count:      count,
+		PrimaryKey: primary,
+	}
+	if topics != "" {
+		for _, pos := range strings.Split(topics, ",") {
+			var posTopics evmtypes.EvmLogTopics
+			if pos != "" {
+				posTopics.Topics = strings.Split(pos, "|")
+			}
+			req.Topics = append(req.Topics, &posTopics)
+		}
+	}
+	var resp evmtypes.EvmGetLogsResp
+	query := sendQuery(rpcLaddr, "GetLogs", &req, &resp)
+	if query {
+		proto.MarshalText(os.Stdout, &resp)
+	} else {
+		fmt.Fprintln(os.Stderr, "error")
+	}
+}
+
 func evmQueryCall(cmd *cobra.Command, args []string) {
 	addr, _ := cmd.Flags().GetString("address")
 	input, _ := cmd.Flags().GetString("input")
@@ -578,7 +629,8 @@ func evmDebugCmd() *cobra.Command {
 	cmd.AddCommand(
 		evmDebugQueryCmd(),
 		evmDebugSetCmd(),
-		evmDebugClearCmd())
+		evmDebugClearCmd(),
+		evmDebugTraceCmd())
 
 	return cmd
 }
@@ -629,6 +681,46 @@ func evmDebugRPC(cmd *cobra.Command, flag int32) {
 	}
 }
 
+// 回放已上链的合约交易并输出执行跟踪信息
+func evmDebugTraceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trace",
+		Short: "Replay an evm transaction and trace its execution",
+		Run:   evmDebugTrace,
+	}
+	cmd.Flags().StringP("hash", "s", "", "transaction hash")
+	cmd.MarkFlagRequired("hash")
+	cmd.Flags().StringP("tracer", "t", "structLogger", "tracer type: structLogger or callTracer")
+	cmd.Flags().BoolP("disable_stack", "", false, "do not record stack in structLogger")
+	cmd.Flags().BoolP("disable_memory", "", false, "do not record memory in structLogger")
+	cmd.Flags().Int32P("limit", "l", 0, "max count of struct logs, 0 means no limit")
+	return cmd
+}
+
+func evmDebugTrace(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	hash, _ := cmd.Flags().GetString("hash")
+	tracer, _ := cmd.Flags().GetString("tracer")
+	disableStack, _ := cmd.Flags().GetBool("disable_stack")
+	disableMemory, _ := cmd.Flags().GetBool("disable_memory")
+	limit, _ := cmd.Flags().GetInt32("limit")
+
+	var req = evmtypes.EvmTraceTxReq{
+		TxHash:        hash,
+		Tracer:        tracer,
+		DisableStack:  disableStack,
+		DisableMemory: disableMemory,
+		Limit:         limit,
+	}
+	var resp evmtypes.EvmTraceTxResp
+	query := sendQuery(rpcLaddr, "TraceTransaction", &req, &resp)
+	if query {
+		proto.MarshalText(os.Stdout, &resp)
+	} else {
+		fmt.Fprintln(os.Stderr, "error")
+	}
+}
+
 // 向EVM合约地址转账
 func evmTransferCmd() *cobra.Command {
 	cmd := &cobra.Command{
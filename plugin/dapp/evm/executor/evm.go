@@ -192,5 +192,7 @@ func (evm *EVMExecutor) NewEVMContext(msg *common.Message, txHash []byte) runtim
 		GasLimit:    msg.GasLimit(),
 		GasPrice:    msg.GasPrice(),
 		TxHash:      txHash,
+		// chain33没有动态基础手续费，使用最低交易费率作为BASEFEE指令返回值
+		BaseFee: new(big.Int).SetInt64(evm.GetAPI().GetConfig().GetMinTxFeeRate()),
 	}
 }
@@ -17,6 +17,7 @@ import (
 	"github.com/33cn/chain33/types"
 	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
 	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/model"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/params"
 	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/runtime"
 	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/state"
 	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
@@ -98,7 +99,16 @@ func (evm *EVMExecutor) innerExec(msg *common.Message, txHash []byte, index int,
 	evm.mStateDB.Prepare(common.BytesToHash(txHash), index)
 
 	if isCreate {
-		ret, snapshot, leftOverGas, vmerr = env.Create(runtime.AccountRef(msg.From()), contractAddr, msg.Data(), context.GasLimit, execName, msg.Alias(), msg.Value())
+		gasLimit := context.GasLimit
+		// 上海分叉后，创建合约时按初始化代码字数收取固定Gas（EIP-3860）
+		if cfg.IsDappFork(evm.GetHeight(), "evm", evmtypes.ForkEVMShanghai) {
+			initCodeGas := params.InitCodeWordGas * ((uint64(len(msg.Data())) + 31) / 32)
+			if gasLimit < initCodeGas {
+				return receipt, model.ErrOutOfGas
+			}
+			gasLimit -= initCodeGas
+		}
+		ret, snapshot, leftOverGas, vmerr = env.Create(runtime.AccountRef(msg.From()), contractAddr, msg.Data(), gasLimit, execName, msg.Alias(), msg.Value())
 	} else {
 		callPara := msg.Para()
 		log.Debug("call contract ", "callPara", common.Bytes2Hex(callPara))
@@ -108,6 +118,14 @@ func (evm *EVMExecutor) innerExec(msg *common.Message, txHash []byte, index int,
 	evm.mStateDB.PrintLogs()
 
 	usedGas := msg.GasLimit() - leftOverGas
+	// 伦敦分叉后，执行成功时返还退款计数器中的Gas，最多返还已用Gas的1/5（EIP-3529）
+	if vmerr == nil && cfg.IsDappFork(evm.GetHeight(), "evm", evmtypes.ForkEVMLondon) {
+		refund := evm.mStateDB.GetRefund()
+		if maxRefund := usedGas / params.RefundQuotientEIP3529; refund > maxRefund {
+			refund = maxRefund
+		}
+		usedGas -= refund
+	}
 	logMsg := "call contract details:"
 	if isCreate {
 		logMsg = "create contract details:"
@@ -22,6 +22,8 @@ func (evm *EVMExecutor) ExecLocal(tx *types.Transaction, receipt *types.ReceiptD
 	}
 	cfg := evm.GetAPI().GetConfig()
 	if cfg.IsDappFork(evm.GetHeight(), "evm", evmtypes.ForkEVMState) {
+		traceEnabled := evm.isTraceEnabled()
+		recorded := make(map[string]bool)
 		// 需要将Exec中生成的合约状态变更信息写入localdb
 		for _, logItem := range receipt.Logs {
 			if evmtypes.TyLogEVMStateChangeItem == logItem.Ty {
@@ -40,8 +42,19 @@ func (evm *EVMExecutor) ExecLocal(tx *types.Transaction, receipt *types.ReceiptD
 					key[3] = 'B'
 				}
 				set.KV = append(set.KV, &types.KeyValue{Key: key, Value: changeItem.CurrentValue})
+				if traceEnabled {
+					if kv := evm.getStateHistoryKV(key, changeItem.PreValue, recorded); kv != nil {
+						set.KV = append(set.KV, kv)
+					}
+				}
 			}
 		}
+		// 建立合约日志索引，用于按合约地址和topic查询日志
+		items, err := parseEvmLogs(tx, receipt, evm.GetHeight(), index)
+		if err != nil {
+			return set, err
+		}
+		set.KV = append(set.KV, getEvmLogsKV(items)...)
 	}
 	set.KV = evm.AddRollbackKV(tx, []byte(evmtypes.ExecutorName), set.KV)
 	return set, err
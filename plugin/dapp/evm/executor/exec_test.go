@@ -0,0 +1,147 @@
+package executor
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/client/mocks"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/params"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+	"github.com/stretchr/testify/mock"
+	"gotest.tools/assert"
+)
+
+const testGasLimit = 1000000
+
+type gasTestEnv struct {
+	t       *testing.T
+	cfg     *types.Chain33Config
+	stateDB db.DB
+	localDB db.KVDB
+	caller  common.Address
+	txCount int
+}
+
+// newGasTestEnv londonHeight和shanghaiHeight分别为两个分叉的生效高度，执行高度固定为10
+func newGasTestEnv(t *testing.T, londonHeight, shanghaiHeight int64) *gasTestEnv {
+	cfg := types.NewChain33Config(strings.Replace(types.GetDefaultCfgstring(), "Title=\"local\"", "Title=\"chain33\"", 1))
+	cfg.SetDappFork(evmtypes.ExecutorName, evmtypes.ForkEVMLondon, londonHeight)
+	cfg.SetDappFork(evmtypes.ExecutorName, evmtypes.ForkEVMShanghai, shanghaiHeight)
+	EvmAddress = address.ExecAddress(cfg.ExecName(evmtypes.ExecutorName))
+	stateDB, err := db.NewGoMemDB("state", "", 0)
+	assert.NilError(t, err)
+	localDB, err := db.NewGoMemDB("local", "", 0)
+	assert.NilError(t, err)
+	return &gasTestEnv{t: t, cfg: cfg, stateDB: stateDB, localDB: db.NewKVDB(localDB), caller: common.BytesToAddress([]byte{0x01})}
+}
+
+// executor 基于测试环境的stateDB和localDB创建执行器
+func (e *gasTestEnv) executor() *EVMExecutor {
+	api := new(mocks.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(e.cfg, nil)
+	coinsAccount := account.NewCoinsAccount(e.cfg)
+	coinsAccount.SetDB(e.stateDB)
+
+	evm := NewEVMExecutor()
+	evm.SetAPI(api)
+	evm.SetEnv(10, 1539918074, 1)
+	evm.SetStateDB(e.stateDB)
+	evm.SetLocalDB(e.localDB)
+	evm.SetCoinsAccount(coinsAccount)
+	evm.CheckInit()
+	return evm
+}
+
+// exec 执行一笔合约交易，并把状态变更写入stateDB，返回交易的合约回执
+func (e *gasTestEnv) exec(to common.Address, code, para []byte) (*evmtypes.ReceiptEVMContract, error) {
+	evm := e.executor()
+	e.txCount++
+	txHash := common.BytesToHash([]byte{byte(e.txCount)}).Bytes()
+	msg := common.NewMessage(e.caller, &to, 0, 0, testGasLimit, 1, code, para, "")
+	receipt, err := evm.innerExec(msg, txHash, 0, testGasLimit, false)
+	if err != nil {
+		return nil, err
+	}
+	for _, kv := range receipt.KV {
+		assert.NilError(e.t, e.stateDB.Set(kv.Key, kv.Value))
+	}
+	var contract evmtypes.ReceiptEVMContract
+	for _, item := range receipt.Logs {
+		if item.Ty == evmtypes.TyLogCallContract {
+			assert.NilError(e.t, types.Decode(item.Log, &contract))
+		}
+	}
+	return &contract, nil
+}
+
+// deploy 部署合约，构造函数先将0号存储槽设置为1，再返回runtime代码
+func (e *gasTestEnv) deploy(runtimeCode string) (common.Address, uint64) {
+	contract, err := e.exec(*common.StringToAddress(EvmAddress), common.FromHex(deployCode(runtimeCode)), nil)
+	assert.NilError(e.t, err)
+	addr := common.StringToAddress(contract.ContractAddr)
+	assert.Assert(e.t, addr != nil)
+	return *addr, contract.UsedGas
+}
+
+func (e *gasTestEnv) call(addr common.Address) uint64 {
+	contract, err := e.exec(addr, nil, nil)
+	assert.NilError(e.t, err)
+	return contract.UsedGas
+}
+
+func deployCode(runtimeCode string) string {
+	// PUSH1 1 PUSH1 0 SSTORE PUSH1 len DUP1 PUSH1 16 PUSH1 0 CODECOPY PUSH1 0 RETURN
+	return fmt.Sprintf("600160005560%02x8060106000396000f3%s", len(runtimeCode)/2, runtimeCode)
+}
+
+const (
+	// 清空0号存储槽
+	clearSlotCode = "600060005500"
+	// 清空0号存储槽后再写入1号存储槽
+	clearAndSetSlotCode = "6000600055600160015500"
+)
+
+func TestInitCodeGas(t *testing.T) {
+	runtimeCode := strings.Repeat(clearSlotCode, 20)
+	words := uint64((len(deployCode(runtimeCode))/2 + 31) / 32)
+
+	_, before := newGasTestEnv(t, 0, 100).deploy(runtimeCode)
+	_, after := newGasTestEnv(t, 0, 0).deploy(runtimeCode)
+	assert.Equal(t, before+params.InitCodeWordGas*words, after)
+
+	// Gas不足以支付初始化代码的费用
+	env := newGasTestEnv(t, 0, 0)
+	code := common.FromHex(deployCode(runtimeCode))
+	evm := env.executor()
+	msg := common.NewMessage(env.caller, common.StringToAddress(EvmAddress), 0, 0, words, 1, code, nil, "")
+	_, err := evm.innerExec(msg, []byte{0x01}, 0, words, false)
+	assert.ErrorContains(t, err, "out of gas")
+}
+
+func TestRefundGas(t *testing.T) {
+	// 退款超过已用Gas的1/5时，按1/5返还
+	before := newGasTestEnv(t, 100, 100)
+	addr, _ := before.deploy(clearSlotCode)
+	beforeGas := before.call(addr)
+	assert.Equal(t, params.SstoreClearGas+6, beforeGas)
+
+	after := newGasTestEnv(t, 0, 100)
+	addr, _ = after.deploy(clearSlotCode)
+	assert.Equal(t, beforeGas-beforeGas/params.RefundQuotientEIP3529, after.call(addr))
+
+	// 退款不足已用Gas的1/5时，全额返还
+	before = newGasTestEnv(t, 100, 100)
+	addr, _ = before.deploy(clearAndSetSlotCode)
+	beforeGas = before.call(addr)
+	assert.Equal(t, params.SstoreClearGas+params.SstoreSetGas+12, beforeGas)
+
+	after = newGasTestEnv(t, 0, 100)
+	addr, _ = after.deploy(clearAndSetSlotCode)
+	assert.Equal(t, beforeGas-params.SstoreClearsScheduleRefundEIP3529, after.call(addr))
+}
@@ -0,0 +1,349 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	chain33Common "github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/model"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+)
+
+const (
+	// 合约日志查询默认返回条数
+	defaultLogCount = 100
+	// 合约日志查询最大返回条数
+	maxLogCount = 1000
+	// 合约日志最多包含的topic数量
+	maxLogTopics = 4
+)
+
+// 合约日志及其索引在localdb中的key，日志按 高度:交易序号:日志序号 排序
+// 日志数据只保存在log下，各个索引的value都是日志的primaryKey
+var (
+	logPrefix          = "LODB-" + evmtypes.ExecutorName + "-log:"
+	logAddrPrefix      = "LODB-" + evmtypes.ExecutorName + "-logaddr:"
+	logTopicPrefix     = "LODB-" + evmtypes.ExecutorName + "-logtopic:"
+	logAddrTopicPrefix = "LODB-" + evmtypes.ExecutorName + "-logaddrtopic:"
+)
+
+func getLogPrimaryKey(height int64, txIndex, logIndex int32) string {
+	return fmt.Sprintf("%012d:%05d:%05d", height, txIndex, logIndex)
+}
+
+func getLogKey(primary string) []byte {
+	return []byte(logPrefix + primary)
+}
+
+func getLogAddrPrefix(addr string) string {
+	return fmt.Sprintf("%s%s:", logAddrPrefix, addr)
+}
+
+func getLogTopicPrefix(pos int, topic string) string {
+	return fmt.Sprintf("%s%d:%s:", logTopicPrefix, pos, topic)
+}
+
+func getLogAddrTopicPrefix(addr string, pos int, topic string) string {
+	return fmt.Sprintf("%s%s:%d:%s:", logAddrTopicPrefix, addr, pos, topic)
+}
+
+// 合约地址在索引中统一使用十六进制格式，兼容不同的地址格式
+func normalizeLogAddr(addr string) (string, error) {
+	a := common.StringToAddress(addr)
+	if a == nil {
+		return "", types.ErrInvalidAddress
+	}
+	return hex.EncodeToString(a.Bytes()), nil
+}
+
+func normalizeLogTopic(topic string) (string, error) {
+	data, err := chain33Common.FromHex(topic)
+	if err != nil {
+		return "", err
+	}
+	if len(data) != common.HashLength {
+		return "", model.ErrInvalidLogTopic
+	}
+	return chain33Common.ToHex(data), nil
+}
+
+// 解析交易回执中的合约日志，没有来源合约记录的日志归属于交易调用的合约
+func parseEvmLogs(tx *types.Transaction, receipt *types.ReceiptData, height int64, index int) ([]*evmtypes.EvmLogItem, error) {
+	var contractAddr string
+	for _, logItem := range receipt.Logs {
+		if logItem.Ty == evmtypes.TyLogCallContract {
+			var contract evmtypes.ReceiptEVMContract
+			if err := types.Decode(logItem.Log, &contract); err != nil {
+				return nil, err
+			}
+			contractAddr = contract.ContractAddr
+		}
+	}
+
+	var items []*evmtypes.EvmLogItem
+	for _, logItem := range receipt.Logs {
+		switch logItem.Ty {
+		case evmtypes.TyLogEVMEventData:
+			var evmLog types.EVMLog
+			if err := types.Decode(logItem.Log, &evmLog); err != nil {
+				return nil, err
+			}
+			item := &evmtypes.EvmLogItem{
+				ContractAddr: contractAddr,
+				Data:         chain33Common.ToHex(evmLog.Data),
+				Height:       height,
+				TxIndex:      int32(index),
+				LogIndex:     int32(len(items)),
+				TxHash:       chain33Common.ToHex(tx.Hash()),
+			}
+			for _, topic := range evmLog.Topic {
+				item.Topics = append(item.Topics, chain33Common.ToHex(topic))
+			}
+			items = append(items, item)
+		case evmtypes.TyLogEVMEventSource:
+			if len(items) == 0 {
+				continue
+			}
+			var source evmtypes.EVMEventSource
+			if err := types.Decode(logItem.Log, &source); err != nil {
+				return nil, err
+			}
+			items[len(items)-1].ContractAddr = source.ContractAddr
+		}
+	}
+	return items, nil
+}
+
+// 生成合约日志及其索引，按合约地址、topic位置、合约地址+topic位置分别建立索引
+// 合约地址无法解析时只建立topic索引，不影响区块的localdb执行
+func getEvmLogsKV(items []*evmtypes.EvmLogItem) []*types.KeyValue {
+	var kvs []*types.KeyValue
+	for _, item := range items {
+		addr, err := normalizeLogAddr(item.ContractAddr)
+		hasAddr := err == nil
+		primary := getLogPrimaryKey(item.Height, item.TxIndex, item.LogIndex)
+		kvs = append(kvs, &types.KeyValue{Key: getLogKey(primary), Value: types.Encode(item)})
+		if hasAddr {
+			kvs = append(kvs, &types.KeyValue{Key: []byte(getLogAddrPrefix(addr) + primary), Value: []byte(primary)})
+		}
+		for pos, topic := range item.Topics {
+			if pos >= maxLogTopics {
+				break
+			}
+			kvs = append(kvs, &types.KeyValue{Key: []byte(getLogTopicPrefix(pos, topic) + primary), Value: []byte(primary)})
+			if hasAddr {
+				kvs = append(kvs, &types.KeyValue{Key: []byte(getLogAddrTopicPrefix(addr, pos, topic) + primary), Value: []byte(primary)})
+			}
+		}
+	}
+	return kvs
+}
+
+// 规范化后的日志过滤条件
+type logFilter struct {
+	addresses  map[string]bool
+	topics     []map[string]bool
+	fromHeight int64
+	toHeight   int64
+	count      int32
+	primaryKey string
+}
+
+func newLogFilter(req *evmtypes.EvmGetLogsReq) (*logFilter, error) {
+	if req.FromHeight < 0 || req.ToHeight < 0 || (req.ToHeight > 0 && req.ToHeight < req.FromHeight) {
+		return nil, types.ErrInvalidParam
+	}
+	if len(req.Topics) > maxLogTopics {
+		return nil, model.ErrInvalidLogTopic
+	}
+	filter := &logFilter{
+		addresses:  make(map[string]bool),
+		fromHeight: req.FromHeight,
+		toHeight:   req.ToHeight,
+		count:      req.Count,
+		primaryKey: req.PrimaryKey,
+	}
+	if filter.count <= 0 {
+		filter.count = defaultLogCount
+	}
+	if filter.count > maxLogCount {
+		filter.count = maxLogCount
+	}
+	for _, addr := range req.Addresses {
+		a, err := normalizeLogAddr(addr)
+		if err != nil {
+			return nil, err
+		}
+		filter.addresses[a] = true
+	}
+	for _, topics := range req.Topics {
+		set := make(map[string]bool)
+		for _, topic := range topics.GetTopics() {
+			t, err := normalizeLogTopic(topic)
+			if err != nil {
+				return nil, err
+			}
+			set[t] = true
+		}
+		filter.topics = append(filter.topics, set)
+	}
+	return filter, nil
+}
+
+// 检查日志是否满足过滤条件
+func (f *logFilter) match(item *evmtypes.EvmLogItem) bool {
+	if item.Height < f.fromHeight || (f.toHeight > 0 && item.Height > f.toHeight) {
+		return false
+	}
+	if len(f.addresses) > 0 {
+		addr, err := normalizeLogAddr(item.ContractAddr)
+		if err != nil || !f.addresses[addr] {
+			return false
+		}
+	}
+	for pos, set := range f.topics {
+		if len(set) == 0 {
+			continue
+		}
+		if pos >= len(item.Topics) || !set[item.Topics[pos]] {
+			return false
+		}
+	}
+	return true
+}
+
+// 选择查询使用的索引前缀，优先使用合约地址+topic索引
+func (f *logFilter) indexPrefixes() []string {
+	topicPos := -1
+	for pos, set := range f.topics {
+		if len(set) > 0 {
+			topicPos = pos
+			break
+		}
+	}
+	var prefixes []string
+	switch {
+	case len(f.addresses) > 0 && topicPos >= 0:
+		for addr := range f.addresses {
+			for topic := range f.topics[topicPos] {
+				prefixes = append(prefixes, getLogAddrTopicPrefix(addr, topicPos, topic))
+			}
+		}
+	case len(f.addresses) > 0:
+		for addr := range f.addresses {
+			prefixes = append(prefixes, getLogAddrPrefix(addr))
+		}
+	case topicPos >= 0:
+		for topic := range f.topics[topicPos] {
+			prefixes = append(prefixes, getLogTopicPrefix(topicPos, topic))
+		}
+	default:
+		prefixes = append(prefixes, logPrefix)
+	}
+	return prefixes
+}
+
+// 在单个索引中按高度顺序查找满足条件的日志，最多返回count条
+func (f *logFilter) scan(localDB db.KVDB, prefix string) ([]*evmtypes.EvmLogItem, error) {
+	// List 不包含起始key本身，只用高度作为起始key可以包含该高度的所有日志
+	start := prefix + fmt.Sprintf("%012d", f.fromHeight)
+	if f.primaryKey > fmt.Sprintf("%012d", f.fromHeight) {
+		start = prefix + f.primaryKey
+	}
+	end := fmt.Sprintf("%012d", f.toHeight)
+
+	var items []*evmtypes.EvmLogItem
+	for {
+		values, err := localDB.List([]byte(prefix), []byte(start), f.count, db.ListASC)
+		if err == types.ErrNotFound || (err == nil && len(values) == 0) {
+			return items, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, value := range values {
+			var item *evmtypes.EvmLogItem
+			if prefix == logPrefix {
+				item = &evmtypes.EvmLogItem{}
+				if err = types.Decode(value, item); err != nil {
+					return nil, err
+				}
+			} else if item, err = getEvmLogItem(localDB, string(value)); err != nil {
+				return nil, err
+			}
+			primary := getLogPrimaryKey(item.Height, item.TxIndex, item.LogIndex)
+			if f.toHeight > 0 && primary[:len(end)] > end {
+				return items, nil
+			}
+			start = prefix + primary
+			if f.match(item) {
+				items = append(items, item)
+				if int32(len(items)) >= f.count {
+					return items, nil
+				}
+			}
+		}
+		if int32(len(values)) < f.count {
+			return items, nil
+		}
+	}
+}
+
+func getEvmLogItem(localDB db.KVDB, primary string) (*evmtypes.EvmLogItem, error) {
+	value, err := localDB.Get(getLogKey(primary))
+	if err != nil {
+		return nil, err
+	}
+	var item evmtypes.EvmLogItem
+	if err = types.Decode(value, &item); err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
+
+// getLogs 按合约地址、topic和高度范围查询合约日志
+func (evm *EVMExecutor) getLogs(req *evmtypes.EvmGetLogsReq) (*evmtypes.EvmGetLogsResp, error) {
+	filter, err := newLogFilter(req)
+	if err != nil {
+		return nil, err
+	}
+	// 每个索引都按顺序返回最多count条，合并后取前count条即为结果
+	var items []*evmtypes.EvmLogItem
+	seen := make(map[string]bool)
+	for _, prefix := range filter.indexPrefixes() {
+		list, err := filter.scan(evm.GetLocalDB(), prefix)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range list {
+			primary := getLogPrimaryKey(item.Height, item.TxIndex, item.LogIndex)
+			if seen[primary] {
+				continue
+			}
+			seen[primary] = true
+			items = append(items, item)
+		}
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return getLogPrimaryKey(items[i].Height, items[i].TxIndex, items[i].LogIndex) <
+			getLogPrimaryKey(items[j].Height, items[j].TxIndex, items[j].LogIndex)
+	})
+	resp := &evmtypes.EvmGetLogsResp{}
+	if int32(len(items)) > filter.count {
+		items = items[:filter.count]
+	}
+	resp.Logs = items
+	if int32(len(items)) == filter.count {
+		last := items[len(items)-1]
+		resp.PrimaryKey = getLogPrimaryKey(last.Height, last.TxIndex, last.LogIndex)
+	}
+	return resp, nil
+}
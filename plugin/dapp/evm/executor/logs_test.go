@@ -0,0 +1,90 @@
+package executor
+
+import (
+	"testing"
+
+	chain33Common "github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/model"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+	"gotest.tools/assert"
+)
+
+func TestParseEvmLogs(t *testing.T) {
+	caller := common.BytesToAddress([]byte{0x01}).String()
+	inner := common.BytesToAddress([]byte{0x02}).String()
+	topic := common.BytesToHash([]byte{0xaa}).Bytes()
+	receipt := &types.ReceiptData{Ty: types.ExecOk, Logs: []*types.ReceiptLog{
+		{Ty: evmtypes.TyLogEVMEventData, Log: types.Encode(&types.EVMLog{Topic: [][]byte{topic}, Data: []byte{0x01}})},
+		{Ty: evmtypes.TyLogEVMEventData, Log: types.Encode(&types.EVMLog{Topic: [][]byte{topic}})},
+		{Ty: evmtypes.TyLogEVMEventSource, Log: types.Encode(&evmtypes.EVMEventSource{ContractAddr: inner})},
+		{Ty: evmtypes.TyLogCallContract, Log: types.Encode(&evmtypes.ReceiptEVMContract{ContractAddr: caller})},
+	}}
+	items, err := parseEvmLogs(&types.Transaction{}, receipt, 10, 2)
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(items))
+	// 没有来源记录的日志归属于交易调用的合约
+	assert.Equal(t, caller, items[0].ContractAddr)
+	assert.Equal(t, inner, items[1].ContractAddr)
+	assert.Equal(t, int32(1), items[1].LogIndex)
+	assert.Equal(t, int32(2), items[1].TxIndex)
+	assert.Equal(t, chain33Common.ToHex(topic), items[1].Topics[0])
+}
+
+func TestGetLogs(t *testing.T) {
+	memDB, err := db.NewGoMemDB("test", "", 0)
+	assert.NilError(t, err)
+	localDB := db.NewKVDB(memDB)
+
+	addr1 := common.BytesToAddress([]byte{0x01}).String()
+	addr2 := common.BytesToAddress([]byte{0x02}).String()
+	transfer := chain33Common.ToHex(common.BytesToHash([]byte{0xaa}).Bytes())
+	approval := chain33Common.ToHex(common.BytesToHash([]byte{0xbb}).Bytes())
+	owner := chain33Common.ToHex(common.BytesToHash([]byte{0xcc}).Bytes())
+	items := []*evmtypes.EvmLogItem{
+		{ContractAddr: addr1, Topics: []string{transfer, owner}, Height: 1, TxIndex: 0, LogIndex: 0},
+		{ContractAddr: addr1, Topics: []string{approval, owner}, Height: 1, TxIndex: 0, LogIndex: 1},
+		{ContractAddr: addr2, Topics: []string{transfer}, Height: 2, TxIndex: 1, LogIndex: 0},
+		{ContractAddr: addr1, Topics: []string{transfer}, Height: 3, TxIndex: 0, LogIndex: 0},
+		{ContractAddr: addr2, Topics: []string{approval, owner}, Height: 5, TxIndex: 3, LogIndex: 0},
+	}
+	for _, kv := range getEvmLogsKV(items) {
+		assert.NilError(t, localDB.Set(kv.Key, kv.Value))
+	}
+	evm := NewEVMExecutor()
+	evm.SetLocalDB(localDB)
+
+	query := func(req *evmtypes.EvmGetLogsReq) ([]int64, string) {
+		resp, err := evm.getLogs(req)
+		assert.NilError(t, err)
+		var heights []int64
+		for _, item := range resp.Logs {
+			heights = append(heights, item.Height*10+int64(item.LogIndex))
+		}
+		return heights, resp.PrimaryKey
+	}
+
+	heights, _ := query(&evmtypes.EvmGetLogsReq{})
+	assert.DeepEqual(t, []int64{10, 11, 20, 30, 50}, heights)
+	heights, _ = query(&evmtypes.EvmGetLogsReq{Addresses: []string{addr1}})
+	assert.DeepEqual(t, []int64{10, 11, 30}, heights)
+	heights, _ = query(&evmtypes.EvmGetLogsReq{Topics: []*evmtypes.EvmLogTopics{{Topics: []string{transfer}}}})
+	assert.DeepEqual(t, []int64{10, 20, 30}, heights)
+	heights, _ = query(&evmtypes.EvmGetLogsReq{Addresses: []string{addr1, addr2}, Topics: []*evmtypes.EvmLogTopics{{}, {Topics: []string{owner}}}})
+	assert.DeepEqual(t, []int64{10, 11, 50}, heights)
+	heights, _ = query(&evmtypes.EvmGetLogsReq{Topics: []*evmtypes.EvmLogTopics{{Topics: []string{transfer, approval}}}, FromHeight: 2, ToHeight: 3})
+	assert.DeepEqual(t, []int64{20, 30}, heights)
+
+	// 翻页
+	heights, primary := query(&evmtypes.EvmGetLogsReq{Count: 2})
+	assert.DeepEqual(t, []int64{10, 11}, heights)
+	heights, primary = query(&evmtypes.EvmGetLogsReq{Count: 2, PrimaryKey: primary})
+	assert.DeepEqual(t, []int64{20, 30}, heights)
+	heights, _ = query(&evmtypes.EvmGetLogsReq{Count: 2, PrimaryKey: primary})
+	assert.DeepEqual(t, []int64{50}, heights)
+
+	_, err = evm.getLogs(&evmtypes.EvmGetLogsReq{Topics: []*evmtypes.EvmLogTopics{{Topics: []string{"0x01"}}}})
+	assert.Equal(t, model.ErrInvalidLogTopic, err)
+}
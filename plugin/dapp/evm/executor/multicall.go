@@ -0,0 +1,114 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"math/big"
+
+	"github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/model"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/runtime"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+)
+
+// 批量只读调用最多包含的调用数量
+const maxMultiCallCount = 1000
+
+// multiCall 基于同一个状态依次执行多个只读调用
+// 每个调用都使用新的内存状态，调用之间的状态变更互不可见；
+// 开启交易回放跟踪时，localdb中的合约状态数据也固定在查询开始时的高度
+func (evm *EVMExecutor) multiCall(req *evmtypes.EvmMultiCallReq) (*evmtypes.EvmMultiCallResp, error) {
+	if len(req.Calls) == 0 || len(req.Calls) > maxMultiCallCount {
+		return nil, types.ErrInvalidParam
+	}
+	for _, call := range req.Calls {
+		if call == nil {
+			return nil, types.ErrInvalidParam
+		}
+	}
+	localDB := evm.GetLocalDB()
+	defer evm.SetLocalDB(localDB)
+	traceEnabled := evm.isTraceEnabled()
+
+	resp := &evmtypes.EvmMultiCallResp{Height: evm.GetHeight()}
+	for _, call := range req.Calls {
+		if traceEnabled {
+			evm.SetLocalDB(newHistoryLocalDB(localDB, evm.GetHeight()+1))
+		} else {
+			evm.SetLocalDB(newCallLocalDB(localDB))
+		}
+		resp.Results = append(resp.Results, evm.staticCall(call))
+	}
+	return resp, nil
+}
+
+// callLocalDB 合约状态数据会写入localdb，只读调用的写入只保存在缓存中，调用结束后丢弃
+type callLocalDB struct {
+	db.KVDB
+	cache map[string][]byte
+}
+
+func newCallLocalDB(localDB db.KVDB) *callLocalDB {
+	return &callLocalDB{KVDB: localDB, cache: make(map[string][]byte)}
+}
+
+// Get 优先读取本次调用写入的数据
+func (l *callLocalDB) Get(key []byte) ([]byte, error) {
+	if value, ok := l.cache[string(key)]; ok {
+		if value == nil {
+			return nil, types.ErrNotFound
+		}
+		return value, nil
+	}
+	return l.KVDB.Get(key)
+}
+
+// Set 只写入缓存
+func (l *callLocalDB) Set(key []byte, value []byte) error {
+	l.cache[string(key)] = value
+	return nil
+}
+
+// staticCall 执行一次只读调用，调用失败时也返回消耗的Gas和revert数据
+func (evm *EVMExecutor) staticCall(call *evmtypes.EvmQueryReq) *evmtypes.EvmCallResult {
+	result := &evmtypes.EvmCallResult{Address: call.Address}
+	to := common.StringToAddress(call.Address)
+	if to == nil {
+		result.Error = types.ErrInvalidAddress.Error()
+		return result
+	}
+	cfg := evm.GetAPI().GetConfig()
+	caller := common.ExecAddress(cfg.ExecName(evmtypes.ExecutorName))
+	if len(call.Caller) > 0 {
+		callAddr := common.StringToAddress(call.Caller)
+		if callAddr == nil {
+			result.Error = types.ErrInvalidAddress.Error()
+			return result
+		}
+		caller = *callAddr
+	}
+
+	evm.CheckInit()
+	if !evm.mStateDB.Exist(to.String()) {
+		result.Error = model.ErrContractNotExist.Error()
+		return result
+	}
+	msg := common.NewMessage(caller, to, 0, 0, evmtypes.MaxGasLimit, 1, nil, common.FromHex(call.Input), "multiCall")
+	txHash := common.BigToHash(big.NewInt(evmtypes.MaxGasLimit)).Bytes()
+	env := runtime.NewEVM(evm.NewEVMContext(msg, txHash), evm.mStateDB, *evm.vmCfg, cfg)
+	evm.mStateDB.Prepare(common.BytesToHash(txHash), 0)
+	ret, _, leftOverGas, err := env.Call(runtime.AccountRef(caller), *to, msg.Para(), msg.GasLimit(), 0)
+
+	result.RawData = common.Bytes2Hex(ret)
+	result.GasUsed = msg.GasLimit() - leftOverGas
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	result.Success = true
+	return result
+}
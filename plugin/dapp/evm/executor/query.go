@@ -130,6 +130,22 @@ func (evm *EVMExecutor) Query_EvmDebug(in *evmtypes.EvmDebugReq) (types.Message,
 	return ret, nil
 }
 
+// Query_TraceTransaction 在历史状态上重新执行一笔已上链的合约交易，返回执行跟踪信息
+func (evm *EVMExecutor) Query_TraceTransaction(in *evmtypes.EvmTraceTxReq) (types.Message, error) {
+	if in == nil || len(in.TxHash) == 0 {
+		return nil, types.ErrInvalidParam
+	}
+	return evm.traceTransaction(in)
+}
+
+// Query_GetLogs 按合约地址、topic和高度范围查询合约生成的event日志
+func (evm *EVMExecutor) Query_GetLogs(in *evmtypes.EvmGetLogsReq) (types.Message, error) {
+	if in == nil {
+		return nil, types.ErrInvalidParam
+	}
+	return evm.getLogs(in)
+}
+
 // Query_Query 此方法用来调用合约的只读接口，不修改原有执行器的状态数据
 func (evm *EVMExecutor) Query_Query(in *evmtypes.EvmQueryReq) (types.Message, error) {
 	evm.CheckInit()
@@ -179,6 +195,14 @@ func (evm *EVMExecutor) Query_Query(in *evmtypes.EvmQueryReq) (types.Message, er
 	return ret, nil
 }
 
+// Query_MultiCall 批量调用合约的只读接口，返回每个调用的结果、错误信息和消耗的Gas
+func (evm *EVMExecutor) Query_MultiCall(in *evmtypes.EvmMultiCallReq) (types.Message, error) {
+	if in == nil {
+		return nil, types.ErrInvalidParam
+	}
+	return evm.multiCall(in)
+}
+
 func (evm *EVMExecutor) Query_GetNonce(in *evmtypes.EvmGetNonceReq) (types.Message, error) {
 	evm.CheckInit()
 	nonce := evm.mStateDB.GetNonce(in.Address)
@@ -6,7 +6,10 @@ import (
 	"testing"
 
 	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/types"
 	evmAbi "github.com/33cn/plugin/plugin/dapp/evm/executor/abi"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/model"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
 	"gotest.tools/assert"
 )
 
@@ -123,3 +126,71 @@ func Test_UnpackInputLockOfBridgevmxgo(t *testing.T) {
 	}
 	assert.Equal(t, correct, 3)
 }
+
+func TestMultiCallParam(t *testing.T) {
+	evm := NewEVMExecutor()
+	_, err := evm.Query_MultiCall(&evmtypes.EvmMultiCallReq{})
+	assert.Equal(t, types.ErrInvalidParam, err)
+	_, err = evm.Query_MultiCall(&evmtypes.EvmMultiCallReq{Calls: make([]*evmtypes.EvmQueryReq, maxMultiCallCount+1)})
+	assert.Equal(t, types.ErrInvalidParam, err)
+	_, err = evm.Query_MultiCall(&evmtypes.EvmMultiCallReq{Calls: []*evmtypes.EvmQueryReq{{Address: "0x0000000000000000000000000000000000000abc"}, nil}})
+	assert.Equal(t, types.ErrInvalidParam, err)
+}
+
+const (
+	// 有输入数据时先清空0号存储槽，再返回0号存储槽的值
+	clearOrReadSlotCode = "36600757600d565b60006000555b60005460005260206000f3"
+	// 返回数据为1的revert
+	revertCode = "600160005260206000fd"
+)
+
+func TestMultiCall(t *testing.T) {
+	env := newGasTestEnv(t, 100, 100)
+	slotAddr, _ := env.deploy(clearOrReadSlotCode)
+	revertAddr, _ := env.deploy(revertCode)
+	word := make([]byte, 32)
+	zero := common.ToHex(word)
+	word[31] = 1
+	one := common.ToHex(word)
+
+	resp, err := env.executor().Query_MultiCall(&evmtypes.EvmMultiCallReq{Calls: []*evmtypes.EvmQueryReq{
+		{Address: slotAddr.String(), Input: "0x01"},
+		{Address: slotAddr.String()},
+		{Address: revertAddr.String()},
+		{Address: "0x0000000000000000000000000000000000000abc"},
+		{Address: "invalid"},
+		{Address: slotAddr.String(), Caller: "invalid"},
+		{Address: slotAddr.String()},
+	}})
+	assert.NilError(t, err)
+	results := resp.(*evmtypes.EvmMultiCallResp).Results
+	assert.Equal(t, 7, len(results))
+
+	// 每个调用的结果按请求顺序返回，调用中的状态变更对后续调用不可见
+	assert.Assert(t, results[0].Success)
+	assert.Equal(t, zero, results[0].RawData)
+	assert.Assert(t, results[1].Success)
+	assert.Equal(t, one, results[1].RawData)
+	assert.Equal(t, slotAddr.String(), results[1].Address)
+
+	// 失败的调用返回revert数据和错误信息，不影响其他调用
+	assert.Assert(t, !results[2].Success)
+	assert.Equal(t, one, results[2].RawData)
+	assert.Assert(t, results[2].Error != "")
+	assert.Equal(t, model.ErrContractNotExist.Error(), results[3].Error)
+	assert.Equal(t, types.ErrInvalidAddress.Error(), results[4].Error)
+	assert.Equal(t, types.ErrInvalidAddress.Error(), results[5].Error)
+	assert.Assert(t, results[6].Success)
+	assert.Equal(t, one, results[6].RawData)
+
+	// 消耗的Gas和执行同样的交易一致，失败的调用也返回消耗的Gas
+	assert.Equal(t, env.call(slotAddr), results[1].GasUsed)
+	assert.Equal(t, results[1].GasUsed, results[6].GasUsed)
+	// PUSH1 PUSH1 MSTORE(含内存扩展) PUSH1 PUSH1 REVERT
+	assert.Equal(t, uint64(18), results[2].GasUsed)
+	assert.Equal(t, uint64(0), results[3].GasUsed)
+	contract, err := env.exec(slotAddr, nil, []byte{0x01})
+	assert.NilError(t, err)
+	assert.Equal(t, contract.UsedGas, results[0].GasUsed)
+	assert.Assert(t, results[0].GasUsed > results[1].GasUsed)
+}
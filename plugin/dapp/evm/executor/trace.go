@@ -0,0 +1,306 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/33cn/chain33/client"
+	chain33Common "github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/model"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/runtime"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+)
+
+const (
+	// TracerStructLogger 指令级跟踪器
+	TracerStructLogger = "structLogger"
+	// TracerCallTracer 调用树跟踪器
+	TracerCallTracer = "callTracer"
+)
+
+var (
+	// 合约状态数据在localdb中的key前缀
+	stateItemPrefix = []byte("LODB-" + evmtypes.ExecutorName + "-state:")
+	// 合约状态数据历史值在localdb中的key前缀
+	stateHistoryPrefix = []byte("LODB-" + evmtypes.ExecutorName + "-statehist:")
+)
+
+// 合约状态数据历史值的key，记录某个区块第一次修改该状态数据之前的取值
+func getStateHistoryKey(stateKey []byte, height int64) []byte {
+	return []byte(fmt.Sprintf("%s%s:%020d", stateHistoryPrefix, stateKey, height))
+}
+
+func getStateHistoryPrefix(stateKey []byte) []byte {
+	return []byte(fmt.Sprintf("%s%s:", stateHistoryPrefix, stateKey))
+}
+
+// 是否开启交易回放跟踪，开启后ExecLocal会额外记录合约状态数据的历史值
+func (evm *EVMExecutor) isTraceEnabled() bool {
+	conf := types.ConfSub(evm.GetAPI().GetConfig(), evmtypes.ExecutorName)
+	return conf.GInt("evmTraceEnable") == 1
+}
+
+// 记录本区块中合约状态数据第一次变更之前的取值，回放交易时用来恢复父区块的合约状态
+// recorded 用于过滤同一笔交易中重复变更的状态数据
+func (evm *EVMExecutor) getStateHistoryKV(stateKey, preValue []byte, recorded map[string]bool) *types.KeyValue {
+	histKey := getStateHistoryKey(stateKey, evm.GetHeight())
+	if recorded[string(histKey)] {
+		return nil
+	}
+	recorded[string(histKey)] = true
+	// 同一区块之前的交易已经记录过
+	if _, err := evm.GetLocalDB().Get(histKey); err == nil {
+		return nil
+	}
+	// 统一保存为32字节，避免空值在localdb中被当作已删除
+	return &types.KeyValue{Key: histKey, Value: common.BytesToHash(preValue).Bytes()}
+}
+
+// historyStateDB 以指定状态哈希读取store中的历史状态数据，写入的数据只缓存在内存中
+type historyStateDB struct {
+	api       client.QueueProtocolAPI
+	stateHash []byte
+	cache     map[string][]byte
+}
+
+func newHistoryStateDB(api client.QueueProtocolAPI, stateHash []byte) *historyStateDB {
+	return &historyStateDB{api: api, stateHash: stateHash, cache: make(map[string][]byte)}
+}
+
+// Get 优先读取缓存，否则从store中读取历史数据
+func (s *historyStateDB) Get(key []byte) ([]byte, error) {
+	if value, ok := s.cache[string(key)]; ok {
+		if value == nil {
+			return nil, types.ErrNotFound
+		}
+		return value, nil
+	}
+	reply, err := s.api.StoreGet(&types.StoreGet{StateHash: s.stateHash, Keys: [][]byte{key}})
+	if err != nil {
+		return nil, err
+	}
+	if len(reply.Values) == 0 || reply.Values[0] == nil {
+		return nil, types.ErrNotFound
+	}
+	return reply.Values[0], nil
+}
+
+// Set 只写入缓存
+func (s *historyStateDB) Set(key []byte, value []byte) error {
+	s.cache[string(key)] = value
+	return nil
+}
+
+// Begin 缓存不区分事务
+func (s *historyStateDB) Begin() {}
+
+// Commit 缓存不区分事务
+func (s *historyStateDB) Commit() error { return nil }
+
+// Rollback 缓存不区分事务
+func (s *historyStateDB) Rollback() {}
+
+// historyLocalDB 在localdb上恢复指定区块执行前的合约状态数据，写入的数据只缓存在内存中
+type historyLocalDB struct {
+	db.KVDB
+	height int64
+	cache  map[string][]byte
+}
+
+func newHistoryLocalDB(localDB db.KVDB, height int64) *historyLocalDB {
+	return &historyLocalDB{KVDB: localDB, height: height, cache: make(map[string][]byte)}
+}
+
+// Get 合约状态数据取height及之后第一次变更前的取值，没有变更过则取当前值
+func (l *historyLocalDB) Get(key []byte) ([]byte, error) {
+	if value, ok := l.cache[string(key)]; ok {
+		if value == nil {
+			return nil, types.ErrNotFound
+		}
+		return value, nil
+	}
+	if bytes.HasPrefix(key, stateItemPrefix) {
+		values, err := l.KVDB.List(getStateHistoryPrefix(key), getStateHistoryKey(key, l.height-1), 1, db.ListASC)
+		if err == nil && len(values) > 0 {
+			return values[0], nil
+		}
+	}
+	return l.KVDB.Get(key)
+}
+
+// Set 只写入缓存
+func (l *historyLocalDB) Set(key []byte, value []byte) error {
+	l.cache[string(key)] = value
+	return nil
+}
+
+// 是否为evm合约交易
+func (evm *EVMExecutor) isEvmTx(tx *types.Transaction) bool {
+	exec := evm.GetAPI().GetConfig().GetParaExec(tx.Execer)
+	return bytes.Equal(exec, evmtypes.ExecerEvm) || bytes.HasPrefix(exec, evmtypes.UserPrefix)
+}
+
+// 创建回放执行器，状态数据恢复到区块执行前
+func (evm *EVMExecutor) newReplayExecutor(block *types.Block, parentStateHash []byte) *EVMExecutor {
+	replay := NewEVMExecutor()
+	replay.SetAPI(evm.GetAPI())
+	replay.SetLocalDB(newHistoryLocalDB(evm.GetLocalDB(), block.Height))
+	replay.SetStateDB(newHistoryStateDB(evm.GetAPI(), parentStateHash))
+	replay.SetEnv(block.Height, block.BlockTime, uint64(block.Difficulty))
+	replay.SetBlockInfo(block.ParentHash, block.MainHash, block.MainHeight)
+	replay.SetTxs(block.Txs)
+	return replay
+}
+
+// 在回放执行器中执行交易，执行成功时将状态变更写入缓存，供后续交易读取
+func (evm *EVMExecutor) replayTx(tx *types.Transaction, index int) (*types.Receipt, error) {
+	evm.CheckInit()
+	msg, err := evm.GetMessage(tx, index, nil)
+	if err != nil {
+		return nil, err
+	}
+	receipt, err := evm.innerExec(msg, tx.Hash(), index, msg.GasLimit(), false)
+	if err != nil {
+		return nil, err
+	}
+	for _, kv := range receipt.KV {
+		_ = evm.GetStateDB().Set(kv.Key, kv.Value)
+	}
+	return receipt, nil
+}
+
+// traceTransaction 在父区块状态上重新执行交易，并记录执行过程
+// 区块内排在目标交易之前的evm交易会先被依次重放，其它执行器的交易不会重放
+func (evm *EVMExecutor) traceTransaction(req *evmtypes.EvmTraceTxReq) (*evmtypes.EvmTraceTxResp, error) {
+	if !evm.isTraceEnabled() {
+		return nil, model.ErrTraceDisabled
+	}
+	hash, err := chain33Common.FromHex(req.TxHash)
+	if err != nil {
+		return nil, err
+	}
+	api := evm.GetAPI()
+	detail, err := api.QueryTx(&types.ReqHash{Hash: hash})
+	if err != nil {
+		return nil, err
+	}
+	if !evm.isEvmTx(detail.Tx) {
+		return nil, model.ErrTraceNotEvmTx
+	}
+	if detail.Height <= 0 {
+		return nil, types.ErrInvalidParam
+	}
+	blocks, err := api.GetBlocks(&types.ReqBlocks{Start: detail.Height, End: detail.Height})
+	if err != nil {
+		return nil, err
+	}
+	if len(blocks.Items) == 0 {
+		return nil, types.ErrBlockNotFound
+	}
+	block := blocks.Items[0].Block
+	headers, err := api.GetHeaders(&types.ReqBlocks{Start: detail.Height - 1, End: detail.Height - 1})
+	if err != nil {
+		return nil, err
+	}
+	if len(headers.Items) == 0 {
+		return nil, types.ErrBlockNotFound
+	}
+
+	replay := evm.newReplayExecutor(block, headers.Items[0].StateHash)
+	index := int(detail.Index)
+	for i := 0; i < index && i < len(block.Txs); i++ {
+		if !evm.isEvmTx(block.Txs[i]) {
+			continue
+		}
+		// 之前的交易执行失败时不会产生状态变更
+		_, _ = replay.replayTx(block.Txs[i], i)
+	}
+
+	var (
+		structLogger *runtime.StructLogger
+		callTracer   *runtime.CallTracer
+		vmCfg        = &runtime.Config{Debug: runtime.EVMDebugOn}
+	)
+	switch req.Tracer {
+	case "", TracerStructLogger:
+		structLogger = runtime.NewStructLogger(&runtime.LogConfig{
+			DisableStack:  req.DisableStack,
+			DisableMemory: req.DisableMemory,
+			Limit:         int(req.Limit),
+		})
+		vmCfg.Tracer = structLogger
+	case TracerCallTracer:
+		callTracer = runtime.NewCallTracer()
+		vmCfg.Tracer = callTracer
+	default:
+		return nil, model.ErrTraceUnknownTracer
+	}
+	replay.vmCfg = vmCfg
+
+	resp := &evmtypes.EvmTraceTxResp{TxHash: req.TxHash, Height: detail.Height, Index: detail.Index}
+	if _, err = replay.replayTx(detail.Tx, index); err != nil {
+		resp.Failed = true
+		resp.Error = err.Error()
+	}
+	if structLogger != nil {
+		resp.GasUsed = structLogger.GasUsed()
+		resp.ReturnValue = chain33Common.ToHex(structLogger.Output())
+		resp.StructLogs = toEvmStructLogs(structLogger.StructLogs())
+	}
+	if callTracer != nil && callTracer.Result() != nil {
+		resp.CallTrace = toEvmCallFrame(callTracer.Result())
+		resp.GasUsed = resp.CallTrace.GasUsed
+		resp.ReturnValue = resp.CallTrace.Output
+	}
+	return resp, nil
+}
+
+func toEvmStructLogs(logs []runtime.StructLog) []*evmtypes.EvmStructLog {
+	result := make([]*evmtypes.EvmStructLog, 0, len(logs))
+	for _, log := range logs {
+		item := &evmtypes.EvmStructLog{
+			Pc:      log.Pc,
+			Op:      log.Op.String(),
+			Gas:     log.Gas,
+			GasCost: log.GasCost,
+			Depth:   int32(log.Depth),
+			Memory:  log.Memory,
+			Refund:  log.RefundCounter,
+		}
+		for _, v := range log.Stack {
+			item.Stack = append(item.Stack, fmt.Sprintf("0x%x", v))
+		}
+		if log.Err != nil {
+			item.Error = log.Err.Error()
+		}
+		result = append(result, item)
+	}
+	return result
+}
+
+func toEvmCallFrame(frame *runtime.CallFrame) *evmtypes.EvmCallFrame {
+	result := &evmtypes.EvmCallFrame{
+		Type:    frame.Type,
+		From:    frame.From.String(),
+		To:      frame.To.String(),
+		Value:   frame.Value,
+		Gas:     frame.Gas,
+		GasUsed: frame.GasUsed,
+		Input:   chain33Common.ToHex(frame.Input),
+		Output:  chain33Common.ToHex(frame.Output),
+	}
+	if frame.Err != nil {
+		result.Error = frame.Err.Error()
+	}
+	for _, call := range frame.Calls {
+		result.Calls = append(result.Calls, toEvmCallFrame(call))
+	}
+	return result
+}
@@ -0,0 +1,46 @@
+package executor
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	"gotest.tools/assert"
+)
+
+func TestHistoryLocalDB(t *testing.T) {
+	memDB, err := db.NewGoMemDB("test", "", 0)
+	assert.NilError(t, err)
+	localDB := db.NewKVDB(memDB)
+
+	key := []byte(string(stateItemPrefix) + "addr:slot")
+	assert.NilError(t, localDB.Set(key, []byte{0x03}))
+	// 高度10将值从1改为2，高度20将值从2改为3
+	assert.NilError(t, localDB.Set(getStateHistoryKey(key, 10), common.BytesToHash([]byte{0x01}).Bytes()))
+	assert.NilError(t, localDB.Set(getStateHistoryKey(key, 20), common.BytesToHash([]byte{0x02}).Bytes()))
+
+	check := func(height int64, expect []byte) {
+		value, err := newHistoryLocalDB(localDB, height).Get(key)
+		assert.NilError(t, err)
+		assert.DeepEqual(t, expect, value)
+	}
+	check(5, common.BytesToHash([]byte{0x01}).Bytes())
+	check(10, common.BytesToHash([]byte{0x01}).Bytes())
+	check(15, common.BytesToHash([]byte{0x02}).Bytes())
+	check(20, common.BytesToHash([]byte{0x02}).Bytes())
+	check(21, []byte{0x03})
+
+	history := newHistoryLocalDB(localDB, 15)
+	assert.NilError(t, history.Set(key, []byte{0x04}))
+	value, err := history.Get(key)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []byte{0x04}, value)
+	assert.NilError(t, history.Set(key, nil))
+	_, err = history.Get(key)
+	assert.Equal(t, types.ErrNotFound, err)
+	// 回放时的写入不影响localdb
+	value, err = localDB.Get(key)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []byte{0x03}, value)
+}
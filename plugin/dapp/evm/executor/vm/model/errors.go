@@ -41,6 +41,8 @@ var (
 	ErrExecutionReverted = errors.New("evm: execution reverted")
 	// ErrMaxCodeSizeExceeded   evm: max code size exceeded
 	ErrMaxCodeSizeExceeded = errors.New("evm: max code size exceeded")
+	// ErrMaxInitCodeSizeExceeded evm: max initcode size exceeded
+	ErrMaxInitCodeSizeExceeded = errors.New("evm: max initcode size exceeded")
 
 	// ErrNoCoinsAccount no coins account in executor!
 	ErrNoCoinsAccount = errors.New("no coins account in executor")
@@ -54,4 +56,12 @@ var (
 	ErrInvalidRetsub = errors.New("invalid retsub")
 	// 没有配置gas
 	ErrNoGasConfigured = errors.New("ErrNoGasConfigured")
+	// ErrTraceDisabled 未开启交易回放跟踪
+	ErrTraceDisabled = errors.New("ErrTraceDisabled")
+	// ErrTraceNotEvmTx 不是evm合约交易
+	ErrTraceNotEvmTx = errors.New("ErrTraceNotEvmTx")
+	// ErrTraceUnknownTracer 不支持的跟踪器类型
+	ErrTraceUnknownTracer = errors.New("ErrTraceUnknownTracer")
+	// ErrInvalidLogTopic 合约日志topic格式错误
+	ErrInvalidLogTopic = errors.New("ErrInvalidLogTopic")
 )
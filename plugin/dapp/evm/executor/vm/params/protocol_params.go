@@ -47,6 +47,9 @@ const (
 	SstoreResetGasEIP2200             uint64 = 5000  // Once per SSTORE operation from clean non-zero to something else
 	SstoreClearsScheduleRefundEIP2200 uint64 = 15000 // Once per SSTORE operation for clearing an originally existing storage slot
 
+	SstoreClearsScheduleRefundEIP3529 uint64 = 4800 // Once per SSTORE operation for clearing an originally existing storage slot after EIP-3529 (London)
+	RefundQuotientEIP3529             uint64 = 5    // Maximum refund quotient; max gas refund is gasUsed / RefundQuotientEIP3529 after EIP-3529 (London)
+
 	JumpdestGas   uint64 = 1     // Once per JUMPDEST operation.
 	EpochDuration uint64 = 30000 // Duration between proof-of-work epochs.
 
@@ -98,7 +101,10 @@ const (
 	// Introduced in Tangerine Whistle (Eip 150)
 	CreateBySelfdestructGas uint64 = 25000
 
-	MaxCodeSize = 24576 // Maximum bytecode to permit for a contract
+	MaxCodeSize     = 24576           // Maximum bytecode to permit for a contract
+	MaxInitCodeSize = 2 * MaxCodeSize // Maximum initcode to permit in a creation transaction and create instructions (EIP-3860)
+
+	InitCodeWordGas uint64 = 2 // Once per word of the init code when creating a contract (EIP-3860)
 
 	// Precompiled contract gas prices
 
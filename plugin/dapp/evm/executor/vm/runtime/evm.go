@@ -79,6 +79,8 @@ type Context struct {
 	Time *big.Int
 	// Difficulty 指令，当前区块难度
 	Difficulty *big.Int
+	// BaseFee 指令，当前区块的基础手续费（EIP-3198）
+	BaseFee *big.Int
 }
 
 // EVM 结构对象及其提供的操作方法，用于进行满足以太坊EVM黄皮书规范定义的智能合约代码的创建和执行
@@ -177,6 +179,10 @@ func (evm *EVM) preCheck(caller ContractRef, value uint64) (pass bool, err error
 // 根据合约地址调用已经存在的合约，input为合约调用参数
 // 合约调用逻辑支持在合约调用的同时进行向合约转账的操作
 func (evm *EVM) Call(caller ContractRef, addr common.Address, input []byte, gas uint64, value uint64) (ret []byte, snapshot int, leftOverGas uint64, err error) {
+	if traceExit := evm.traceInnerCall(CALL, caller.Address(), addr, input, gas, value); traceExit != nil {
+		defer func() { traceExit(ret, leftOverGas, err) }()
+	}
+
 	pass, err := evm.preCheck(caller, value)
 	if !pass {
 		return nil, -1, gas, err
@@ -264,6 +270,10 @@ func (evm *EVM) Call(caller ContractRef, addr common.Address, input []byte, gas
 // 执行逻辑同Call方法，但是有以下几点不同：
 // 在创建合约对象时，合约对象的上下文地址（合约对象的self属性）被设置为caller的地址
 func (evm *EVM) CallCode(caller ContractRef, addr common.Address, input []byte, gas uint64, value uint64) (ret []byte, leftOverGas uint64, err error) {
+	if traceExit := evm.traceInnerCall(CALLCODE, caller.Address(), addr, input, gas, value); traceExit != nil {
+		defer func() { traceExit(ret, leftOverGas, err) }()
+	}
+
 	pass, err := evm.preCheck(caller, value)
 	if !pass {
 		return nil, gas, err
@@ -305,6 +315,10 @@ func (evm *EVM) CallCode(caller ContractRef, addr common.Address, input []byte,
 // 不支持向合约转账
 // 和CallCode不同的是，它会把合约的外部调用地址设置成caller的caller
 func (evm *EVM) DelegateCall(caller ContractRef, addr common.Address, input []byte, gas uint64) (ret []byte, leftOverGas uint64, err error) {
+	if traceExit := evm.traceInnerCall(DELEGATECALL, caller.Address(), addr, input, gas, 0); traceExit != nil {
+		defer func() { traceExit(ret, leftOverGas, err) }()
+	}
+
 	pass, err := evm.preCheck(caller, 0)
 	if !pass {
 		return nil, gas, err
@@ -346,6 +360,10 @@ func (evm *EVM) DelegateCall(caller ContractRef, addr common.Address, input []by
 // 不支持向合约转账
 // 在合约逻辑中，可以指定其它的合约地址以及输入参数进行合约调用，但是，这种情况下禁止修改MemoryStateDB中的任何数据，否则执行会出错
 func (evm *EVM) StaticCall(caller ContractRef, addr common.Address, input []byte, gas uint64) (ret []byte, leftOverGas uint64, err error) {
+	if traceExit := evm.traceInnerCall(STATICCALL, caller.Address(), addr, input, gas, 0); traceExit != nil {
+		defer func() { traceExit(ret, leftOverGas, err) }()
+	}
+
 	addrecrecover := common.BytesToAddress(common.RightPadBytes([]byte{1}, 20))
 	log.Info("StaticCall", "input", common.Bytes2Hex(input),
 		"addr slice", common.Bytes2Hex(addr.Bytes()),
@@ -430,11 +448,20 @@ func (evm *EVM) StaticCall(caller ContractRef, addr common.Address, input []byte
 // 目前chain33为了保证账户安全，不允许合约中涉及到外部账户的转账操作，
 // 所以，本步骤不接收转账金额参数
 func (evm *EVM) Create(caller ContractRef, contractAddr common.Address, code []byte, gas uint64, execName, alias string, value uint64) (ret []byte, snapshot int, leftOverGas uint64, err error) {
+	if traceExit := evm.traceInnerCall(CREATE, caller.Address(), contractAddr, code, gas, value); traceExit != nil {
+		defer func() { traceExit(ret, leftOverGas, err) }()
+	}
+
 	pass, err := evm.preCheck(caller, value)
 	if !pass {
 		return nil, -1, gas, err
 	}
 
+	// 上海分叉后，限制合约初始化代码的大小（EIP-3860）
+	if len(code) > params.MaxInitCodeSize && evm.cfg.IsDappFork(evm.StateDB.GetBlockHeight(), "evm", evmtypes.ForkEVMShanghai) {
+		return nil, -1, gas, model.ErrMaxInitCodeSizeExceeded
+	}
+
 	evm.Transfer(evm.StateDB, caller.Address(), contractAddr, value)
 
 	// 创建新的合约对象，包含双方地址以及合约代码，可用Gas信息
@@ -489,6 +516,18 @@ func (evm *EVM) Create(caller ContractRef, contractAddr common.Address, code []b
 	return ret, snapshot, contract.Gas, err
 }
 
+// traceInnerCall 调试模式下跟踪合约内部调用（调用深度大于0），返回的方法需要在调用结束时执行
+// 最外层调用由CaptureStart和CaptureEnd记录，这里返回nil
+func (evm *EVM) traceInnerCall(typ OpCode, from, to common.Address, input []byte, gas uint64, value uint64) func(ret []byte, leftOverGas uint64, err error) {
+	if EVMDebugOn != evm.VMConfig.Debug || evm.depth == 0 {
+		return nil
+	}
+	evm.VMConfig.Tracer.CaptureEnter(typ, from, to, input, gas, value)
+	return func(ret []byte, leftOverGas uint64, err error) {
+		evm.VMConfig.Tracer.CaptureExit(ret, gas-leftOverGas, err)
+	}
+}
+
 func (evm *EVM) precompile(addr common.Address) (PrecompiledContract, bool) {
 	p, ok := PrecompiledContractsBerlin[addr.ToHash160()]
 	return p, ok
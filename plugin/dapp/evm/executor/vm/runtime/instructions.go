@@ -965,3 +965,19 @@ func opChainID(pc *uint64, evm *EVM, callContext *callCtx) ([]byte, error) {
 	callContext.stack.push(chainId)
 	return nil, nil
 }
+
+// opBaseFee implements BASEFEE opcode (EIP-3198)
+func opBaseFee(pc *uint64, evm *EVM, callContext *callCtx) ([]byte, error) {
+	baseFee := new(uint256.Int)
+	if evm.BaseFee != nil {
+		baseFee, _ = uint256.FromBig(evm.BaseFee)
+	}
+	callContext.stack.push(baseFee)
+	return nil, nil
+}
+
+// opPush0 implements the PUSH0 opcode (EIP-3855)
+func opPush0(pc *uint64, evm *EVM, callContext *callCtx) ([]byte, error) {
+	callContext.stack.push(new(uint256.Int))
+	return nil, nil
+}
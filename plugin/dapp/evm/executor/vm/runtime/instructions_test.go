@@ -22,6 +22,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"math/big"
 	"testing"
 
 	"github.com/33cn/chain33/types"
@@ -29,6 +30,7 @@ import (
 
 	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
 	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common/crypto"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/params"
 	"github.com/holiman/uint256"
 )
 
@@ -656,3 +658,125 @@ func TestCreate2Addreses(t *testing.T) {
 		}
 	}
 }
+
+func TestPush0AndBaseFee(t *testing.T) {
+	var (
+		env   = NewEVM(Context{BaseFee: big.NewInt(100000)}, &state.MemoryStateDB{}, Config{}, chain33Cfg)
+		stack = newstack()
+		pc    = uint64(0)
+	)
+	opPush0(&pc, env, &callCtx{nil, stack, nil})
+	if stack.len() != 1 {
+		t.Fatal("PUSH0 should push one word")
+	}
+	if actual := stack.pop(); !actual.IsZero() {
+		t.Fatalf("PUSH0 expected zero, got %v", actual)
+	}
+	opBaseFee(&pc, env, &callCtx{nil, stack, nil})
+	if actual := stack.pop(); actual.Uint64() != 100000 {
+		t.Fatalf("BASEFEE expected 100000, got %v", actual)
+	}
+	// 没有设置BaseFee时压栈0
+	env = NewEVM(Context{}, &state.MemoryStateDB{}, Config{}, chain33Cfg)
+	opBaseFee(&pc, env, &callCtx{nil, stack, nil})
+	if actual := stack.pop(); !actual.IsZero() {
+		t.Fatalf("BASEFEE without base fee expected zero, got %v", actual)
+	}
+}
+
+func TestForkInstructionSets(t *testing.T) {
+	if berlinInstructionSet[PUSH0] != nil || berlinInstructionSet[BASEFEE] != nil {
+		t.Fatal("berlin instruction set should not contain PUSH0 or BASEFEE")
+	}
+	if londonInstructionSet[BASEFEE] == nil || londonInstructionSet[PUSH0] != nil {
+		t.Fatal("london instruction set should contain BASEFEE only")
+	}
+	if shanghaiInstructionSet[BASEFEE] == nil || shanghaiInstructionSet[PUSH0] == nil {
+		t.Fatal("shanghai instruction set should contain BASEFEE and PUSH0")
+	}
+	if PUSH0.IsPush() {
+		t.Fatal("PUSH0 takes no immediate data")
+	}
+	if PUSH0.String() != "PUSH0" || BASEFEE.String() != "BASEFEE" {
+		t.Fatal("unexpected opcode names")
+	}
+
+	// 默认配置下分叉高度为0，应使用最新的上海指令集
+	env := NewEVM(Context{}, &state.MemoryStateDB{}, Config{}, chain33Cfg)
+	if env.Interpreter.cfg.JumpTable[PUSH0] == nil {
+		t.Fatal("interpreter should use shanghai instruction set")
+	}
+}
+
+func TestGasCreateEip3860(t *testing.T) {
+	stack := newstack()
+	stack.push(new(uint256.Int).SetUint64(uint64(params.MaxInitCodeSize))) // size
+	stack.push(new(uint256.Int))                                           // offset
+	stack.push(new(uint256.Int))                                           // value
+	gas, err := gasCreateEip3860(nil, nil, stack, NewMemory(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := params.InitCodeWordGas * toWordSize(uint64(params.MaxInitCodeSize)); gas != expected {
+		t.Fatalf("expected gas %v, got %v", expected, gas)
+	}
+
+	stack = newstack()
+	stack.push(new(uint256.Int).SetUint64(uint64(params.MaxInitCodeSize + 1)))
+	stack.push(new(uint256.Int))
+	stack.push(new(uint256.Int))
+	if _, err = gasCreateEip3860(nil, nil, stack, NewMemory(), 0); err != ErrGasUintOverflow {
+		t.Fatalf("expected %v, got %v", ErrGasUintOverflow, err)
+	}
+}
+
+func TestStructLoggerLimit(t *testing.T) {
+	var (
+		env    = NewEVM(Context{}, &state.MemoryStateDB{}, Config{}, chain33Cfg)
+		stack  = newstack()
+		mem    = NewMemory()
+		logger = NewStructLogger(&LogConfig{Limit: 2, DisableMemory: true})
+	)
+	stack.push(uint256.NewInt(1))
+	for pc := uint64(0); pc < 3; pc++ {
+		logger.CaptureState(env, pc, PUSH1, 100, 3, mem, stack, nil, nil, 1, nil)
+	}
+	logs := logger.StructLogs()
+	if len(logs) != 2 {
+		t.Fatalf("expected 2 struct logs, got %d", len(logs))
+	}
+	if logs[1].Pc != 1 || logs[1].Op != PUSH1 || len(logs[1].Stack) != 1 || logs[1].Memory != nil {
+		t.Fatalf("unexpected struct log %+v", logs[1])
+	}
+	logger.CaptureEnd([]byte{0x01}, 21000, 0, nil)
+	if logger.GasUsed() != 21000 || !bytes.Equal(logger.Output(), []byte{0x01}) {
+		t.Fatalf("unexpected result output %x gas %d", logger.Output(), logger.GasUsed())
+	}
+}
+
+func TestCallTracer(t *testing.T) {
+	var (
+		tracer = NewCallTracer()
+		from   = common.BytesToAddress([]byte{0x01})
+		to     = common.BytesToAddress([]byte{0x02})
+		inner  = common.BytesToAddress([]byte{0x03})
+	)
+	tracer.CaptureStart(from, to, false, []byte{0xaa}, 1000, 0)
+	tracer.CaptureEnter(STATICCALL, to, inner, []byte{0xbb}, 500, 0)
+	tracer.CaptureEnter(CREATE, inner, from, nil, 200, 1)
+	tracer.CaptureExit(nil, 150, ErrOutOfGas)
+	tracer.CaptureExit([]byte{0xcc}, 300, nil)
+	tracer.CaptureEnd([]byte{0xdd}, 800, 0, nil)
+
+	root := tracer.Result()
+	if root == nil || root.Type != "CALL" || root.GasUsed != 800 || len(root.Calls) != 1 {
+		t.Fatalf("unexpected root frame %+v", root)
+	}
+	call := root.Calls[0]
+	if call.Type != "STATICCALL" || call.To != inner || call.GasUsed != 300 || len(call.Calls) != 1 {
+		t.Fatalf("unexpected inner frame %+v", call)
+	}
+	if create := call.Calls[0]; create.Type != "CREATE" || create.Err != ErrOutOfGas || create.Value != 1 {
+		t.Fatalf("unexpected create frame %+v", create)
+	}
+}
@@ -51,9 +51,13 @@ func NewInterpreter(evm *EVM, cfg Config) *Interpreter {
 	// 使用是否包含第一个STOP指令判断jump table是否完成初始化
 	// 需要注意，后继如果新增指令，需要在这里判断硬分叉，指定不同的指令集
 	if cfg.JumpTable[STOP] == nil {
-		cfg.JumpTable = berlinInstructionSet
-		if evm.cfg.IsDappFork(evm.StateDB.GetBlockHeight(), "evm", evmtypes.ForkEVMYoloV1) {
-			//这里需要替换为最新得指令集
+		height := evm.StateDB.GetBlockHeight()
+		switch {
+		case evm.cfg.IsDappFork(height, "evm", evmtypes.ForkEVMShanghai):
+			cfg.JumpTable = shanghaiInstructionSet
+		case evm.cfg.IsDappFork(height, "evm", evmtypes.ForkEVMLondon):
+			cfg.JumpTable = londonInstructionSet
+		default:
 			cfg.JumpTable = berlinInstructionSet
 		}
 	}
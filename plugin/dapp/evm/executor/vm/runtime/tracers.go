@@ -0,0 +1,212 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package runtime
+
+import (
+	"time"
+
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+)
+
+// StructLogger 在内存中收集合约执行过程中每条指令的状态信息，用于交易回放跟踪
+type StructLogger struct {
+	cfg LogConfig
+
+	logs    []StructLog
+	output  []byte
+	gasUsed uint64
+	err     error
+}
+
+// NewStructLogger 创建指令级跟踪器
+func NewStructLogger(cfg *LogConfig) *StructLogger {
+	logger := &StructLogger{}
+	if cfg != nil {
+		logger.cfg = *cfg
+	}
+	return logger
+}
+
+// CaptureStart 开始记录
+func (l *StructLogger) CaptureStart(from common.Address, to common.Address, create bool, input []byte, gas uint64, value uint64) error {
+	return nil
+}
+
+// CaptureState 记录当前指令执行前的状态，超过Limit条数后不再记录
+func (l *StructLogger) CaptureState(env *EVM, pc uint64, op OpCode, gas, cost uint64, memory *Memory, stack *Stack, rData []byte, contract *Contract, depth int, err error) error {
+	if l.cfg.Limit != 0 && len(l.logs) >= l.cfg.Limit {
+		return nil
+	}
+	log := StructLog{
+		Pc:            pc,
+		Op:            op,
+		Gas:           gas,
+		GasCost:       cost,
+		MemorySize:    memory.Len(),
+		Depth:         depth,
+		RefundCounter: env.StateDB.GetRefund(),
+		Err:           err,
+	}
+	if !l.cfg.DisableMemory {
+		log.Memory = formatMemory(memory.Data())
+	}
+	if !l.cfg.DisableStack {
+		log.Stack = formatStack(stack.Data())
+	}
+	if !l.cfg.DisableReturnData {
+		log.ReturnData = common.CopyBytes(rData)
+	}
+	l.logs = append(l.logs, log)
+	return nil
+}
+
+// CaptureFault 目前实现为空，出错的指令已经在CaptureState中记录
+func (l *StructLogger) CaptureFault(env *EVM, pc uint64, op OpCode, gas, cost uint64, memory *Memory, stack *Stack, contract *Contract, depth int, err error) error {
+	return nil
+}
+
+// CaptureEnd 记录最外层调用的执行结果
+func (l *StructLogger) CaptureEnd(output []byte, gasUsed uint64, t time.Duration, err error) error {
+	l.output = common.CopyBytes(output)
+	l.gasUsed = gasUsed
+	l.err = err
+	return nil
+}
+
+// CaptureEnter 目前实现为空
+func (l *StructLogger) CaptureEnter(typ OpCode, from common.Address, to common.Address, input []byte, gas uint64, value uint64) {
+}
+
+// CaptureExit 目前实现为空
+func (l *StructLogger) CaptureExit(output []byte, gasUsed uint64, err error) {
+}
+
+// StructLogs 返回记录的指令状态
+func (l *StructLogger) StructLogs() []StructLog {
+	return l.logs
+}
+
+// Output 返回最外层调用的返回数据
+func (l *StructLogger) Output() []byte {
+	return l.output
+}
+
+// GasUsed 返回最外层调用消耗的Gas
+func (l *StructLogger) GasUsed() uint64 {
+	return l.gasUsed
+}
+
+// Error 返回最外层调用的错误信息
+func (l *StructLogger) Error() error {
+	return l.err
+}
+
+// CallFrame 合约调用树中的一次调用
+type CallFrame struct {
+	// Type 调用类型，CALL、CREATE等
+	Type string
+	// From 调用者地址
+	From common.Address
+	// To 被调用的合约地址
+	To common.Address
+	// Input 调用参数或合约部署代码
+	Input []byte
+	// Output 调用返回数据
+	Output []byte
+	// Gas 调用时提供的Gas
+	Gas uint64
+	// GasUsed 调用实际消耗的Gas
+	GasUsed uint64
+	// Value 调用时的转账金额
+	Value uint64
+	// Err 调用的错误信息
+	Err error
+	// Calls 本次调用中发起的内部调用
+	Calls []*CallFrame
+}
+
+// CallTracer 记录合约执行过程中的调用树，不记录指令级信息
+type CallTracer struct {
+	callstack []*CallFrame
+}
+
+// NewCallTracer 创建调用树跟踪器
+func NewCallTracer() *CallTracer {
+	return &CallTracer{}
+}
+
+// CaptureStart 记录最外层调用
+func (t *CallTracer) CaptureStart(from common.Address, to common.Address, create bool, input []byte, gas uint64, value uint64) error {
+	typ := CALL
+	if create {
+		typ = CREATE
+	}
+	t.callstack = []*CallFrame{{
+		Type:  typ.String(),
+		From:  from,
+		To:    to,
+		Input: common.CopyBytes(input),
+		Gas:   gas,
+		Value: value,
+	}}
+	return nil
+}
+
+// CaptureState 目前实现为空
+func (t *CallTracer) CaptureState(env *EVM, pc uint64, op OpCode, gas, cost uint64, memory *Memory, stack *Stack, rData []byte, contract *Contract, depth int, err error) error {
+	return nil
+}
+
+// CaptureFault 目前实现为空
+func (t *CallTracer) CaptureFault(env *EVM, pc uint64, op OpCode, gas, cost uint64, memory *Memory, stack *Stack, contract *Contract, depth int, err error) error {
+	return nil
+}
+
+// CaptureEnd 记录最外层调用的执行结果
+func (t *CallTracer) CaptureEnd(output []byte, gasUsed uint64, tm time.Duration, err error) error {
+	if len(t.callstack) == 0 {
+		return nil
+	}
+	root := t.callstack[0]
+	root.Output = common.CopyBytes(output)
+	root.GasUsed = gasUsed
+	root.Err = err
+	return nil
+}
+
+// CaptureEnter 记录一次内部调用的开始
+func (t *CallTracer) CaptureEnter(typ OpCode, from common.Address, to common.Address, input []byte, gas uint64, value uint64) {
+	t.callstack = append(t.callstack, &CallFrame{
+		Type:  typ.String(),
+		From:  from,
+		To:    to,
+		Input: common.CopyBytes(input),
+		Gas:   gas,
+		Value: value,
+	})
+}
+
+// CaptureExit 记录一次内部调用的结果，并挂到上层调用中
+func (t *CallTracer) CaptureExit(output []byte, gasUsed uint64, err error) {
+	size := len(t.callstack)
+	if size <= 1 {
+		return
+	}
+	call := t.callstack[size-1]
+	t.callstack = t.callstack[:size-1]
+	call.Output = common.CopyBytes(output)
+	call.GasUsed = gasUsed
+	call.Err = err
+	parent := t.callstack[size-2]
+	parent.Calls = append(parent.Calls, call)
+}
+
+// Result 返回调用树的根节点，没有记录到调用时返回nil
+func (t *CallTracer) Result() *CallFrame {
+	if len(t.callstack) == 0 {
+		return nil
+	}
+	return t.callstack[0]
+}
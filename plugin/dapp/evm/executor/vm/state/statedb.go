@@ -584,9 +584,18 @@ func (mdb *MemoryStateDB) AddLog(log *model.ContractLog) {
 		Log: types.Encode(newEvmLog),
 	}
 
+	receiptLogs := []*types.ReceiptLog{receiptLog}
+	cfg := mdb.api.GetConfig()
+	if cfg.IsDappFork(mdb.blockHeight, "evm", evmtypes.ForkEVMEventSource) {
+		// 内部调用的合约也会生成event，需要单独记录来源合约地址
+		receiptLogs = append(receiptLogs, &types.ReceiptLog{
+			Ty:  evmtypes.TyLogEVMEventSource,
+			Log: types.Encode(&evmtypes.EVMEventSource{ContractAddr: log.Address.String()}),
+		})
+	}
 	mdb.addChange(addLogChange{
 		txhash: mdb.txHash,
-		logs:   []*types.ReceiptLog{receiptLog}})
+		logs:   receiptLogs})
 
 	log.TxHash = mdb.txHash
 	log.Index = int(mdb.logSize)
@@ -42,6 +42,12 @@ func InitFork(cfg *types.Chain33Config) {
 	cfg.RegisterDappFork(ExecutorName, ForkEVMYoloV1, 0)
 	// EVM合约支持交易组
 	cfg.RegisterDappFork(ExecutorName, ForkEVMTxGroup, 0)
+	// EVM伦敦分叉指令集
+	cfg.RegisterDappFork(ExecutorName, ForkEVMLondon, 0)
+	// EVM上海分叉指令集
+	cfg.RegisterDappFork(ExecutorName, ForkEVMShanghai, 0)
+	// EVM合约event日志记录来源合约地址
+	cfg.RegisterDappFork(ExecutorName, ForkEVMEventSource, 0)
 }
 
 //InitExecutor ...
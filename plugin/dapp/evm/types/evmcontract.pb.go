@@ -1967,6 +1967,953 @@ func (x *EvmGetUnpackDataRespose) GetUnpackData() []string {
 	return nil
 }
 
+type EvmTraceTxReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 需要回放的交易哈希
+	TxHash string `protobuf:"bytes,1,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	// 跟踪器类型: structLogger(默认，指令级跟踪) 或 callTracer(调用树跟踪)
+	Tracer        string `protobuf:"bytes,2,opt,name=tracer,proto3" json:"tracer,omitempty"`
+	DisableStack  bool   `protobuf:"varint,3,opt,name=disableStack,proto3" json:"disableStack,omitempty"`
+	DisableMemory bool   `protobuf:"varint,4,opt,name=disableMemory,proto3" json:"disableMemory,omitempty"`
+	// 最多返回的指令条数，0表示不限制
+	Limit int32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *EvmTraceTxReq) Reset() {
+	*x = EvmTraceTxReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evmcontract_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvmTraceTxReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvmTraceTxReq) ProtoMessage() {}
+
+func (x *EvmTraceTxReq) ProtoReflect() protoreflect.Message {
+	mi := &file_evmcontract_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvmTraceTxReq.ProtoReflect.Descriptor instead.
+func (*EvmTraceTxReq) Descriptor() ([]byte, []int) {
+	return file_evmcontract_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *EvmTraceTxReq) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *EvmTraceTxReq) GetTracer() string {
+	if x != nil {
+		return x.Tracer
+	}
+	return ""
+}
+
+func (x *EvmTraceTxReq) GetDisableStack() bool {
+	if x != nil {
+		return x.DisableStack
+	}
+	return false
+}
+
+func (x *EvmTraceTxReq) GetDisableMemory() bool {
+	if x != nil {
+		return x.DisableMemory
+	}
+	return false
+}
+
+func (x *EvmTraceTxReq) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type EvmStructLog struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pc      uint64   `protobuf:"varint,1,opt,name=pc,proto3" json:"pc,omitempty"`
+	Op      string   `protobuf:"bytes,2,opt,name=op,proto3" json:"op,omitempty"`
+	Gas     uint64   `protobuf:"varint,3,opt,name=gas,proto3" json:"gas,omitempty"`
+	GasCost uint64   `protobuf:"varint,4,opt,name=gasCost,proto3" json:"gasCost,omitempty"`
+	Depth   int32    `protobuf:"varint,5,opt,name=depth,proto3" json:"depth,omitempty"`
+	Stack   []string `protobuf:"bytes,6,rep,name=stack,proto3" json:"stack,omitempty"`
+	Memory  []string `protobuf:"bytes,7,rep,name=memory,proto3" json:"memory,omitempty"`
+	Refund  uint64   `protobuf:"varint,8,opt,name=refund,proto3" json:"refund,omitempty"`
+	Error   string   `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *EvmStructLog) Reset() {
+	*x = EvmStructLog{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evmcontract_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvmStructLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvmStructLog) ProtoMessage() {}
+
+func (x *EvmStructLog) ProtoReflect() protoreflect.Message {
+	mi := &file_evmcontract_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvmStructLog.ProtoReflect.Descriptor instead.
+func (*EvmStructLog) Descriptor() ([]byte, []int) {
+	return file_evmcontract_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *EvmStructLog) GetPc() uint64 {
+	if x != nil {
+		return x.Pc
+	}
+	return 0
+}
+
+func (x *EvmStructLog) GetOp() string {
+	if x != nil {
+		return x.Op
+	}
+	return ""
+}
+
+func (x *EvmStructLog) GetGas() uint64 {
+	if x != nil {
+		return x.Gas
+	}
+	return 0
+}
+
+func (x *EvmStructLog) GetGasCost() uint64 {
+	if x != nil {
+		return x.GasCost
+	}
+	return 0
+}
+
+func (x *EvmStructLog) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *EvmStructLog) GetStack() []string {
+	if x != nil {
+		return x.Stack
+	}
+	return nil
+}
+
+func (x *EvmStructLog) GetMemory() []string {
+	if x != nil {
+		return x.Memory
+	}
+	return nil
+}
+
+func (x *EvmStructLog) GetRefund() uint64 {
+	if x != nil {
+		return x.Refund
+	}
+	return 0
+}
+
+func (x *EvmStructLog) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type EvmCallFrame struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type    string          `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	From    string          `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To      string          `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Value   uint64          `protobuf:"varint,4,opt,name=value,proto3" json:"value,omitempty"`
+	Gas     uint64          `protobuf:"varint,5,opt,name=gas,proto3" json:"gas,omitempty"`
+	GasUsed uint64          `protobuf:"varint,6,opt,name=gasUsed,proto3" json:"gasUsed,omitempty"`
+	Input   string          `protobuf:"bytes,7,opt,name=input,proto3" json:"input,omitempty"`
+	Output  string          `protobuf:"bytes,8,opt,name=output,proto3" json:"output,omitempty"`
+	Error   string          `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	Calls   []*EvmCallFrame `protobuf:"bytes,10,rep,name=calls,proto3" json:"calls,omitempty"`
+}
+
+func (x *EvmCallFrame) Reset() {
+	*x = EvmCallFrame{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evmcontract_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvmCallFrame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvmCallFrame) ProtoMessage() {}
+
+func (x *EvmCallFrame) ProtoReflect() protoreflect.Message {
+	mi := &file_evmcontract_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvmCallFrame.ProtoReflect.Descriptor instead.
+func (*EvmCallFrame) Descriptor() ([]byte, []int) {
+	return file_evmcontract_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *EvmCallFrame) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *EvmCallFrame) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *EvmCallFrame) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *EvmCallFrame) GetValue() uint64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *EvmCallFrame) GetGas() uint64 {
+	if x != nil {
+		return x.Gas
+	}
+	return 0
+}
+
+func (x *EvmCallFrame) GetGasUsed() uint64 {
+	if x != nil {
+		return x.GasUsed
+	}
+	return 0
+}
+
+func (x *EvmCallFrame) GetInput() string {
+	if x != nil {
+		return x.Input
+	}
+	return ""
+}
+
+func (x *EvmCallFrame) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+func (x *EvmCallFrame) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *EvmCallFrame) GetCalls() []*EvmCallFrame {
+	if x != nil {
+		return x.Calls
+	}
+	return nil
+}
+
+type EvmTraceTxResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxHash      string          `protobuf:"bytes,1,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	Height      int64           `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Index       int64           `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	Failed      bool            `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	GasUsed     uint64          `protobuf:"varint,5,opt,name=gasUsed,proto3" json:"gasUsed,omitempty"`
+	ReturnValue string          `protobuf:"bytes,6,opt,name=returnValue,proto3" json:"returnValue,omitempty"`
+	Error       string          `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	StructLogs  []*EvmStructLog `protobuf:"bytes,8,rep,name=structLogs,proto3" json:"structLogs,omitempty"`
+	CallTrace   *EvmCallFrame   `protobuf:"bytes,9,opt,name=callTrace,proto3" json:"callTrace,omitempty"`
+}
+
+func (x *EvmTraceTxResp) Reset() {
+	*x = EvmTraceTxResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evmcontract_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvmTraceTxResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvmTraceTxResp) ProtoMessage() {}
+
+func (x *EvmTraceTxResp) ProtoReflect() protoreflect.Message {
+	mi := &file_evmcontract_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvmTraceTxResp.ProtoReflect.Descriptor instead.
+func (*EvmTraceTxResp) Descriptor() ([]byte, []int) {
+	return file_evmcontract_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *EvmTraceTxResp) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *EvmTraceTxResp) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *EvmTraceTxResp) GetIndex() int64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *EvmTraceTxResp) GetFailed() bool {
+	if x != nil {
+		return x.Failed
+	}
+	return false
+}
+
+func (x *EvmTraceTxResp) GetGasUsed() uint64 {
+	if x != nil {
+		return x.GasUsed
+	}
+	return 0
+}
+
+func (x *EvmTraceTxResp) GetReturnValue() string {
+	if x != nil {
+		return x.ReturnValue
+	}
+	return ""
+}
+
+func (x *EvmTraceTxResp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *EvmTraceTxResp) GetStructLogs() []*EvmStructLog {
+	if x != nil {
+		return x.StructLogs
+	}
+	return nil
+}
+
+func (x *EvmTraceTxResp) GetCallTrace() *EvmCallFrame {
+	if x != nil {
+		return x.CallTrace
+	}
+	return nil
+}
+
+// 合约事件日志的来源合约，紧跟在对应的LogEVMEventData日志之后
+type EVMEventSource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContractAddr string `protobuf:"bytes,1,opt,name=contractAddr,proto3" json:"contractAddr,omitempty"`
+}
+
+func (x *EVMEventSource) Reset() {
+	*x = EVMEventSource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evmcontract_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EVMEventSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EVMEventSource) ProtoMessage() {}
+
+func (x *EVMEventSource) ProtoReflect() protoreflect.Message {
+	mi := &file_evmcontract_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EVMEventSource.ProtoReflect.Descriptor instead.
+func (*EVMEventSource) Descriptor() ([]byte, []int) {
+	return file_evmcontract_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *EVMEventSource) GetContractAddr() string {
+	if x != nil {
+		return x.ContractAddr
+	}
+	return ""
+}
+
+// 合约事件日志索引中保存的日志信息
+type EvmLogItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContractAddr string   `protobuf:"bytes,1,opt,name=contractAddr,proto3" json:"contractAddr,omitempty"`
+	Topics       []string `protobuf:"bytes,2,rep,name=topics,proto3" json:"topics,omitempty"`
+	Data         string   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Height       int64    `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	TxIndex      int32    `protobuf:"varint,5,opt,name=txIndex,proto3" json:"txIndex,omitempty"`
+	// 日志在交易中的序号
+	LogIndex int32  `protobuf:"varint,6,opt,name=logIndex,proto3" json:"logIndex,omitempty"`
+	TxHash   string `protobuf:"bytes,7,opt,name=txHash,proto3" json:"txHash,omitempty"`
+}
+
+func (x *EvmLogItem) Reset() {
+	*x = EvmLogItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evmcontract_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvmLogItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvmLogItem) ProtoMessage() {}
+
+func (x *EvmLogItem) ProtoReflect() protoreflect.Message {
+	mi := &file_evmcontract_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvmLogItem.ProtoReflect.Descriptor instead.
+func (*EvmLogItem) Descriptor() ([]byte, []int) {
+	return file_evmcontract_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *EvmLogItem) GetContractAddr() string {
+	if x != nil {
+		return x.ContractAddr
+	}
+	return ""
+}
+
+func (x *EvmLogItem) GetTopics() []string {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+func (x *EvmLogItem) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *EvmLogItem) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *EvmLogItem) GetTxIndex() int32 {
+	if x != nil {
+		return x.TxIndex
+	}
+	return 0
+}
+
+func (x *EvmLogItem) GetLogIndex() int32 {
+	if x != nil {
+		return x.LogIndex
+	}
+	return 0
+}
+
+func (x *EvmLogItem) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+// 某个位置上的主题，满足其中任意一个即可
+type EvmLogTopics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Topics []string `protobuf:"bytes,1,rep,name=topics,proto3" json:"topics,omitempty"`
+}
+
+func (x *EvmLogTopics) Reset() {
+	*x = EvmLogTopics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evmcontract_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvmLogTopics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvmLogTopics) ProtoMessage() {}
+
+func (x *EvmLogTopics) ProtoReflect() protoreflect.Message {
+	mi := &file_evmcontract_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvmLogTopics.ProtoReflect.Descriptor instead.
+func (*EvmLogTopics) Descriptor() ([]byte, []int) {
+	return file_evmcontract_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *EvmLogTopics) GetTopics() []string {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+type EvmGetLogsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 合约地址，满足其中任意一个即可，为空时不限制合约
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// 按位置匹配topic0..topic3，某个位置为空时不限制该位置
+	Topics     []*EvmLogTopics `protobuf:"bytes,2,rep,name=topics,proto3" json:"topics,omitempty"`
+	FromHeight int64           `protobuf:"varint,3,opt,name=fromHeight,proto3" json:"fromHeight,omitempty"`
+	// 为0时表示不限制结束高度
+	ToHeight int64 `protobuf:"varint,4,opt,name=toHeight,proto3" json:"toHeight,omitempty"`
+	Count    int32 `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	// 上一次查询返回的primaryKey，用于翻页
+	PrimaryKey string `protobuf:"bytes,6,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+}
+
+func (x *EvmGetLogsReq) Reset() {
+	*x = EvmGetLogsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evmcontract_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvmGetLogsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvmGetLogsReq) ProtoMessage() {}
+
+func (x *EvmGetLogsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_evmcontract_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvmGetLogsReq.ProtoReflect.Descriptor instead.
+func (*EvmGetLogsReq) Descriptor() ([]byte, []int) {
+	return file_evmcontract_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *EvmGetLogsReq) GetAddresses() []string {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+func (x *EvmGetLogsReq) GetTopics() []*EvmLogTopics {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+func (x *EvmGetLogsReq) GetFromHeight() int64 {
+	if x != nil {
+		return x.FromHeight
+	}
+	return 0
+}
+
+func (x *EvmGetLogsReq) GetToHeight() int64 {
+	if x != nil {
+		return x.ToHeight
+	}
+	return 0
+}
+
+func (x *EvmGetLogsReq) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *EvmGetLogsReq) GetPrimaryKey() string {
+	if x != nil {
+		return x.PrimaryKey
+	}
+	return ""
+}
+
+type EvmGetLogsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Logs []*EvmLogItem `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+	// 最后一条日志的primaryKey，为空表示没有更多数据
+	PrimaryKey string `protobuf:"bytes,2,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+}
+
+func (x *EvmGetLogsResp) Reset() {
+	*x = EvmGetLogsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evmcontract_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvmGetLogsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvmGetLogsResp) ProtoMessage() {}
+
+func (x *EvmGetLogsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_evmcontract_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvmGetLogsResp.ProtoReflect.Descriptor instead.
+func (*EvmGetLogsResp) Descriptor() ([]byte, []int) {
+	return file_evmcontract_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *EvmGetLogsResp) GetLogs() []*EvmLogItem {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+func (x *EvmGetLogsResp) GetPrimaryKey() string {
+	if x != nil {
+		return x.PrimaryKey
+	}
+	return ""
+}
+
+// 批量只读调用，所有调用基于同一个状态执行，互相之间不影响
+type EvmMultiCallReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Calls []*EvmQueryReq `protobuf:"bytes,1,rep,name=calls,proto3" json:"calls,omitempty"`
+}
+
+func (x *EvmMultiCallReq) Reset() {
+	*x = EvmMultiCallReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evmcontract_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvmMultiCallReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvmMultiCallReq) ProtoMessage() {}
+
+func (x *EvmMultiCallReq) ProtoReflect() protoreflect.Message {
+	mi := &file_evmcontract_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvmMultiCallReq.ProtoReflect.Descriptor instead.
+func (*EvmMultiCallReq) Descriptor() ([]byte, []int) {
+	return file_evmcontract_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *EvmMultiCallReq) GetCalls() []*EvmQueryReq {
+	if x != nil {
+		return x.Calls
+	}
+	return nil
+}
+
+type EvmCallResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Success bool   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	// 调用返回数据，调用失败时为revert返回的数据
+	RawData string `protobuf:"bytes,3,opt,name=rawData,proto3" json:"rawData,omitempty"`
+	GasUsed uint64 `protobuf:"varint,4,opt,name=gasUsed,proto3" json:"gasUsed,omitempty"`
+	Error   string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *EvmCallResult) Reset() {
+	*x = EvmCallResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evmcontract_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvmCallResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvmCallResult) ProtoMessage() {}
+
+func (x *EvmCallResult) ProtoReflect() protoreflect.Message {
+	mi := &file_evmcontract_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvmCallResult.ProtoReflect.Descriptor instead.
+func (*EvmCallResult) Descriptor() ([]byte, []int) {
+	return file_evmcontract_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *EvmCallResult) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *EvmCallResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *EvmCallResult) GetRawData() string {
+	if x != nil {
+		return x.RawData
+	}
+	return ""
+}
+
+func (x *EvmCallResult) GetGasUsed() uint64 {
+	if x != nil {
+		return x.GasUsed
+	}
+	return 0
+}
+
+func (x *EvmCallResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type EvmMultiCallResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 执行调用时使用的区块高度
+	Height  int64            `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Results []*EvmCallResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *EvmMultiCallResp) Reset() {
+	*x = EvmMultiCallResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evmcontract_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvmMultiCallResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvmMultiCallResp) ProtoMessage() {}
+
+func (x *EvmMultiCallResp) ProtoReflect() protoreflect.Message {
+	mi := &file_evmcontract_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvmMultiCallResp.ProtoReflect.Descriptor instead.
+func (*EvmMultiCallResp) Descriptor() ([]byte, []int) {
+	return file_evmcontract_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *EvmMultiCallResp) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *EvmMultiCallResp) GetResults() []*EvmCallResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
 var File_evmcontract_proto protoreflect.FileDescriptor
 
 var file_evmcontract_proto_rawDesc = []byte{
@@ -2178,8 +3125,121 @@ var file_evmcontract_proto_rawDesc = []byte{
 	0x45, 0x76, 0x6d, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x70, 0x61, 0x63, 0x6b, 0x44, 0x61, 0x74, 0x61,
 	0x52, 0x65, 0x73, 0x70, 0x6f, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x6e, 0x70, 0x61, 0x63,
 	0x6b, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x6e, 0x70,
-	0x61, 0x63, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x42, 0x0a, 0x5a, 0x08, 0x2e, 0x2e, 0x2f, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x61, 0x63, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x22, 0x9f, 0x01, 0x0a, 0x0d, 0x45, 0x76, 0x6d, 0x54,
+	0x72, 0x61, 0x63, 0x65, 0x54, 0x78, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x78, 0x48,
+	0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73,
+	0x68, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x24, 0x0a,
+	0x0d, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xcc, 0x01, 0x0a, 0x0c, 0x45, 0x76,
+	0x6d, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4c, 0x6f, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x70, 0x63,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x70, 0x63, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x70,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x61,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x67, 0x61, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x67, 0x61, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67,
+	0x61, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x66, 0x75, 0x6e, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x72, 0x65, 0x66, 0x75,
+	0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xf7, 0x01, 0x0a, 0x0c, 0x45, 0x76, 0x6d,
+	0x43, 0x61, 0x6c, 0x6c, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74,
+	0x6f, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x61, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x67, 0x61, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x61, 0x73,
+	0x55, 0x73, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x61, 0x73, 0x55,
+	0x73, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x29, 0x0a, 0x05, 0x63, 0x61, 0x6c, 0x6c, 0x73,
+	0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45,
+	0x76, 0x6d, 0x43, 0x61, 0x6c, 0x6c, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x05, 0x63, 0x61, 0x6c,
+	0x6c, 0x73, 0x22, 0xa8, 0x02, 0x0a, 0x0e, 0x45, 0x76, 0x6d, 0x54, 0x72, 0x61, 0x63, 0x65, 0x54,
+	0x78, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x66,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x66, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12, 0x20, 0x0a,
+	0x0b, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x33, 0x0a, 0x0a, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4c,
+	0x6f, 0x67, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x45, 0x76, 0x6d, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x0a,
+	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x31, 0x0a, 0x09, 0x63, 0x61,
+	0x6c, 0x6c, 0x54, 0x72, 0x61, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x76, 0x6d, 0x43, 0x61, 0x6c, 0x6c, 0x46, 0x72, 0x61,
+	0x6d, 0x65, 0x52, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x54, 0x72, 0x61, 0x63, 0x65, 0x22, 0x34, 0x0a,
+	0x0e, 0x45, 0x56, 0x4d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
+	0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41,
+	0x64, 0x64, 0x72, 0x22, 0xc2, 0x01, 0x0a, 0x0a, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x49, 0x74,
+	0x65, 0x6d, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64,
+	0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x78,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x78, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x16, 0x0a, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x22, 0x26, 0x0a, 0x0c, 0x45, 0x76, 0x6d, 0x4c,
+	0x6f, 0x67, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69,
+	0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73,
+	0x22, 0xcc, 0x01, 0x0a, 0x0d, 0x45, 0x76, 0x6d, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x52,
+	0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
+	0x12, 0x2b, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x54,
+	0x6f, 0x70, 0x69, 0x63, 0x73, 0x52, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x74, 0x6f, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x74, 0x6f, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x22,
+	0x57, 0x0a, 0x0e, 0x45, 0x76, 0x6d, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x25, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x49, 0x74,
+	0x65, 0x6d, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x6d,
+	0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72,
+	0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x3b, 0x0a, 0x0f, 0x45, 0x76, 0x6d, 0x4d,
+	0x75, 0x6c, 0x74, 0x69, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x28, 0x0a, 0x05, 0x63,
+	0x61, 0x6c, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x45, 0x76, 0x6d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x52, 0x05,
+	0x63, 0x61, 0x6c, 0x6c, 0x73, 0x22, 0x8d, 0x01, 0x0a, 0x0d, 0x45, 0x76, 0x6d, 0x43, 0x61, 0x6c,
+	0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72,
+	0x61, 0x77, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x61,
+	0x77, 0x44, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x5a, 0x0a, 0x10, 0x45, 0x76, 0x6d, 0x4d, 0x75, 0x6c, 0x74,
+	0x69, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x2e, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x76, 0x6d, 0x43, 0x61,
+	0x6c, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x42, 0x0a, 0x5a, 0x08, 0x2e, 0x2e, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2194,7 +3254,7 @@ func file_evmcontract_proto_rawDescGZIP() []byte {
 	return file_evmcontract_proto_rawDescData
 }
 
-var file_evmcontract_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
+var file_evmcontract_proto_msgTypes = make([]protoimpl.MessageInfo, 43)
 var file_evmcontract_proto_goTypes = []interface{}{
 	(*EVMContractObject)(nil),         // 0: types.EVMContractObject
 	(*EVMContractData)(nil),           // 1: types.EVMContractData
@@ -2225,19 +3285,38 @@ var file_evmcontract_proto_goTypes = []interface{}{
 	(*EvmGetPackDataRespose)(nil),     // 26: types.EvmGetPackDataRespose
 	(*EvmGetUnpackDataReq)(nil),       // 27: types.EvmGetUnpackDataReq
 	(*EvmGetUnpackDataRespose)(nil),   // 28: types.EvmGetUnpackDataRespose
-	nil,                               // 29: types.EVMContractState.StorageEntry
-	nil,                               // 30: types.EVMContractStateCmd.StorageEntry
+	(*EvmTraceTxReq)(nil),             // 29: types.EvmTraceTxReq
+	(*EvmStructLog)(nil),              // 30: types.EvmStructLog
+	(*EvmCallFrame)(nil),              // 31: types.EvmCallFrame
+	(*EvmTraceTxResp)(nil),            // 32: types.EvmTraceTxResp
+	(*EVMEventSource)(nil),            // 33: types.EVMEventSource
+	(*EvmLogItem)(nil),                // 34: types.EvmLogItem
+	(*EvmLogTopics)(nil),              // 35: types.EvmLogTopics
+	(*EvmGetLogsReq)(nil),             // 36: types.EvmGetLogsReq
+	(*EvmGetLogsResp)(nil),            // 37: types.EvmGetLogsResp
+	(*EvmMultiCallReq)(nil),           // 38: types.EvmMultiCallReq
+	(*EvmCallResult)(nil),             // 39: types.EvmCallResult
+	(*EvmMultiCallResp)(nil),          // 40: types.EvmMultiCallResp
+	nil,                               // 41: types.EVMContractState.StorageEntry
+	nil,                               // 42: types.EVMContractStateCmd.StorageEntry
 }
 var file_evmcontract_proto_depIdxs = []int32{
 	1,  // 0: types.EVMContractObject.data:type_name -> types.EVMContractData
 	2,  // 1: types.EVMContractObject.state:type_name -> types.EVMContractState
-	29, // 2: types.EVMContractState.storage:type_name -> types.EVMContractState.StorageEntry
-	30, // 3: types.EVMContractStateCmd.storage:type_name -> types.EVMContractStateCmd.StorageEntry
-	4,  // [4:4] is the sub-list for method output_type
-	4,  // [4:4] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	41, // 2: types.EVMContractState.storage:type_name -> types.EVMContractState.StorageEntry
+	42, // 3: types.EVMContractStateCmd.storage:type_name -> types.EVMContractStateCmd.StorageEntry
+	31, // 4: types.EvmCallFrame.calls:type_name -> types.EvmCallFrame
+	30, // 5: types.EvmTraceTxResp.structLogs:type_name -> types.EvmStructLog
+	31, // 6: types.EvmTraceTxResp.callTrace:type_name -> types.EvmCallFrame
+	35, // 7: types.EvmGetLogsReq.topics:type_name -> types.EvmLogTopics
+	34, // 8: types.EvmGetLogsResp.logs:type_name -> types.EvmLogItem
+	17, // 9: types.EvmMultiCallReq.calls:type_name -> types.EvmQueryReq
+	39, // 10: types.EvmMultiCallResp.results:type_name -> types.EvmCallResult
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_evmcontract_proto_init() }
@@ -2594,6 +3673,150 @@ func file_evmcontract_proto_init() {
 				return nil
 			}
 		}
+		file_evmcontract_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvmTraceTxReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evmcontract_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvmStructLog); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evmcontract_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvmCallFrame); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evmcontract_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvmTraceTxResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evmcontract_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EVMEventSource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evmcontract_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvmLogItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evmcontract_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvmLogTopics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evmcontract_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvmGetLogsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evmcontract_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvmGetLogsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evmcontract_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvmMultiCallReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evmcontract_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvmCallResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evmcontract_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvmMultiCallResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2601,7 +3824,7 @@ func file_evmcontract_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_evmcontract_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   31,
+			NumMessages:   43,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -26,6 +26,8 @@ const (
 	TyLogEVMStateChangeItem = 604
 	// TyLogEVMEventData 合约生成新的event日志数据
 	TyLogEVMEventData = 605
+	// TyLogEVMEventSource 合约event日志的来源合约，紧跟在对应的event日志之后
+	TyLogEVMEventSource = 606
 
 	// MaxGasLimit  最大Gas消耗上限 5
 	MaxGasLimit = (100000000 * 5)
@@ -46,6 +48,12 @@ const (
 	ForkEVMYoloV1 = "ForkEVMYoloV1"
 	//ForkEVMTxGroup 交易组中的交易通过GAS检查
 	ForkEVMTxGroup = "ForkEVMTxGroup"
+	// ForkEVMLondon 伦敦虚拟机指令分叉，支持BASEFEE指令并降低Gas退款（EIP-3198/3529）
+	ForkEVMLondon = "ForkEVMLondon"
+	// ForkEVMShanghai 上海虚拟机指令分叉，支持PUSH0指令并限制初始化代码大小（EIP-3855/3860）
+	ForkEVMShanghai = "ForkEVMShanghai"
+	// ForkEVMEventSource EVM合约event日志记录来源合约地址
+	ForkEVMEventSource = "ForkEVMEventSource"
 )
 
 var (
@@ -65,5 +73,6 @@ var (
 		TyLogContractState:      {Ty: reflect.TypeOf(EVMContractState{}), Name: "LogContractState"},
 		TyLogEVMStateChangeItem: {Ty: reflect.TypeOf(EVMStateChangeItem{}), Name: "LogEVMStateChangeItem"},
 		TyLogEVMEventData:       {Ty: reflect.TypeOf(types.EVMLog{}), Name: "LogEVMEventData"},
+		TyLogEVMEventSource:     {Ty: reflect.TypeOf(EVMEventSource{}), Name: "LogEVMEventSource"},
 	}
 )
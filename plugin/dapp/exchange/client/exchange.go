@@ -4,7 +4,6 @@ import (
 	"github.com/33cn/chain33/types"
 	et "github.com/33cn/plugin/plugin/dapp/exchange/types"
 	"github.com/golang/protobuf/proto"
-	"github.com/pkg/errors"
 )
 
 var cfg = types.NewChain33Config(types.GetDefaultCfgstring())
@@ -73,6 +72,32 @@ func (c *ExchangeClient) QueryOrderList(msg proto.Message) (types.Message, error
 	return &resp, nil
 }
 
+func (c *ExchangeClient) QueryCandles(msg proto.Message) (types.Message, error) {
+	data, err := c.client.Query(et.FuncNameQueryCandles, msg)
+	if err != nil {
+		return nil, err
+	}
+	var resp et.CandleList
+	err = types.Decode(data, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *ExchangeClient) QueryTicker(msg proto.Message) (types.Message, error) {
+	data, err := c.client.Query(et.FuncNameQueryTicker, msg)
+	if err != nil {
+		return nil, err
+	}
+	var resp et.Ticker
+	err = types.Decode(data, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
 func (c *ExchangeClient) LimitOrder(msg proto.Message, hexKey string) (*et.ReceiptExchange, error) {
 	ety := types.LoadExecutorType(et.ExchangeX)
 	tx, err := ety.Create("LimitOrder", msg)
@@ -96,29 +121,50 @@ func (c *ExchangeClient) LimitOrder(msg proto.Message, hexKey string) (*et.Recei
 	return &resp, nil
 }
 
-//TODO marketOrder
 func (c *ExchangeClient) MarketOrder(msg proto.Message, hexKey string) (*et.ReceiptExchange, error) {
-	return nil, errors.New("Unopen")
-	//ety := types.LoadExecutorType(et.ExchangeX)
-	//tx, err := ety.Create("MarketOrder", msg)
-	//if err != nil {
-	//	return nil, err
-	//}
-	//logs, err := c.client.Send(tx, hexKey)
-	//if err != nil {
-	//	return nil, err
-	//}
-	//var resp et.ReceiptExchange
-	//for _, l := range logs {
-	//	if l.Ty == et.TyMarketOrderLog {
-	//		err = types.Decode(l.Log, &resp)
-	//		if err != nil {
-	//			return nil, err
-	//		}
-	//		break
-	//	}
-	//}
-	//return &resp, nil
+	ety := types.LoadExecutorType(et.ExchangeX)
+	tx, err := ety.Create("MarketOrder", msg)
+	if err != nil {
+		return nil, err
+	}
+	logs, err := c.client.Send(tx, hexKey)
+	if err != nil {
+		return nil, err
+	}
+	var resp et.ReceiptExchange
+	for _, l := range logs {
+		if l.Ty == et.TyMarketOrderLog {
+			err = types.Decode(l.Log, &resp)
+			if err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+	return &resp, nil
+}
+
+func (c *ExchangeClient) StopOrder(msg proto.Message, hexKey string) (*et.ReceiptExchange, error) {
+	ety := types.LoadExecutorType(et.ExchangeX)
+	tx, err := ety.Create("StopOrder", msg)
+	if err != nil {
+		return nil, err
+	}
+	logs, err := c.client.Send(tx, hexKey)
+	if err != nil {
+		return nil, err
+	}
+	var resp et.ReceiptExchange
+	for _, l := range logs {
+		if l.Ty == et.TyStopOrderLog {
+			err = types.Decode(l.Log, &resp)
+			if err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+	return &resp, nil
 }
 
 func (c *ExchangeClient) RevokeOrder(msg proto.Message, hexKey string) (*et.ReceiptExchange, error) {
@@ -0,0 +1,162 @@
+package executor
+
+import (
+	"fmt"
+
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/common/db/table"
+	"github.com/33cn/chain33/types"
+	et "github.com/33cn/plugin/plugin/dapp/exchange/types"
+)
+
+//CheckCandleInterval 只支持1分钟, 1小时, 1天的K线
+func CheckCandleInterval(interval int32) bool {
+	for _, v := range et.CandleIntervals {
+		if v == interval {
+			return true
+		}
+	}
+	return false
+}
+
+func calcCandlePrefix(left, right *et.Asset, interval int32) []byte {
+	return []byte(fmt.Sprintf("%s:%s:%d:", left.GetSymbol(), right.GetSymbol(), interval))
+}
+
+func calcCandleKey(left, right *et.Asset, interval int32, openTime int64) []byte {
+	return []byte(fmt.Sprintf("%s:%s:%d:%016d", left.GetSymbol(), right.GetSymbol(), interval, openTime))
+}
+
+// updateCandles 把回执中的每笔成交按区块时间统计到各个周期的K线, 区块回滚时由自动回滚的kv恢复
+func (e *exchange) updateCandles(candleTable *table.Table, receipt *et.ReceiptExchange) {
+	order := receipt.GetOrder().GetLimitOrder()
+	if order == nil {
+		return
+	}
+	coinPrecision := e.GetAPI().GetConfig().GetCoinPrecision()
+	blocktime := e.GetBlockTime()
+	candles := make(map[int32]*et.Candle)
+	for _, match := range receipt.GetMatchOrders() {
+		// 过期的挂单以及删除空深度的记录没有成交
+		if match.GetExecuted() <= 0 {
+			continue
+		}
+		price := match.GetLimitOrder().GetPrice()
+		for _, interval := range et.CandleIntervals {
+			candle, ok := candles[interval]
+			if !ok {
+				candle = getCandle(candleTable, order.GetLeftAsset(), order.GetRightAsset(), interval, blocktime)
+				candles[interval] = candle
+			}
+			if candle.Count == 0 {
+				candle.Open = price
+				candle.High = price
+				candle.Low = price
+			}
+			if price > candle.High {
+				candle.High = price
+			}
+			if price < candle.Low {
+				candle.Low = price
+			}
+			candle.Close = price
+			candle.Volume += match.GetExecuted()
+			candle.Turnover += SafeMul(match.GetExecuted(), price, coinPrecision)
+			candle.Count++
+		}
+	}
+	for _, interval := range et.CandleIntervals {
+		candle, ok := candles[interval]
+		if !ok {
+			continue
+		}
+		err := candleTable.Replace(candle)
+		if err != nil {
+			elog.Error("updateCandles", "candleTable.Replace", err.Error())
+		}
+	}
+}
+
+func getCandle(candleTable *table.Table, left, right *et.Asset, interval int32, blocktime int64) *et.Candle {
+	openTime := blocktime - blocktime%int64(interval)
+	row, err := candleTable.GetData(calcCandleKey(left, right, interval, openTime))
+	if err == nil {
+		if candle, ok := row.Data.(*et.Candle); ok {
+			return candle
+		}
+	}
+	return &et.Candle{
+		LeftAsset:  left,
+		RightAsset: right,
+		Interval:   interval,
+		OpenTime:   openTime,
+	}
+}
+
+//QueryCandles 默认从最新的K线开始返回
+func QueryCandles(localdb dbm.KV, left, right *et.Asset, interval int32, primaryKey string, count, direction int32) (*et.CandleList, error) {
+	candleTable := NewCandleTable(localdb)
+	prefix := calcCandlePrefix(left, right, interval)
+	if count == 0 {
+		count = et.Count
+	}
+	var rows []*table.Row
+	var err error
+	if primaryKey == "" {
+		rows, err = candleTable.ListIndex("openTime", prefix, nil, count, direction)
+	} else {
+		rows, err = candleTable.ListIndex("openTime", prefix, []byte(primaryKey), count, direction)
+	}
+	if err != nil {
+		elog.Error("QueryCandles.", "left", left, "right", right, "interval", interval, "err", err.Error())
+		return nil, err
+	}
+	var list et.CandleList
+	for _, row := range rows {
+		list.List = append(list.List, row.Data.(*et.Candle))
+	}
+	if len(rows) == int(count) {
+		list.PrimaryKey = string(rows[len(rows)-1].Primary)
+	}
+	return &list, nil
+}
+
+//QueryTicker 统计now所在小时以及之前23个小时的小时K线, 没有成交时开盘价, 最高价和最低价都是最新成交价
+func QueryTicker(localdb dbm.KV, left, right *et.Asset, now int64) (*et.Ticker, error) {
+	ticker := &et.Ticker{LeftAsset: left, RightAsset: right, Time: now}
+	candleTable := NewCandleTable(localdb)
+	rows, err := candleTable.ListIndex("openTime", calcCandlePrefix(left, right, et.CandleHour), nil, 24, et.ListDESC)
+	if err == types.ErrNotFound {
+		return ticker, nil
+	}
+	if err != nil {
+		elog.Error("QueryTicker.", "left", left, "right", right, "err", err.Error())
+		return nil, err
+	}
+	start := now - now%et.CandleHour - 23*et.CandleHour
+	for i, row := range rows {
+		candle := row.Data.(*et.Candle)
+		if i == 0 {
+			ticker.Last = candle.Close
+		}
+		if candle.OpenTime < start || candle.OpenTime > now {
+			continue
+		}
+		if ticker.Count == 0 || candle.High > ticker.High {
+			ticker.High = candle.High
+		}
+		if ticker.Count == 0 || candle.Low < ticker.Low {
+			ticker.Low = candle.Low
+		}
+		ticker.Open = candle.Open
+		ticker.Volume += candle.Volume
+		ticker.Turnover += candle.Turnover
+		ticker.Count += candle.Count
+	}
+	if ticker.Count == 0 {
+		ticker.Open = ticker.Last
+		ticker.High = ticker.Last
+		ticker.Low = ticker.Last
+	}
+	return ticker, nil
+}
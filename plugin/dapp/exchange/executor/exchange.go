@@ -78,9 +78,44 @@ func (e *exchange) CheckTx(tx *types.Transaction, index int) error {
 		if !CheckOp(op) {
 			return exchangetypes.ErrAssetOp
 		}
+		if !CheckTimeInForce(limitOrder) {
+			return exchangetypes.ErrTimeInForce
+		}
 	}
 	if exchange.Ty == exchangetypes.TyMarketOrderAction {
-		return types.ErrActionNotSupport
+		marketOrder := exchange.GetMarketOrder()
+		if !CheckExchangeAsset(cfg.GetCoinExec(), marketOrder.GetLeftAsset(), marketOrder.GetRightAsset()) {
+			return exchangetypes.ErrAsset
+		}
+		// 最差成交价格为0时不限制成交价格
+		if marketOrder.GetWorstPrice() != 0 && !CheckPrice(marketOrder.GetWorstPrice()) {
+			return exchangetypes.ErrAssetPrice
+		}
+		if !CheckAmount(marketOrder.GetAmount(), cfg.GetCoinPrecision()) {
+			return exchangetypes.ErrAssetAmount
+		}
+		if !CheckOp(marketOrder.GetOp()) {
+			return exchangetypes.ErrAssetOp
+		}
+	}
+	if exchange.Ty == exchangetypes.TyStopOrderAction {
+		stopOrder := exchange.GetStopOrder()
+		if !CheckExchangeAsset(cfg.GetCoinExec(), stopOrder.GetLeftAsset(), stopOrder.GetRightAsset()) {
+			return exchangetypes.ErrAsset
+		}
+		// 委托价格为0时触发后以市价成交
+		if !CheckPrice(stopOrder.GetTriggerPrice()) || (stopOrder.GetPrice() != 0 && !CheckPrice(stopOrder.GetPrice())) {
+			return exchangetypes.ErrAssetPrice
+		}
+		if !CheckAmount(stopOrder.GetAmount(), cfg.GetCoinPrecision()) {
+			return exchangetypes.ErrAssetAmount
+		}
+		if !CheckOp(stopOrder.GetOp()) {
+			return exchangetypes.ErrAssetOp
+		}
+		if !CheckStopKind(stopOrder.GetKind()) {
+			return exchangetypes.ErrStopKind
+		}
 	}
 	return nil
 }
@@ -674,6 +674,454 @@ func TestExchange(t *testing.T) {
 
 }
 
+func TestMarketOrder(t *testing.T) {
+	cfg := types.NewChain33Config(et.GetDefaultCfgstring())
+	cfg.SetTitleOnlyForTest("chain33")
+	InitExecType()
+	total := 100 * types.DefaultCoinPrecision
+	_, stateDB, kvdb := util.CreateTestDB()
+	execAddr := address.ExecAddress(et.ExchangeX)
+
+	accBty, _ := account.NewAccountDB(cfg, "coins", "bty", stateDB)
+	accCCNY, _ := account.NewAccountDB(cfg, "token", "CCNY", stateDB)
+	for _, addr := range Nodes[:2] {
+		accBty.SaveExecAccount(execAddr, &types.Account{Balance: total, Addr: addr})
+		accCCNY.SaveExecAccount(execAddr, &types.Account{Balance: total, Addr: addr})
+	}
+	accCCNY.SaveExecAccount(execAddr, &types.Account{Balance: 3 * types.DefaultCoinPrecision, Addr: Nodes[2]})
+	env := &execEnv{10, 1, 1539918074}
+	left := &et.Asset{Symbol: "bty", Execer: "coins"}
+	right := &et.Asset{Execer: "token", Symbol: "CCNY"}
+
+	/*
+	  用例说明：
+	   1.A挂价格为1和2的卖单各5个
+	   2.B市价买入8个，从低到高依次成交，不冻结资金
+	   3.B以最差价格1.5市价买入，没有可以成交的挂单，订单直接撤销
+	   4.C余额不足以支付下一笔成交时停止撮合
+	   5.没有对手盘的市价卖单直接撤销，不冻结资金
+	*/
+	err := Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: types.DefaultCoinPrecision, Amount: 5 * types.DefaultCoinPrecision, Op: et.OpSell}, PrivKeyA, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: 2 * types.DefaultCoinPrecision, Amount: 5 * types.DefaultCoinPrecision, Op: et.OpSell}, PrivKeyA, stateDB, kvdb, env)
+	assert.Nil(t, err)
+
+	err = Exec_MarketOrder(t, &et.MarketOrder{LeftAsset: left, RightAsset: right, Amount: 8 * types.DefaultCoinPrecision, Op: et.OpBuy}, PrivKeyB, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	orderList, err := Exec_QueryOrderList(et.Completed, Nodes[1], "", stateDB, kvdb)
+	assert.Nil(t, err)
+	order, err := Exec_QueryOrder(orderList.List[0].OrderID, stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(et.TyMarketOrderAction), order.Ty)
+	assert.Equal(t, 8*types.DefaultCoinPrecision, order.Executed)
+	// (5*1+3*2)/8
+	assert.Equal(t, int64(137500000), order.AVGPrice)
+	acc := accCCNY.LoadExecAccount(Nodes[1], execAddr)
+	assert.Equal(t, total-11*types.DefaultCoinPrecision, acc.Balance)
+	assert.Equal(t, int64(0), acc.Frozen)
+	// 扣除1%的吃单手续费
+	acc = accBty.LoadExecAccount(Nodes[1], execAddr)
+	assert.Equal(t, total+8*types.DefaultCoinPrecision-8*types.DefaultCoinPrecision/100, acc.Balance)
+	marketDepthList, err := Exec_QueryMarketDepth(&et.QueryMarketDepth{LeftAsset: left, RightAsset: right, Op: et.OpSell}, stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(marketDepthList.List))
+	assert.Equal(t, 2*types.DefaultCoinPrecision, marketDepthList.List[0].Price)
+	assert.Equal(t, 2*types.DefaultCoinPrecision, marketDepthList.List[0].Amount)
+
+	err = Exec_MarketOrder(t, &et.MarketOrder{LeftAsset: left, RightAsset: right, Amount: 2 * types.DefaultCoinPrecision, Op: et.OpBuy, WorstPrice: 150000000}, PrivKeyB, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	orderList, err = Exec_QueryOrderList(et.Revoked, Nodes[1], "", stateDB, kvdb)
+	assert.Nil(t, err)
+	order, err = Exec_QueryOrder(orderList.List[0].OrderID, stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(et.Revoked), order.Status)
+	assert.Equal(t, int64(0), order.Executed)
+	assert.Equal(t, 2*types.DefaultCoinPrecision, order.Balance)
+	marketDepthList, err = Exec_QueryMarketDepth(&et.QueryMarketDepth{LeftAsset: left, RightAsset: right, Op: et.OpSell}, stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, 2*types.DefaultCoinPrecision, marketDepthList.List[0].Amount)
+
+	err = Exec_MarketOrder(t, &et.MarketOrder{LeftAsset: left, RightAsset: right, Amount: 2 * types.DefaultCoinPrecision, Op: et.OpBuy}, PrivKeyC, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	orderList, err = Exec_QueryOrderList(et.Revoked, Nodes[2], "", stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), orderList.List[0].Executed)
+	acc = accCCNY.LoadExecAccount(Nodes[2], execAddr)
+	assert.Equal(t, 3*types.DefaultCoinPrecision, acc.Balance)
+
+	// 部分成交后撤销剩余部分
+	err = Exec_MarketOrder(t, &et.MarketOrder{LeftAsset: left, RightAsset: right, Amount: 5 * types.DefaultCoinPrecision, Op: et.OpBuy}, PrivKeyB, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	orderList, err = Exec_QueryOrderList(et.Revoked, Nodes[1], "", stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, 2*types.DefaultCoinPrecision, orderList.List[0].Executed)
+	assert.Equal(t, 3*types.DefaultCoinPrecision, orderList.List[0].Balance)
+	_, err = Exec_QueryMarketDepth(&et.QueryMarketDepth{LeftAsset: left, RightAsset: right, Op: et.OpSell}, stateDB, kvdb)
+	assert.Equal(t, types.ErrNotFound, err)
+	orderList, err = Exec_QueryOrderList(et.Completed, Nodes[0], "", stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(orderList.List))
+
+	err = Exec_MarketOrder(t, &et.MarketOrder{LeftAsset: left, RightAsset: right, Amount: 3 * types.DefaultCoinPrecision, Op: et.OpSell}, PrivKeyA, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	acc = accBty.LoadExecAccount(Nodes[0], execAddr)
+	assert.Equal(t, int64(0), acc.Frozen)
+	_, err = Exec_QueryMarketDepth(&et.QueryMarketDepth{LeftAsset: left, RightAsset: right, Op: et.OpSell}, stateDB, kvdb)
+	assert.Equal(t, types.ErrNotFound, err)
+
+	err = Exec_MarketOrder(t, &et.MarketOrder{LeftAsset: left, RightAsset: right, Amount: types.DefaultCoinPrecision, Op: et.OpBuy, WorstPrice: 1e17}, PrivKeyB, stateDB, kvdb, env)
+	assert.Equal(t, et.ErrAssetPrice, err)
+	err = Exec_MarketOrder(t, &et.MarketOrder{LeftAsset: left, RightAsset: right, Amount: 200 * types.DefaultCoinPrecision, Op: et.OpSell}, PrivKeyA, stateDB, kvdb, env)
+	assert.Equal(t, et.ErrAssetBalance, err)
+}
+
+func TestStopOrder(t *testing.T) {
+	cfg := types.NewChain33Config(et.GetDefaultCfgstring())
+	cfg.SetTitleOnlyForTest("chain33")
+	InitExecType()
+	total := 100 * types.DefaultCoinPrecision
+	_, stateDB, kvdb := util.CreateTestDB()
+	execAddr := address.ExecAddress(et.ExchangeX)
+
+	accBty, _ := account.NewAccountDB(cfg, "coins", "bty", stateDB)
+	accCCNY, _ := account.NewAccountDB(cfg, "token", "CCNY", stateDB)
+	for _, addr := range Nodes[:3] {
+		accBty.SaveExecAccount(execAddr, &types.Account{Balance: total, Addr: addr})
+		accCCNY.SaveExecAccount(execAddr, &types.Account{Balance: total, Addr: addr})
+	}
+	env := &execEnv{10, 1, 1539918074}
+	left := &et.Asset{Symbol: "bty", Execer: "coins"}
+	right := &et.Asset{Execer: "token", Symbol: "CCNY"}
+
+	/*
+	  用例说明：
+	   1.A和B以价格2成交，最新成交价格为2
+	   2.C挂触发价格1.5的止损卖单(市价)，已经触及触发价格的止盈卖单下单失败
+	   3.B挂触发价格3的止损买单(限价3)以及价格1的买单
+	   4.A以价格1卖出，触发C的止损卖单，C与B剩余的买单成交，未成交部分撤销
+	   5.B撤销未触发的止损买单，解冻资金
+	*/
+	err := Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: 2 * types.DefaultCoinPrecision, Amount: types.DefaultCoinPrecision, Op: et.OpSell}, PrivKeyA, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: 2 * types.DefaultCoinPrecision, Amount: types.DefaultCoinPrecision, Op: et.OpBuy}, PrivKeyB, stateDB, kvdb, env)
+	assert.Nil(t, err)
+
+	err = Exec_StopOrder(t, &et.StopOrder{LeftAsset: left, RightAsset: right, TriggerPrice: 150000000, Amount: 2 * types.DefaultCoinPrecision, Op: et.OpSell, Kind: et.StopLoss}, PrivKeyC, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	acc := accBty.LoadExecAccount(Nodes[2], execAddr)
+	assert.Equal(t, 2*types.DefaultCoinPrecision, acc.Frozen)
+	err = Exec_StopOrder(t, &et.StopOrder{LeftAsset: left, RightAsset: right, TriggerPrice: 190000000, Amount: types.DefaultCoinPrecision, Op: et.OpSell, Kind: et.TakeProfit}, PrivKeyC, stateDB, kvdb, env)
+	assert.Equal(t, et.ErrTriggerPrice, err)
+	stopList, err := Exec_QueryStopOrderList(Nodes[2], stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(stopList.List))
+	stopID := stopList.List[0].OrderID
+
+	err = Exec_StopOrder(t, &et.StopOrder{LeftAsset: left, RightAsset: right, TriggerPrice: 3 * types.DefaultCoinPrecision, Price: 3 * types.DefaultCoinPrecision, Amount: types.DefaultCoinPrecision, Op: et.OpBuy, Kind: et.StopLoss}, PrivKeyB, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: types.DefaultCoinPrecision, Amount: 2 * types.DefaultCoinPrecision, Op: et.OpBuy}, PrivKeyB, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	acc = accCCNY.LoadExecAccount(Nodes[1], execAddr)
+	assert.Equal(t, 5*types.DefaultCoinPrecision, acc.Frozen)
+
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: types.DefaultCoinPrecision, Amount: types.DefaultCoinPrecision, Op: et.OpSell}, PrivKeyA, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	stopList, err = Exec_QueryStopOrderList(Nodes[2], stateDB, kvdb)
+	assert.Equal(t, types.ErrNotFound, err)
+	order, err := Exec_QueryOrder(stopID, stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(et.TyMarketOrderAction), order.Ty)
+	assert.Equal(t, int32(et.Revoked), order.Status)
+	assert.Equal(t, types.DefaultCoinPrecision, order.Executed)
+	acc = accBty.LoadExecAccount(Nodes[2], execAddr)
+	assert.Equal(t, int64(0), acc.Frozen)
+	assert.Equal(t, total-types.DefaultCoinPrecision, acc.Balance)
+	orderList, err := Exec_QueryOrderList(et.Revoked, Nodes[2], "", stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, stopID, orderList.List[0].OrderID)
+	_, err = Exec_QueryMarketDepth(&et.QueryMarketDepth{LeftAsset: left, RightAsset: right, Op: et.OpBuy}, stateDB, kvdb)
+	assert.Equal(t, types.ErrNotFound, err)
+
+	stopList, err = Exec_QueryStopOrderList(Nodes[1], stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(stopList.List))
+	err = Exec_RevokeOrder(t, stopList.List[0].OrderID, PrivKeyB, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	acc = accCCNY.LoadExecAccount(Nodes[1], execAddr)
+	assert.Equal(t, int64(0), acc.Frozen)
+	_, err = Exec_QueryStopOrderList(Nodes[1], stateDB, kvdb)
+	assert.Equal(t, types.ErrNotFound, err)
+}
+
+func TestTimeInForce(t *testing.T) {
+	cfg := types.NewChain33Config(et.GetDefaultCfgstring())
+	cfg.SetTitleOnlyForTest("chain33")
+	InitExecType()
+	total := 100 * types.DefaultCoinPrecision
+	_, stateDB, kvdb := util.CreateTestDB()
+	execAddr := address.ExecAddress(et.ExchangeX)
+
+	accBty, _ := account.NewAccountDB(cfg, "coins", "bty", stateDB)
+	accCCNY, _ := account.NewAccountDB(cfg, "token", "CCNY", stateDB)
+	for _, addr := range Nodes[:2] {
+		accBty.SaveExecAccount(execAddr, &types.Account{Balance: total, Addr: addr})
+		accCCNY.SaveExecAccount(execAddr, &types.Account{Balance: total, Addr: addr})
+	}
+	env := &execEnv{10, 1, 1539918074}
+	left := &et.Asset{Symbol: "bty", Execer: "coins"}
+	right := &et.Asset{Execer: "token", Symbol: "CCNY"}
+
+	/*
+	  用例说明：
+	   1.有效方式和过期参数不匹配时检查失败，已经过期的订单下单失败
+	   2.A挂价格1的GTT卖单(高度13过期)以及价格2的卖单
+	   3.B以IOC买入3个，全部成交
+	   4.高度14时B以IOC买入5个，撮合时清理过期的卖单并解冻资金，未成交部分撤销
+	   5.会立即成交的只做挂单方订单失败
+	   6.不能全部成交的FOK订单失败，能够全部成交时成功
+	*/
+	err := Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: types.DefaultCoinPrecision, Amount: types.DefaultCoinPrecision, Op: et.OpSell, TimeInForce: et.GTT}, PrivKeyA, stateDB, kvdb, env)
+	assert.Equal(t, et.ErrTimeInForce, err)
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: types.DefaultCoinPrecision, Amount: types.DefaultCoinPrecision, Op: et.OpSell, TimeInForce: et.IOC, ExpireHeight: 100}, PrivKeyA, stateDB, kvdb, env)
+	assert.Equal(t, et.ErrTimeInForce, err)
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: types.DefaultCoinPrecision, Amount: types.DefaultCoinPrecision, Op: et.OpSell, TimeInForce: et.GTT, ExpireTime: env.blockTime}, PrivKeyA, stateDB, kvdb, env)
+	assert.Equal(t, et.ErrOrderExpired, err)
+
+	expireHeight := env.blockHeight + 3
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: types.DefaultCoinPrecision, Amount: 5 * types.DefaultCoinPrecision, Op: et.OpSell, TimeInForce: et.GTT, ExpireHeight: expireHeight}, PrivKeyA, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: 2 * types.DefaultCoinPrecision, Amount: 5 * types.DefaultCoinPrecision, Op: et.OpSell}, PrivKeyA, stateDB, kvdb, env)
+	assert.Nil(t, err)
+
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: types.DefaultCoinPrecision, Amount: 3 * types.DefaultCoinPrecision, Op: et.OpBuy, TimeInForce: et.IOC}, PrivKeyB, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	assert.Equal(t, expireHeight, env.blockHeight)
+	orderList, err := Exec_QueryOrderList(et.Completed, Nodes[1], "", stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, 3*types.DefaultCoinPrecision, orderList.List[0].Executed)
+
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: types.DefaultCoinPrecision, Amount: 5 * types.DefaultCoinPrecision, Op: et.OpBuy, TimeInForce: et.IOC}, PrivKeyB, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	orderList, err = Exec_QueryOrderList(et.Expired, Nodes[0], "", stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(orderList.List))
+	assert.Equal(t, 2*types.DefaultCoinPrecision, orderList.List[0].Balance)
+	order, err := Exec_QueryOrder(orderList.List[0].OrderID, stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(et.Expired), order.Status)
+	acc := accBty.LoadExecAccount(Nodes[0], execAddr)
+	assert.Equal(t, total-8*types.DefaultCoinPrecision, acc.Balance)
+	assert.Equal(t, 5*types.DefaultCoinPrecision, acc.Frozen)
+	orderList, err = Exec_QueryOrderList(et.Revoked, Nodes[1], "", stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), orderList.List[0].Executed)
+	acc = accCCNY.LoadExecAccount(Nodes[1], execAddr)
+	assert.Equal(t, int64(0), acc.Frozen)
+	marketDepthList, err := Exec_QueryMarketDepth(&et.QueryMarketDepth{LeftAsset: left, RightAsset: right, Op: et.OpSell}, stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(marketDepthList.List))
+	assert.Equal(t, 2*types.DefaultCoinPrecision, marketDepthList.List[0].Price)
+	assert.Equal(t, 5*types.DefaultCoinPrecision, marketDepthList.List[0].Amount)
+	historyList, err := Exec_QueryHistoryOrder(&et.QueryHistoryOrderList{LeftAsset: left, RightAsset: right}, stateDB, kvdb)
+	assert.Nil(t, err)
+	var expired int
+	for _, o := range historyList.List {
+		if o.Status == et.Expired {
+			expired++
+		}
+	}
+	assert.Equal(t, 1, expired)
+
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: 2 * types.DefaultCoinPrecision, Amount: types.DefaultCoinPrecision, Op: et.OpBuy, TimeInForce: et.PostOnly}, PrivKeyB, stateDB, kvdb, env)
+	assert.Equal(t, et.ErrPostOnly, err)
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: types.DefaultCoinPrecision, Amount: types.DefaultCoinPrecision, Op: et.OpBuy, TimeInForce: et.PostOnly}, PrivKeyB, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	orderList, err = Exec_QueryOrderList(et.Ordered, Nodes[1], "", stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(orderList.List))
+
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: 150000000, Amount: types.DefaultCoinPrecision, Op: et.OpBuy, TimeInForce: et.FOK}, PrivKeyB, stateDB, kvdb, env)
+	assert.Equal(t, et.ErrFillOrKill, err)
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: 2 * types.DefaultCoinPrecision, Amount: 5 * types.DefaultCoinPrecision, Op: et.OpBuy, TimeInForce: et.FOK}, PrivKeyB, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	_, err = Exec_QueryMarketDepth(&et.QueryMarketDepth{LeftAsset: left, RightAsset: right, Op: et.OpSell}, stateDB, kvdb)
+	assert.Equal(t, types.ErrNotFound, err)
+}
+
+func TestQueryExpiredOrder(t *testing.T) {
+	cfg := types.NewChain33Config(et.GetDefaultCfgstring())
+	cfg.SetTitleOnlyForTest("chain33")
+	InitExecType()
+	total := 100 * types.DefaultCoinPrecision
+	_, stateDB, kvdb := util.CreateTestDB()
+	execAddr := address.ExecAddress(et.ExchangeX)
+
+	accBty, _ := account.NewAccountDB(cfg, "coins", "bty", stateDB)
+	accBty.SaveExecAccount(execAddr, &types.Account{Balance: total, Addr: Nodes[0]})
+	env := &execEnv{10, 1, 1539918074}
+	left := &et.Asset{Symbol: "bty", Execer: "coins"}
+	right := &et.Asset{Execer: "token", Symbol: "CCNY"}
+
+	/*
+	  用例说明：
+	   1.A挂价格1的GTT卖单(高度10过期)和普通卖单，以及价格2的GTT卖单(时间100过期)
+	   2.没有撮合清理之前，按查询高度和时间过期的订单显示为过期状态
+	   3.市场深度中扣除过期订单的数量，全部过期的价格不再显示
+	*/
+	err := Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: types.DefaultCoinPrecision, Amount: 5 * types.DefaultCoinPrecision, Op: et.OpSell, TimeInForce: et.GTT, ExpireHeight: 10}, PrivKeyA, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: types.DefaultCoinPrecision, Amount: 2 * types.DefaultCoinPrecision, Op: et.OpSell}, PrivKeyA, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: 2 * types.DefaultCoinPrecision, Amount: 5 * types.DefaultCoinPrecision, Op: et.OpSell, TimeInForce: et.GTT, ExpireTime: 100}, PrivKeyA, stateDB, kvdb, env)
+	assert.Nil(t, err)
+
+	countExpired := func(env *execEnv) int {
+		msg, err := Exec_QueryWithEnv(et.FuncNameQueryOrderList, &et.QueryOrderList{Status: et.Ordered, Address: Nodes[0]}, stateDB, kvdb, env)
+		assert.Nil(t, err)
+		var expired int
+		for _, order := range msg.(*et.OrderList).List {
+			if order.Status == et.Expired {
+				expired++
+			}
+		}
+		return expired
+	}
+	queryDepth := &et.QueryMarketDepth{LeftAsset: left, RightAsset: right, Op: et.OpSell}
+
+	assert.Equal(t, 0, countExpired(env))
+	msg, err := Exec_QueryWithEnv(et.FuncNameQueryMarketDepth, queryDepth, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(msg.(*et.MarketDepthList).List))
+	assert.Equal(t, 7*types.DefaultCoinPrecision, msg.(*et.MarketDepthList).List[0].Amount)
+
+	later := &execEnv{80, 11, 1539918074}
+	assert.Equal(t, 1, countExpired(later))
+	msg, err = Exec_QueryWithEnv(et.FuncNameQueryMarketDepth, queryDepth, stateDB, kvdb, later)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(msg.(*et.MarketDepthList).List))
+	assert.Equal(t, 2*types.DefaultCoinPrecision, msg.(*et.MarketDepthList).List[0].Amount)
+
+	later = &execEnv{110, 11, 1539918074}
+	assert.Equal(t, 2, countExpired(later))
+	msg, err = Exec_QueryWithEnv(et.FuncNameQueryMarketDepth, queryDepth, stateDB, kvdb, later)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(msg.(*et.MarketDepthList).List))
+	assert.Equal(t, types.DefaultCoinPrecision, msg.(*et.MarketDepthList).List[0].Price)
+
+	orderList, err := Exec_QueryOrderList(et.Ordered, Nodes[0], "", stateDB, kvdb)
+	assert.Nil(t, err)
+	for _, order := range orderList.List {
+		msg, err = Exec_QueryWithEnv(et.FuncNameQueryOrder, &et.QueryOrder{OrderID: order.OrderID}, stateDB, kvdb, later)
+		assert.Nil(t, err)
+		if order.GetLimitOrder().GetTimeInForce() == et.GTT {
+			assert.Equal(t, int32(et.Expired), msg.(*et.Order).Status)
+		} else {
+			assert.Equal(t, int32(et.Ordered), msg.(*et.Order).Status)
+		}
+	}
+}
+
+func TestCandles(t *testing.T) {
+	cfg := types.NewChain33Config(et.GetDefaultCfgstring())
+	cfg.SetTitleOnlyForTest("chain33")
+	InitExecType()
+	total := 100 * types.DefaultCoinPrecision
+	_, stateDB, kvdb := util.CreateTestDB()
+	execAddr := address.ExecAddress(et.ExchangeX)
+
+	accBty, _ := account.NewAccountDB(cfg, "coins", "bty", stateDB)
+	accCCNY, _ := account.NewAccountDB(cfg, "token", "CCNY", stateDB)
+	for _, addr := range Nodes[:2] {
+		accBty.SaveExecAccount(execAddr, &types.Account{Balance: total, Addr: addr})
+		accCCNY.SaveExecAccount(execAddr, &types.Account{Balance: total, Addr: addr})
+	}
+	// 区块时间依次为1539918094, 1539918114, 1539918134, 1539918154, 都在同一个小时内
+	env := &execEnv{1539918074, 10, 1}
+	left := &et.Asset{Symbol: "bty", Execer: "coins"}
+	right := &et.Asset{Execer: "token", Symbol: "CCNY"}
+
+	/*
+	  用例说明：
+	   1.A挂价格为1的卖单5个，B以价格1买入1个，成交记入第一个分钟K线
+	   2.A挂价格为2的卖单5个，B以价格2买入6个，依次以价格1和2成交，记入第二个分钟K线
+	   3.小时K线和24小时行情统计全部成交，超过24小时没有成交时行情只有最新成交价
+	   4.回滚最后一笔交易后K线恢复
+	*/
+	err := Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: types.DefaultCoinPrecision, Amount: 5 * types.DefaultCoinPrecision, Op: et.OpSell}, PrivKeyA, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: types.DefaultCoinPrecision, Amount: types.DefaultCoinPrecision, Op: et.OpBuy}, PrivKeyB, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: 2 * types.DefaultCoinPrecision, Amount: 5 * types.DefaultCoinPrecision, Op: et.OpSell}, PrivKeyA, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	tx, err := CreateLimitOrder(&et.LimitOrder{LeftAsset: left, RightAsset: right, Price: 2 * types.DefaultCoinPrecision, Amount: 6 * types.DefaultCoinPrecision, Op: et.OpBuy}, PrivKeyB)
+	assert.Nil(t, err)
+	err = Exec_Block(t, stateDB, kvdb, env, tx)
+	assert.Nil(t, err)
+
+	candleList, err := Exec_QueryCandles(&et.QueryCandles{LeftAsset: left, RightAsset: right, Interval: et.CandleMinute}, stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(candleList.List))
+	candle := candleList.List[0]
+	assert.Equal(t, int64(1539918120), candle.OpenTime)
+	assert.Equal(t, types.DefaultCoinPrecision, candle.Open)
+	assert.Equal(t, 2*types.DefaultCoinPrecision, candle.High)
+	assert.Equal(t, types.DefaultCoinPrecision, candle.Low)
+	assert.Equal(t, 2*types.DefaultCoinPrecision, candle.Close)
+	assert.Equal(t, 6*types.DefaultCoinPrecision, candle.Volume)
+	assert.Equal(t, 8*types.DefaultCoinPrecision, candle.Turnover)
+	assert.Equal(t, int64(2), candle.Count)
+	candle = candleList.List[1]
+	assert.Equal(t, int64(1539918060), candle.OpenTime)
+	assert.Equal(t, types.DefaultCoinPrecision, candle.Close)
+	assert.Equal(t, types.DefaultCoinPrecision, candle.Volume)
+
+	candleList, err = Exec_QueryCandles(&et.QueryCandles{LeftAsset: left, RightAsset: right, Interval: et.CandleHour}, stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(candleList.List))
+	assert.Equal(t, int64(1539918000), candleList.List[0].OpenTime)
+	assert.Equal(t, 7*types.DefaultCoinPrecision, candleList.List[0].Volume)
+	assert.Equal(t, int64(3), candleList.List[0].Count)
+	_, err = Exec_QueryCandles(&et.QueryCandles{LeftAsset: left, RightAsset: right, Interval: 300}, stateDB, kvdb)
+	assert.Equal(t, et.ErrCandleInterval, err)
+
+	ticker, err := Exec_QueryTicker(&et.QueryTicker{LeftAsset: left, RightAsset: right}, env.blockTime, stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, 2*types.DefaultCoinPrecision, ticker.Last)
+	assert.Equal(t, types.DefaultCoinPrecision, ticker.Open)
+	assert.Equal(t, 2*types.DefaultCoinPrecision, ticker.High)
+	assert.Equal(t, types.DefaultCoinPrecision, ticker.Low)
+	assert.Equal(t, 7*types.DefaultCoinPrecision, ticker.Volume)
+	assert.Equal(t, 9*types.DefaultCoinPrecision, ticker.Turnover)
+	assert.Equal(t, int64(3), ticker.Count)
+	ticker, err = Exec_QueryTicker(&et.QueryTicker{LeftAsset: left, RightAsset: right}, env.blockTime+et.CandleDay, stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, 2*types.DefaultCoinPrecision, ticker.Last)
+	assert.Equal(t, 2*types.DefaultCoinPrecision, ticker.Open)
+	assert.Equal(t, int64(0), ticker.Volume)
+
+	exec := NewExchange()
+	q := queue.New("channel")
+	q.SetConfig(cfg)
+	api, _ := client.New(q.Client(), nil)
+	exec.SetAPI(api)
+	exec.SetStateDB(stateDB)
+	exec.SetLocalDB(kvdb)
+	exec.SetEnv(env.blockHeight, env.blockTime, env.difficulty)
+	set, err := exec.ExecDelLocal(tx, &types.ReceiptData{Ty: types.ExecOk}, 0)
+	assert.Nil(t, err)
+	for _, kv := range set.KV {
+		kvdb.Set(kv.Key, kv.Value)
+	}
+	candleList, err = Exec_QueryCandles(&et.QueryCandles{LeftAsset: left, RightAsset: right, Interval: et.CandleMinute}, stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(candleList.List))
+	assert.Equal(t, int64(1539918060), candleList.List[0].OpenTime)
+	candleList, err = Exec_QueryCandles(&et.QueryCandles{LeftAsset: left, RightAsset: right, Interval: et.CandleDay}, stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, types.DefaultCoinPrecision, candleList.List[0].Volume)
+	assert.Equal(t, int64(1), candleList.List[0].Count)
+}
+
 func CreateLimitOrder(limitOrder *et.LimitOrder, privKey string) (tx *types.Transaction, err error) {
 	ety := types.LoadExecutorType(et.ExchangeX)
 	tx, err = ety.Create("LimitOrder", limitOrder)
@@ -769,6 +1217,60 @@ func Exec_LimitOrder(t *testing.T, limitOrder *et.LimitOrder, privKey string, st
 	return Exec_Block(t, stateDB, kvdb, env, tx)
 }
 
+func CreateMarketOrder(marketOrder *et.MarketOrder, privKey string) (tx *types.Transaction, err error) {
+	ety := types.LoadExecutorType(et.ExchangeX)
+	tx, err = ety.Create("MarketOrder", marketOrder)
+	if err != nil {
+		return nil, err
+	}
+	cfg := types.NewChain33Config(et.GetDefaultCfgstring())
+	cfg.SetTitleOnlyForTest("chain33")
+	tx, err = types.FormatTx(cfg, et.ExchangeX, tx)
+	if err != nil {
+		return nil, err
+	}
+	tx, err = signTx(tx, privKey)
+	if err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+func Exec_MarketOrder(t *testing.T, marketOrder *et.MarketOrder, privKey string, stateDB db.DB, kvdb db.KVDB, env *execEnv) error {
+	tx, err := CreateMarketOrder(marketOrder, privKey)
+	if err != nil {
+		return err
+	}
+	return Exec_Block(t, stateDB, kvdb, env, tx)
+}
+
+func CreateStopOrder(stopOrder *et.StopOrder, privKey string) (tx *types.Transaction, err error) {
+	ety := types.LoadExecutorType(et.ExchangeX)
+	tx, err = ety.Create("StopOrder", stopOrder)
+	if err != nil {
+		return nil, err
+	}
+	cfg := types.NewChain33Config(et.GetDefaultCfgstring())
+	cfg.SetTitleOnlyForTest("chain33")
+	tx, err = types.FormatTx(cfg, et.ExchangeX, tx)
+	if err != nil {
+		return nil, err
+	}
+	tx, err = signTx(tx, privKey)
+	if err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+func Exec_StopOrder(t *testing.T, stopOrder *et.StopOrder, privKey string, stateDB db.DB, kvdb db.KVDB, env *execEnv) error {
+	tx, err := CreateStopOrder(stopOrder, privKey)
+	if err != nil {
+		return err
+	}
+	return Exec_Block(t, stateDB, kvdb, env, tx)
+}
+
 func Exec_RevokeOrder(t *testing.T, orderID int64, privKey string, stateDB db.DB, kvdb db.KVDB, env *execEnv) error {
 	tx, err := CreateRevokeOrder(orderID, privKey)
 	if err != nil {
@@ -794,6 +1296,38 @@ func Exec_QueryOrderList(status int32, addr string, primaryKey string, stateDB d
 	}
 	return msg.(*et.OrderList), nil
 }
+// Exec_QueryWithEnv 按指定的高度和时间查询
+func Exec_QueryWithEnv(funcName string, param types.Message, stateDB db.KV, kvdb db.KVDB, env *execEnv) (types.Message, error) {
+	cfg := types.NewChain33Config(et.GetDefaultCfgstring())
+	cfg.SetTitleOnlyForTest("chain33")
+	exec := NewExchange()
+	q := queue.New("channel")
+	q.SetConfig(cfg)
+	api, _ := client.New(q.Client(), nil)
+	exec.SetAPI(api)
+	exec.SetStateDB(stateDB)
+	exec.SetLocalDB(kvdb)
+	exec.SetEnv(env.blockHeight, env.blockTime, env.difficulty)
+	return exec.Query(funcName, types.Encode(param))
+}
+
+func Exec_QueryStopOrderList(addr string, stateDB db.KV, kvdb db.KVDB) (*et.OrderList, error) {
+	cfg := types.NewChain33Config(et.GetDefaultCfgstring())
+	cfg.SetTitleOnlyForTest("chain33")
+	exec := NewExchange()
+	q := queue.New("channel")
+	q.SetConfig(cfg)
+	api, _ := client.New(q.Client(), nil)
+	exec.SetAPI(api)
+	exec.SetStateDB(stateDB)
+	exec.SetLocalDB(kvdb)
+	msg, err := exec.Query(et.FuncNameQueryStopOrderList, types.Encode(&et.QueryOrderList{Address: addr}))
+	if err != nil {
+		return nil, err
+	}
+	return msg.(*et.OrderList), nil
+}
+
 func Exec_QueryOrder(orderID int64, stateDB db.KV, kvdb db.KVDB) (*et.Order, error) {
 	cfg := types.NewChain33Config(et.GetDefaultCfgstring())
 	cfg.SetTitleOnlyForTest("chain33")
@@ -844,6 +1378,42 @@ func Exec_QueryHistoryOrder(query *et.QueryHistoryOrderList, stateDB db.KV, kvdb
 	msg, err := exec.Query(et.FuncNameQueryHistoryOrderList, types.Encode(query))
 	return msg.(*et.OrderList), err
 }
+func Exec_QueryCandles(query *et.QueryCandles, stateDB db.KV, kvdb db.KVDB) (*et.CandleList, error) {
+	cfg := types.NewChain33Config(et.GetDefaultCfgstring())
+	cfg.SetTitleOnlyForTest("chain33")
+	exec := NewExchange()
+	q := queue.New("channel")
+	q.SetConfig(cfg)
+	api, _ := client.New(q.Client(), nil)
+	exec.SetAPI(api)
+	exec.SetStateDB(stateDB)
+	exec.SetLocalDB(kvdb)
+	msg, err := exec.Query(et.FuncNameQueryCandles, types.Encode(query))
+	if err != nil {
+		return nil, err
+	}
+	return msg.(*et.CandleList), err
+}
+
+func Exec_QueryTicker(query *et.QueryTicker, blockTime int64, stateDB db.KV, kvdb db.KVDB) (*et.Ticker, error) {
+	cfg := types.NewChain33Config(et.GetDefaultCfgstring())
+	cfg.SetTitleOnlyForTest("chain33")
+	exec := NewExchange()
+	q := queue.New("channel")
+	q.SetConfig(cfg)
+	api, _ := client.New(q.Client(), nil)
+	exec.SetAPI(api)
+	exec.SetStateDB(stateDB)
+	exec.SetLocalDB(kvdb)
+	//行情按照最新区块时间统计
+	exec.SetEnv(0, blockTime, 0)
+	msg, err := exec.Query(et.FuncNameQueryTicker, types.Encode(query))
+	if err != nil {
+		return nil, err
+	}
+	return msg.(*et.Ticker), err
+}
+
 func signTx(tx *types.Transaction, hexPrivKey string) (*types.Transaction, error) {
 	signType := types.SECP256K1
 	c, err := crypto.Load(types.GetSignName("", signType), -1)
@@ -26,6 +26,8 @@ type Action struct {
 	localDB   dbm.KVDB
 	index     int
 	api       client.QueueProtocolAPI
+	// 本次交易最新的成交价格
+	lastPrice int64
 }
 
 //NewAction ...
@@ -73,9 +75,16 @@ func CalcActualCost(op int32, amount int64, price, coinPrecision int64) int64 {
 	return amount
 }
 
+const (
+	//MinPrice 最低价格
+	MinPrice = 1
+	//MaxPrice 最高价格
+	MaxPrice = 1e16
+)
+
 //CheckPrice price  1<=price<=1e16
 func CheckPrice(price int64) bool {
-	if price > 1e16 || price < 1 {
+	if price > MaxPrice || price < MinPrice {
 		return false
 	}
 	return true
@@ -112,7 +121,7 @@ func CheckDirection(direction int32) bool {
 
 //CheckStatus ...
 func CheckStatus(status int32) bool {
-	if status == et.Ordered || status == et.Completed || status == et.Revoked {
+	if status == et.Ordered || status == et.Completed || status == et.Revoked || status == et.Expired {
 		return true
 	}
 	return false
@@ -146,6 +155,12 @@ func (a *Action) LimitOrder(payload *et.LimitOrder, entrustAddr string) (*types.
 	if !CheckOp(payload.GetOp()) {
 		return nil, et.ErrAssetOp
 	}
+	if !CheckTimeInForce(payload) {
+		return nil, et.ErrTimeInForce
+	}
+	if isExpired(payload, a.height, a.blocktime) {
+		return nil, et.ErrOrderExpired
+	}
 
 	leftAssetDB, err := account.NewAccountDB(cfg, leftAsset.GetExecer(), leftAsset.GetSymbol(), a.statedb)
 	if err != nil {
@@ -163,7 +178,7 @@ func (a *Action) LimitOrder(payload *et.LimitOrder, entrustAddr string) (*types.
 			elog.Error("limit check right balance", "addr", a.fromaddr, "avail", rightAccount.Balance, "need", amount)
 			return nil, et.ErrAssetBalance
 		}
-		return a.matchLimitOrder(payload, leftAssetDB, rightAssetDB, entrustAddr)
+		return a.matchLimitOrder(payload, et.TyLimitOrderAction, leftAssetDB, rightAssetDB, entrustAddr)
 
 	}
 	if payload.GetOp() == et.OpSell {
@@ -173,11 +188,63 @@ func (a *Action) LimitOrder(payload *et.LimitOrder, entrustAddr string) (*types.
 			elog.Error("limit check left balance", "addr", a.fromaddr, "avail", leftAccount.Balance, "need", amount)
 			return nil, et.ErrAssetBalance
 		}
-		return a.matchLimitOrder(payload, leftAssetDB, rightAssetDB, entrustAddr)
+		return a.matchLimitOrder(payload, et.TyLimitOrderAction, leftAssetDB, rightAssetDB, entrustAddr)
 	}
 	return nil, fmt.Errorf("unknow op")
 }
 
+//MarketOrder 市价单按对手盘价格优先逐档成交, 成交价格受WorstPrice保护, 未成交部分直接撤销, 不会挂单
+func (a *Action) MarketOrder(payload *et.MarketOrder) (*types.Receipt, error) {
+	leftAsset := payload.GetLeftAsset()
+	rightAsset := payload.GetRightAsset()
+	cfg := a.api.GetConfig()
+	if !CheckExchangeAsset(cfg.GetCoinExec(), leftAsset, rightAsset) {
+		return nil, et.ErrAsset
+	}
+	if !CheckAmount(payload.GetAmount(), cfg.GetCoinPrecision()) {
+		return nil, et.ErrAssetAmount
+	}
+	if !CheckOp(payload.GetOp()) {
+		return nil, et.ErrAssetOp
+	}
+	price := payload.GetWorstPrice()
+	if price == 0 {
+		price = MaxPrice
+		if payload.GetOp() == et.OpSell {
+			price = MinPrice
+		}
+	}
+	if !CheckPrice(price) {
+		return nil, et.ErrAssetPrice
+	}
+
+	leftAssetDB, err := account.NewAccountDB(cfg, leftAsset.GetExecer(), leftAsset.GetSymbol(), a.statedb)
+	if err != nil {
+		return nil, err
+	}
+	rightAssetDB, err := account.NewAccountDB(cfg, rightAsset.GetExecer(), rightAsset.GetSymbol(), a.statedb)
+	if err != nil {
+		return nil, err
+	}
+	// 买单的成交金额取决于对手盘价格, 在撮合时逐笔检查余额
+	if payload.GetOp() == et.OpSell {
+		leftAccount := leftAssetDB.LoadExecAccount(a.fromaddr, a.execaddr)
+		if leftAccount.Balance < payload.GetAmount() {
+			elog.Error("market check left balance", "addr", a.fromaddr, "avail", leftAccount.Balance, "need", payload.GetAmount())
+			return nil, et.ErrAssetBalance
+		}
+	}
+	// 以最差成交价格作为限价撮合, 订单信息与限价单保持一致, 便于查询和本地索引
+	order := &et.LimitOrder{
+		LeftAsset:  leftAsset,
+		RightAsset: rightAsset,
+		Price:      price,
+		Amount:     payload.GetAmount(),
+		Op:         payload.GetOp(),
+	}
+	return a.matchLimitOrder(order, et.TyMarketOrderAction, leftAssetDB, rightAssetDB, "")
+}
+
 //RevokeOrder ...
 func (a *Action) RevokeOrder(payload *et.RevokeOrder) (*types.Receipt, error) {
 	var logs []*types.ReceiptLog
@@ -190,10 +257,13 @@ func (a *Action) RevokeOrder(payload *et.RevokeOrder) (*types.Receipt, error) {
 		elog.Error("RevokeOrder.OrderCheck", "addr", a.fromaddr, "order.addr", order.Addr, "order.status", order.Status)
 		return nil, et.ErrAddr
 	}
-	if order.Status == et.Completed || order.Status == et.Revoked {
+	if order.Status == et.Completed || order.Status == et.Revoked || order.Status == et.Expired {
 		elog.Error("RevokeOrder.OrderCheck", "addr", a.fromaddr, "order.addr", order.Addr, "order.status", order.Status)
 		return nil, et.ErrOrderSatus
 	}
+	if order.GetStopOrder() != nil {
+		return a.revokeStopOrder(order)
+	}
 	leftAsset := order.GetLimitOrder().GetLeftAsset()
 	rightAsset := order.GetLimitOrder().GetRightAsset()
 	price := order.GetLimitOrder().GetPrice()
@@ -258,24 +328,14 @@ func (a *Action) RevokeOrder(payload *et.RevokeOrder) (*types.Receipt, error) {
 //1. The purchase price is higher than the market price, and the price is matched from low to high.
 //2. Sell orders are matched at prices lower than market prices.
 //3. Match the same prices on a first-in, first-out basis
-func (a *Action) matchLimitOrder(payload *et.LimitOrder, leftAccountDB, rightAccountDB *account.DB, entrustAddr string) (*types.Receipt, error) {
-	var logs []*types.ReceiptLog
-	var kvs []*types.KeyValue
-	var priceKey string
-	var count int
-
-	cfg := a.api.GetConfig()
-	tCfg, err := ParseConfig(a.api.GetConfig(), a.height)
-	if err != nil {
-		elog.Error("executor/exchangedb matchLimitOrder.ParseConfig", "err", err)
-		return nil, err
-	}
-	trade := tCfg.GetTrade(payload)
-
+//4. The unmatched part of a market order or an IOC order is revoked instead of being listed
+//5. A FOK order fails unless it is filled entirely, a post-only order fails if it would be matched
+//6. Expired orders met during matching are removed from the book and their frozen funds are released
+func (a *Action) matchLimitOrder(payload *et.LimitOrder, orderTy int32, leftAccountDB, rightAccountDB *account.DB, entrustAddr string) (*types.Receipt, error) {
 	or := &et.Order{
 		OrderID:     a.GetIndex(),
 		Value:       &et.Order_LimitOrder{LimitOrder: payload},
-		Ty:          et.TyLimitOrderAction,
+		Ty:          orderTy,
 		Executed:    0,
 		AVGPrice:    0,
 		Balance:     payload.GetAmount(),
@@ -284,14 +344,38 @@ func (a *Action) matchLimitOrder(payload *et.LimitOrder, leftAccountDB, rightAcc
 		Addr:        a.fromaddr,
 		UpdateTime:  a.blocktime,
 		Index:       a.GetIndex(),
-		Rate:        trade.GetMaker(),
-		MinFee:      trade.GetMinFee(),
 		Hash:        hex.EncodeToString(a.txhash),
 		CreateTime:  a.blocktime,
 	}
+	return a.matchOrder(or, leftAccountDB, rightAccountDB)
+}
+
+// matchOrder match the order with the opposite side, or.Index is used as the receipt index
+func (a *Action) matchOrder(or *et.Order, leftAccountDB, rightAccountDB *account.DB) (*types.Receipt, error) {
+	var logs []*types.ReceiptLog
+	var kvs []*types.KeyValue
+	var priceKey string
+	var count int
+
+	payload := or.GetLimitOrder()
+	orderTy := or.Ty
+	logTy := int32(et.TyLimitOrderLog)
+	if orderTy == et.TyMarketOrderAction {
+		logTy = et.TyMarketOrderLog
+	}
+
+	cfg := a.api.GetConfig()
+	tCfg, err := ParseConfig(a.api.GetConfig(), a.height)
+	if err != nil {
+		elog.Error("executor/exchangedb matchLimitOrder.ParseConfig", "err", err)
+		return nil, err
+	}
+	trade := tCfg.GetTrade(payload)
+	or.Rate = trade.GetMaker()
+	or.MinFee = trade.GetMinFee()
 	re := &et.ReceiptExchange{
 		Order: or,
-		Index: a.GetIndex(),
+		Index: or.Index,
 	}
 
 	// A single transaction can match up to 100 historical orders, the maximum depth can be matched, the system has to protect itself
@@ -310,6 +394,9 @@ func (a *Action) matchLimitOrder(payload *et.LimitOrder, leftAccountDB, rightAcc
 			break
 		}
 		for _, marketDepth := range marketDepthList.List {
+			if done {
+				break
+			}
 			elog.Info("LimitOrder debug find depth", "height", a.height, "amount", marketDepth.Amount, "price", marketDepth.Price, "order-price", payload.GetPrice(), "op", a.OpSwap(payload.Op), "index", a.GetIndex())
 			if count >= et.MaxMatchCount {
 				done = true
@@ -355,6 +442,25 @@ func (a *Action) matchLimitOrder(payload *et.LimitOrder, leftAccountDB, rightAcc
 						}
 						continue
 					}
+					if isExpired(order.GetLimitOrder(), a.height, a.blocktime) {
+						log, kv, err := a.expireOrder(leftAccountDB, rightAccountDB, order)
+						if err != nil {
+							return nil, err
+						}
+						logs = append(logs, log...)
+						kvs = append(kvs, kv...)
+						re.MatchOrders = append(re.MatchOrders, order)
+						count = count + 1
+						continue
+					}
+					if payload.GetTimeInForce() == et.PostOnly {
+						return nil, et.ErrPostOnly
+					}
+					// 市价买单没有预先冻结资金, 余额不足以支付本次成交时停止撮合
+					if orderTy == et.TyMarketOrderAction && payload.Op == et.OpBuy && !a.canAfford(rightAccountDB, order, or) {
+						done = true
+						break
+					}
 					log, kv, err := a.matchModel(leftAccountDB, rightAccountDB, payload, order, or, re, tCfg.GetFeeAddr(), trade.GetTaker()) // payload, or redundant
 					if err != nil {
 						if err == types.ErrNoBalance {
@@ -366,7 +472,7 @@ func (a *Action) matchLimitOrder(payload *et.LimitOrder, leftAccountDB, rightAcc
 					logs = append(logs, log...)
 					kvs = append(kvs, kv...)
 					if or.Status == et.Completed {
-						receiptlog := &types.ReceiptLog{Ty: et.TyLimitOrderLog, Log: types.Encode(re)}
+						receiptlog := &types.ReceiptLog{Ty: logTy, Log: types.Encode(re)}
 						logs = append(logs, receiptlog)
 						receipts := &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}
 						return receipts, nil
@@ -374,7 +480,7 @@ func (a *Action) matchLimitOrder(payload *et.LimitOrder, leftAccountDB, rightAcc
 					// match depth count
 					count = count + 1
 				}
-				if orderList.PrimaryKey == "" {
+				if done || orderList.PrimaryKey == "" {
 					break
 				}
 				orderKey = orderList.PrimaryKey
@@ -397,6 +503,21 @@ func (a *Action) matchLimitOrder(payload *et.LimitOrder, leftAccountDB, rightAcc
 		priceKey = marketDepthList.PrimaryKey
 	}
 
+	if payload.GetTimeInForce() == et.FOK {
+		return nil, et.ErrFillOrKill
+	}
+
+	//Outstanding market orders and IOC orders are revoked, nothing is frozen
+	if isImmediateOrder(or) {
+		or.Status = et.Revoked
+		or.UpdateTime = a.blocktime
+		kvs = append(kvs, a.GetKVSet(or)...)
+		re.Order = or
+		receiptlog := &types.ReceiptLog{Ty: logTy, Log: types.Encode(re)}
+		logs = append(logs, receiptlog)
+		return &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}, nil
+	}
+
 	//Outstanding orders require freezing of the remaining unclosed funds
 	if payload.Op == et.OpBuy {
 		amount := CalcActualCost(et.OpBuy, or.Balance, payload.Price, cfg.GetCoinPrecision())
@@ -420,12 +541,25 @@ func (a *Action) matchLimitOrder(payload *et.LimitOrder, leftAccountDB, rightAcc
 	}
 	kvs = append(kvs, a.GetKVSet(or)...)
 	re.Order = or
-	receiptlog := &types.ReceiptLog{Ty: et.TyLimitOrderLog, Log: types.Encode(re)}
+	receiptlog := &types.ReceiptLog{Ty: logTy, Log: types.Encode(re)}
 	logs = append(logs, receiptlog)
 	receipts := &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}
 	return receipts, nil
 }
 
+// canAfford 检查主动方是否有足够的余额买入本次撮合的数量
+func (a *Action) canAfford(rightAccountDB *account.DB, matchorder *et.Order, or *et.Order) bool {
+	if matchorder.Addr == a.fromaddr {
+		return true
+	}
+	matched := or.GetBalance()
+	if matchorder.GetBalance() < matched {
+		matched = matchorder.GetBalance()
+	}
+	cost := CalcActualCost(et.OpBuy, matched, matchorder.GetLimitOrder().Price, a.api.GetConfig().GetCoinPrecision())
+	return rightAccountDB.LoadExecAccount(a.fromaddr, a.execaddr).Balance >= cost
+}
+
 func (a *Action) matchModel(leftAccountDB, rightAccountDB *account.DB, payload *et.LimitOrder, matchorder *et.Order, or *et.Order, re *et.ReceiptExchange, feeAddr string, taker int32) ([]*types.ReceiptLog, []*types.KeyValue, error) {
 	var logs []*types.ReceiptLog
 	var kvs []*types.KeyValue
@@ -558,6 +692,7 @@ func (a *Action) matchModel(leftAccountDB, rightAccountDB *account.DB, payload *
 	}
 
 	matchorder.UpdateTime = a.blocktime
+	a.lastPrice = matchorder.GetLimitOrder().Price
 
 	if matched == matchorder.GetBalance() {
 		matchorder.Status = et.Completed
@@ -608,7 +743,9 @@ func findOrderByOrderID(statedb dbm.KV, localdb dbm.KV, orderID int64) (*et.Orde
 		elog.Error("findOrderByOrderID.Decode", "orderID", orderID, "err", err.Error())
 		return nil, err
 	}
-	order.Executed = order.GetLimitOrder().Amount - order.Balance
+	if order.GetLimitOrder() != nil {
+		order.Executed = order.GetLimitOrder().Amount - order.Balance
+	}
 	return &order, nil
 }
 
@@ -730,7 +867,7 @@ HERE:
 //QueryOrderList Displays the latest by default
 func QueryOrderList(localdb dbm.KV, addr string, status, count, direction int32, primaryKey string) (types.Message, error) {
 	var table *tab.Table
-	if status == et.Completed || status == et.Revoked {
+	if status == et.Completed || status == et.Revoked || status == et.Expired {
 		table = NewHistoryOrderTable(localdb)
 	} else {
 		table = NewMarketOrderTable(localdb)
@@ -12,14 +12,40 @@ import (
 
 // 限价交易
 func (e *exchange) Exec_LimitOrder(payload *exchangetypes.LimitOrder, tx *types.Transaction, index int) (*types.Receipt, error) {
+	cfg := e.GetAPI().GetConfig()
+	if hasTimeInForce(payload) && !cfg.IsDappFork(e.GetHeight(), exchangetypes.ExchangeX, exchangetypes.ForkTimeInForce) {
+		return nil, types.ErrActionNotSupport
+	}
 	action := NewAction(e, tx, index)
-	return action.LimitOrder(payload, "")
+	receipt, err := action.LimitOrder(payload, "")
+	if err != nil {
+		return nil, err
+	}
+	return action.triggerStopOrders(payload.GetLeftAsset(), payload.GetRightAsset(), receipt)
 }
 
 //市价交易
 func (e *exchange) Exec_MarketOrder(payload *exchangetypes.MarketOrder, tx *types.Transaction, index int) (*types.Receipt, error) {
-	//TODO marketOrder
-	return nil, types.ErrActionNotSupport
+	cfg := e.GetAPI().GetConfig()
+	if !cfg.IsDappFork(e.GetHeight(), exchangetypes.ExchangeX, exchangetypes.ForkMarketOrder) {
+		return nil, types.ErrActionNotSupport
+	}
+	action := NewAction(e, tx, index)
+	receipt, err := action.MarketOrder(payload)
+	if err != nil {
+		return nil, err
+	}
+	return action.triggerStopOrders(payload.GetLeftAsset(), payload.GetRightAsset(), receipt)
+}
+
+// 条件委托
+func (e *exchange) Exec_StopOrder(payload *exchangetypes.StopOrder, tx *types.Transaction, index int) (*types.Receipt, error) {
+	cfg := e.GetAPI().GetConfig()
+	if !cfg.IsDappFork(e.GetHeight(), exchangetypes.ExchangeX, exchangetypes.ForkStopOrder) {
+		return nil, types.ErrActionNotSupport
+	}
+	action := NewAction(e, tx, index)
+	return action.StopOrder(payload)
 }
 
 // 撤单
@@ -37,7 +63,11 @@ func (e *exchange) Exec_ExchangeBind(payload *exchangetypes.ExchangeBind, tx *ty
 // 委托交易
 func (e *exchange) Exec_EntrustOrder(payload *exchangetypes.EntrustOrder, tx *types.Transaction, index int) (*types.Receipt, error) {
 	action := NewAction(e, tx, index)
-	return action.EntrustOrder(payload)
+	receipt, err := action.EntrustOrder(payload)
+	if err != nil {
+		return nil, err
+	}
+	return action.triggerStopOrders(payload.GetLeftAsset(), payload.GetRightAsset(), receipt)
 }
 
 // 委托撤单
@@ -3,6 +3,7 @@ package executor
 import (
 	"fmt"
 
+	dbm "github.com/33cn/chain33/common/db"
 	"github.com/33cn/chain33/common/db/table"
 	"github.com/33cn/chain33/types"
 	ety "github.com/33cn/plugin/plugin/dapp/exchange/types"
@@ -21,6 +22,10 @@ func (e *exchange) ExecLocal_MarketOrder(payload *ety.MarketOrder, tx *types.Tra
 	return e.interExecLocal(tx, receiptData, index)
 }
 
+func (e *exchange) ExecLocal_StopOrder(payload *ety.StopOrder, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return e.interExecLocal(tx, receiptData, index)
+}
+
 func (e *exchange) ExecLocal_RevokeOrder(payload *ety.RevokeOrder, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
 	return e.interExecLocal(tx, receiptData, index)
 }
@@ -35,9 +40,14 @@ func (e *exchange) ExecLocal_EntrustRevokeOrder(payload *ety.MarketOrder, tx *ty
 
 func (e *exchange) interExecLocal(tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
 	dbSet := &types.LocalDBSet{}
-	historyTable := NewHistoryOrderTable(e.GetLocalDB())
-	marketTable := NewMarketDepthTable(e.GetLocalDB())
-	orderTable := NewMarketOrderTable(e.GetLocalDB())
+	// 触发条件委托时一笔交易有多个订单回执, 后面的回执需要读取前面回执更新后的索引
+	cache := newLocalCache(e.GetLocalDB())
+	historyTable := NewHistoryOrderTable(cache)
+	marketTable := NewMarketDepthTable(cache)
+	orderTable := NewMarketOrderTable(cache)
+	stopTable := NewStopOrderTable(cache)
+	candleTable := NewCandleTable(cache)
+	var kvs []*types.KeyValue
 	if receiptData.Ty == types.ExecOk {
 		for _, log := range receiptData.Logs {
 			switch log.Ty {
@@ -47,31 +57,28 @@ func (e *exchange) interExecLocal(tx *types.Transaction, receiptData *types.Rece
 					return nil, err
 				}
 				e.updateIndex(marketTable, orderTable, historyTable, receipt)
+				e.updateCandles(candleTable, receipt)
+			case ety.TyStopOrderLog:
+				receipt := &ety.ReceiptExchange{}
+				if err := types.Decode(log.Log, receipt); err != nil {
+					return nil, err
+				}
+				e.updateStopOrder(stopTable, receipt.GetOrder())
+			default:
+				continue
+			}
+			for _, t := range []*table.Table{marketTable, orderTable, historyTable, stopTable, candleTable} {
+				kv, err := t.Save()
+				if err != nil {
+					elog.Error("updateIndex", "table.Save", err.Error())
+					return nil, nil
+				}
+				cache.setKVs(kv)
+				kvs = append(kvs, kv...)
 			}
 		}
 	}
 
-	var kvs []*types.KeyValue
-	kv, err := marketTable.Save()
-	if err != nil {
-		elog.Error("updateIndex", "marketTable.Save", err.Error())
-		return nil, nil
-	}
-	kvs = append(kvs, kv...)
-
-	kv, err = orderTable.Save()
-	if err != nil {
-		elog.Error("updateIndex", "orderTable.Save", err.Error())
-		return nil, nil
-	}
-	kvs = append(kvs, kv...)
-
-	kv, err = historyTable.Save()
-	if err != nil {
-		elog.Error("updateIndex", "historyTable.Save", err.Error())
-		return nil, nil
-	}
-	kvs = append(kvs, kv...)
 	dbSet.KV = append(dbSet.KV, kvs...)
 	dbSet = e.addAutoRollBack(tx, dbSet.KV)
 	localDB := e.GetLocalDB()
@@ -85,6 +92,32 @@ func (e *exchange) interExecLocal(tx *types.Transaction, receiptData *types.Rece
 	return dbSet, nil
 }
 
+// localCache 在本地数据库之上缓存本次交易已经更新的索引
+type localCache struct {
+	dbm.KV
+	cache map[string][]byte
+}
+
+func newLocalCache(kv dbm.KV) *localCache {
+	return &localCache{KV: kv, cache: make(map[string][]byte)}
+}
+
+func (c *localCache) Get(key []byte) ([]byte, error) {
+	if value, ok := c.cache[string(key)]; ok {
+		if value == nil {
+			return nil, types.ErrNotFound
+		}
+		return value, nil
+	}
+	return c.KV.Get(key)
+}
+
+func (c *localCache) setKVs(kvs []*types.KeyValue) {
+	for _, kv := range kvs {
+		c.cache[string(kv.Key)] = kv.Value
+	}
+}
+
 // Set automatic rollback
 func (e *exchange) addAutoRollBack(tx *types.Transaction, kv []*types.KeyValue) *types.LocalDBSet {
 	dbSet := &types.LocalDBSet{}
@@ -117,6 +150,11 @@ func (e *exchange) updateIndex(marketTable, orderTable, historyTable *table.Tabl
 		if err != nil {
 			return nil
 		}
+		// 市价单未成交部分撤销之前可能已经部分成交
+		err = e.updateMatchOrders(marketTable, orderTable, historyTable, receipt.GetOrder(), receipt.GetMatchOrders(), receipt.GetIndex())
+		if err != nil {
+			return nil
+		}
 	}
 
 	return
@@ -159,6 +197,15 @@ func (e *exchange) updateOrder(marketTable, orderTable, historyTable *table.Tabl
 			elog.Error("updateIndex", "historyTable.Replace", err.Error())
 		}
 	case ety.Revoked:
+		// 市价单和IOC订单不会挂单, 撤销时不需要更新市场深度和挂单列表
+		if isImmediateOrder(order) {
+			order.Index = index
+			err := historyTable.Replace(order)
+			if err != nil {
+				elog.Error("updateIndex", "historyTable.Replace", err.Error())
+			}
+			return nil
+		}
 		var marketDepth ety.MarketDepth
 		depth, err := queryMarketDepth(marketTable, left, right, op, price)
 		if err == nil {
@@ -196,6 +243,22 @@ func (e *exchange) updateOrder(marketTable, orderTable, historyTable *table.Tabl
 	}
 	return nil
 }
+
+// updateStopOrder 触发列表中只保存未触发的条件委托
+func (e *exchange) updateStopOrder(stopTable *table.Table, order *ety.Order) {
+	if order.Status == ety.Ordered {
+		err := stopTable.Replace(order)
+		if err != nil {
+			elog.Error("updateIndex", "stopTable.Replace", err.Error())
+		}
+		return
+	}
+	err := stopTable.DelRow(order)
+	if err != nil {
+		elog.Error("updateIndex", "stopTable.DelRow", err.Error())
+	}
+}
+
 func (e *exchange) updateMatchOrders(marketTable, orderTable, historyTable *table.Table, order *ety.Order, matchOrders []*ety.Order, index int64) error {
 	left := order.GetLimitOrder().GetLeftAsset()
 	right := order.GetLimitOrder().GetRightAsset()
@@ -216,7 +279,7 @@ func (e *exchange) updateMatchOrders(marketTable, orderTable, historyTable *tabl
 				}
 				continue
 			}
-			if matchOrder.Status == ety.Completed {
+			if matchOrder.Status == ety.Completed || matchOrder.Status == ety.Expired {
 				err := orderTable.DelRow(matchOrder)
 				if err != nil {
 					elog.Error("updateIndex", "orderTable.DelRow", err.Error())
@@ -234,6 +297,10 @@ func (e *exchange) updateMatchOrders(marketTable, orderTable, historyTable *tabl
 			}
 			executed := cache[matchOrder.GetLimitOrder().Price]
 			executed = executed + matchOrder.Executed
+			// 过期订单的剩余数量全部从市场深度中扣除
+			if matchOrder.Status == ety.Expired {
+				executed = executed + matchOrder.Balance
+			}
 			cache[matchOrder.GetLimitOrder().Price] = executed
 		}
 
@@ -17,7 +17,11 @@ func (e *exchange) Query_QueryMarketDepth(in *et.QueryMarketDepth) (types.Messag
 	if !CheckOp(in.Op) {
 		return nil, et.ErrAssetOp
 	}
-	return QueryMarketDepth(e.GetLocalDB(), in.LeftAsset, in.RightAsset, in.Op, in.PrimaryKey, in.Count)
+	list, err := QueryMarketDepth(e.GetLocalDB(), in.LeftAsset, in.RightAsset, in.Op, in.PrimaryKey, in.Count)
+	if err != nil {
+		return nil, err
+	}
+	return excludeExpiredDepth(e.GetLocalDB(), list, in.LeftAsset, in.RightAsset, in.Op, e.GetHeight(), e.GetBlockTime())
 }
 
 //查询已经完成得订单
@@ -40,7 +44,12 @@ func (e *exchange) Query_QueryOrder(in *et.QueryOrder) (types.Message, error) {
 	if in.OrderID == 0 {
 		return nil, et.ErrOrderID
 	}
-	return findOrderByOrderID(e.GetStateDB(), e.GetLocalDB(), in.OrderID)
+	order, err := findOrderByOrderID(e.GetStateDB(), e.GetLocalDB(), in.OrderID)
+	if err != nil {
+		return nil, err
+	}
+	markExpired(order, e.GetHeight(), e.GetBlockTime())
+	return order, nil
 }
 
 //根据订单状态，查询订单信息（这里面包含所有交易对）
@@ -59,5 +68,51 @@ func (e *exchange) Query_QueryOrderList(in *et.QueryOrderList) (types.Message, e
 	if in.Address == "" {
 		return nil, et.ErrAddr
 	}
-	return QueryOrderList(e.GetLocalDB(), in.Address, in.Status, in.Count, in.Direction, in.PrimaryKey)
+	msg, err := QueryOrderList(e.GetLocalDB(), in.Address, in.Status, in.Count, in.Direction, in.PrimaryKey)
+	if err != nil {
+		return nil, err
+	}
+	for _, order := range msg.(*et.OrderList).List {
+		markExpired(order, e.GetHeight(), e.GetBlockTime())
+	}
+	return msg, nil
+}
+
+//根据地址查询未触发的条件委托
+func (e *exchange) Query_QueryStopOrderList(in *et.QueryOrderList) (types.Message, error) {
+	if !CheckCount(in.Count) {
+		return nil, et.ErrCount
+	}
+	if !CheckDirection(in.Direction) {
+		return nil, et.ErrDirection
+	}
+	if in.Address == "" {
+		return nil, et.ErrAddr
+	}
+	return QueryStopOrderList(e.GetLocalDB(), in.Address, in.Count, in.Direction, in.PrimaryKey)
+}
+
+//查询K线
+func (e *exchange) Query_QueryCandles(in *et.QueryCandles) (types.Message, error) {
+	if !CheckExchangeAsset(e.GetAPI().GetConfig().GetCoinExec(), in.LeftAsset, in.RightAsset) {
+		return nil, et.ErrAsset
+	}
+	if !CheckCandleInterval(in.Interval) {
+		return nil, et.ErrCandleInterval
+	}
+	if !CheckCount(in.Count) {
+		return nil, et.ErrCount
+	}
+	if !CheckDirection(in.Direction) {
+		return nil, et.ErrDirection
+	}
+	return QueryCandles(e.GetLocalDB(), in.LeftAsset, in.RightAsset, in.Interval, in.PrimaryKey, in.Count, in.Direction)
+}
+
+//查询最近24小时行情
+func (e *exchange) Query_QueryTicker(in *et.QueryTicker) (types.Message, error) {
+	if !CheckExchangeAsset(e.GetAPI().GetConfig().GetCoinExec(), in.LeftAsset, in.RightAsset) {
+		return nil, et.ErrAsset
+	}
+	return QueryTicker(e.GetLocalDB(), in.LeftAsset, in.RightAsset, e.GetBlockTime())
 }
@@ -0,0 +1,369 @@
+package executor
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/33cn/chain33/account"
+	dbm "github.com/33cn/chain33/common/db"
+	tab "github.com/33cn/chain33/common/db/table"
+	"github.com/33cn/chain33/types"
+	et "github.com/33cn/plugin/plugin/dapp/exchange/types"
+)
+
+/*
+ * 条件委托(止损/止盈)
+ * 下单时冻结资金并放入触发列表, 最新成交价格触及触发价格后, 在同一笔交易中转为限价单或者市价单撮合,
+ * 转换后的订单沿用条件委托的订单号, 撮合产生的新成交价格可能继续触发其它条件委托
+ */
+
+const (
+	//最新成交价格高于等于触发价格时触发
+	triggerRise = int32(1)
+	//最新成交价格低于等于触发价格时触发
+	triggerFall = int32(2)
+)
+
+//CheckStopKind ...
+func CheckStopKind(kind int32) bool {
+	return kind == et.StopLoss || kind == et.TakeProfit
+}
+
+// triggerDirection 卖单止损和买单止盈在价格下跌时触发, 卖单止盈和买单止损在价格上涨时触发
+func triggerDirection(stop *et.StopOrder) int32 {
+	if (stop.GetOp() == et.OpSell) == (stop.GetKind() == et.StopLoss) {
+		return triggerFall
+	}
+	return triggerRise
+}
+
+func isTriggered(stop *et.StopOrder, lastPrice int64) bool {
+	if triggerDirection(stop) == triggerRise {
+		return lastPrice >= stop.GetTriggerPrice()
+	}
+	return lastPrice <= stop.GetTriggerPrice()
+}
+
+// stopOrderFrozen 条件委托需要冻结的资金, 市价买单的成交金额无法预知, 触发时逐笔检查余额
+func stopOrderFrozen(stop *et.StopOrder, coinPrecision int64) int64 {
+	if stop.GetOp() == et.OpSell {
+		return stop.GetAmount()
+	}
+	return CalcActualCost(et.OpBuy, stop.GetAmount(), stop.GetPrice(), coinPrecision)
+}
+
+//StopOrder 条件委托
+func (a *Action) StopOrder(payload *et.StopOrder) (*types.Receipt, error) {
+	leftAsset := payload.GetLeftAsset()
+	rightAsset := payload.GetRightAsset()
+	cfg := a.api.GetConfig()
+	if !CheckExchangeAsset(cfg.GetCoinExec(), leftAsset, rightAsset) {
+		return nil, et.ErrAsset
+	}
+	if !CheckAmount(payload.GetAmount(), cfg.GetCoinPrecision()) {
+		return nil, et.ErrAssetAmount
+	}
+	if !CheckPrice(payload.GetTriggerPrice()) || (payload.GetPrice() != 0 && !CheckPrice(payload.GetPrice())) {
+		return nil, et.ErrAssetPrice
+	}
+	if !CheckOp(payload.GetOp()) {
+		return nil, et.ErrAssetOp
+	}
+	if !CheckStopKind(payload.GetKind()) {
+		return nil, et.ErrStopKind
+	}
+	lastPrice := getLastPrice(a.statedb, leftAsset, rightAsset)
+	if lastPrice != 0 && isTriggered(payload, lastPrice) {
+		elog.Error("StopOrder check trigger price", "addr", a.fromaddr, "triggerPrice", payload.GetTriggerPrice(), "lastPrice", lastPrice)
+		return nil, et.ErrTriggerPrice
+	}
+
+	asset := leftAsset
+	if payload.GetOp() == et.OpBuy {
+		asset = rightAsset
+	}
+	assetDB, err := account.NewAccountDB(cfg, asset.GetExecer(), asset.GetSymbol(), a.statedb)
+	if err != nil {
+		return nil, err
+	}
+	var logs []*types.ReceiptLog
+	var kvs []*types.KeyValue
+	amount := stopOrderFrozen(payload, cfg.GetCoinPrecision())
+	if amount > 0 {
+		acc := assetDB.LoadExecAccount(a.fromaddr, a.execaddr)
+		if acc.Balance < amount {
+			elog.Error("stop order check balance", "addr", a.fromaddr, "avail", acc.Balance, "need", amount)
+			return nil, et.ErrAssetBalance
+		}
+		receipt, err := assetDB.ExecFrozen(a.fromaddr, a.execaddr, amount)
+		if err != nil {
+			elog.Error("StopOrder.ExecFrozen", "addr", a.fromaddr, "amount", amount, "err", err.Error())
+			return nil, err
+		}
+		logs = append(logs, receipt.Logs...)
+		kvs = append(kvs, receipt.KV...)
+	}
+
+	order := &et.Order{
+		OrderID:    a.GetIndex(),
+		Value:      &et.Order_StopOrder{StopOrder: payload},
+		Ty:         et.TyStopOrderAction,
+		Balance:    payload.GetAmount(),
+		Status:     et.Ordered,
+		Addr:       a.fromaddr,
+		UpdateTime: a.blocktime,
+		Index:      a.GetIndex(),
+		Hash:       hex.EncodeToString(a.txhash),
+		CreateTime: a.blocktime,
+	}
+	kvs = append(kvs, a.GetKVSet(order)...)
+	re := &et.ReceiptExchange{
+		Order: order,
+		Index: a.GetIndex(),
+	}
+	logs = append(logs, &types.ReceiptLog{Ty: et.TyStopOrderLog, Log: types.Encode(re)})
+	return &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}, nil
+}
+
+// revokeStopOrder 撤销未触发的条件委托, 解冻下单时冻结的资金
+func (a *Action) revokeStopOrder(order *et.Order) (*types.Receipt, error) {
+	stop := order.GetStopOrder()
+	cfg := a.api.GetConfig()
+	asset := stop.GetLeftAsset()
+	if stop.GetOp() == et.OpBuy {
+		asset = stop.GetRightAsset()
+	}
+	var logs []*types.ReceiptLog
+	var kvs []*types.KeyValue
+	amount := stopOrderFrozen(stop, cfg.GetCoinPrecision())
+	if amount > 0 {
+		assetDB, err := account.NewAccountDB(cfg, asset.GetExecer(), asset.GetSymbol(), a.statedb)
+		if err != nil {
+			return nil, err
+		}
+		receipt, err := assetDB.ExecActive(order.Addr, a.execaddr, amount)
+		if err != nil {
+			elog.Error("revokeStopOrder.ExecActive", "addr", order.Addr, "amount", amount, "err", err.Error())
+			return nil, err
+		}
+		logs = append(logs, receipt.Logs...)
+		kvs = append(kvs, receipt.KV...)
+	}
+
+	order.Status = et.Revoked
+	order.UpdateTime = a.blocktime
+	order.RevokeHash = hex.EncodeToString(a.txhash)
+	kvs = append(kvs, a.GetKVSet(order)...)
+	re := &et.ReceiptExchange{
+		Order: order,
+		Index: a.GetIndex(),
+	}
+	logs = append(logs, &types.ReceiptLog{Ty: et.TyStopOrderLog, Log: types.Encode(re)})
+	return &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}, nil
+}
+
+// triggerStopOrders 本次交易产生成交后, 记录最新成交价格并触发满足条件的委托
+func (a *Action) triggerStopOrders(left, right *et.Asset, receipt *types.Receipt) (*types.Receipt, error) {
+	cfg := a.api.GetConfig()
+	if a.lastPrice == 0 || !cfg.IsDappFork(a.height, et.ExchangeX, et.ForkStopOrder) {
+		return receipt, nil
+	}
+	leftAccountDB, err := account.NewAccountDB(cfg, left.GetExecer(), left.GetSymbol(), a.statedb)
+	if err != nil {
+		return nil, err
+	}
+	rightAccountDB, err := account.NewAccountDB(cfg, right.GetExecer(), right.GetSymbol(), a.statedb)
+	if err != nil {
+		return nil, err
+	}
+	// 触发的订单从状态数据库中读取挂单信息, 需要先写入本次交易已经修改的订单
+	a.setKVs(receipt.KV)
+
+	var count int
+	for a.lastPrice != 0 && count < et.MaxTriggerCount {
+		lastPrice := a.lastPrice
+		a.lastPrice = 0
+		kv := &types.KeyValue{Key: calcLastPriceKey(left, right), Value: types.Encode(&types.Int64{Data: lastPrice})}
+		a.setKVs([]*types.KeyValue{kv})
+		receipt.KV = append(receipt.KV, kv)
+
+		orders, err := findTriggeredStopOrders(a.statedb, a.localDB, left, right, lastPrice, et.MaxTriggerCount-count)
+		if err != nil {
+			return nil, err
+		}
+		for _, order := range orders {
+			count++
+			// 每个触发的订单单独占用一段回执索引, 与撮合的挂单索引不重叠
+			r, err := a.triggerStopOrder(order, leftAccountDB, rightAccountDB, a.GetIndex()+int64(count*(et.MaxMatchCount+1)))
+			if err != nil {
+				return nil, err
+			}
+			a.setKVs(r.KV)
+			receipt.KV = append(receipt.KV, r.KV...)
+			receipt.Logs = append(receipt.Logs, r.Logs...)
+		}
+	}
+	return receipt, nil
+}
+
+// triggerStopOrder 解冻条件委托冻结的资金, 转为限价单或者市价单撮合
+func (a *Action) triggerStopOrder(order *et.Order, leftAccountDB, rightAccountDB *account.DB, index int64) (*types.Receipt, error) {
+	var logs []*types.ReceiptLog
+	var kvs []*types.KeyValue
+	stop := order.GetStopOrder()
+	cfg := a.api.GetConfig()
+	elog.Info("trigger stop order", "height", a.height, "orderID", order.OrderID, "triggerPrice", stop.GetTriggerPrice(), "index", index)
+
+	amount := stopOrderFrozen(stop, cfg.GetCoinPrecision())
+	if amount > 0 {
+		assetDB := leftAccountDB
+		if stop.GetOp() == et.OpBuy {
+			assetDB = rightAccountDB
+		}
+		receipt, err := assetDB.ExecActive(order.Addr, a.execaddr, amount)
+		if err != nil {
+			elog.Error("triggerStopOrder.ExecActive", "addr", order.Addr, "amount", amount, "err", err.Error())
+			return nil, err
+		}
+		logs = append(logs, receipt.Logs...)
+		kvs = append(kvs, receipt.KV...)
+	}
+	triggered := types.Clone(order).(*et.Order)
+	triggered.Status = et.Completed
+	triggered.UpdateTime = a.blocktime
+	logs = append(logs, &types.ReceiptLog{Ty: et.TyStopOrderLog, Log: types.Encode(&et.ReceiptExchange{Order: triggered, Index: index})})
+
+	price := stop.GetPrice()
+	orderTy := int32(et.TyLimitOrderAction)
+	if price == 0 {
+		orderTy = et.TyMarketOrderAction
+		price = MaxPrice
+		if stop.GetOp() == et.OpSell {
+			price = MinPrice
+		}
+	}
+	or := &et.Order{
+		OrderID: order.OrderID,
+		Value: &et.Order_LimitOrder{LimitOrder: &et.LimitOrder{
+			LeftAsset:  stop.GetLeftAsset(),
+			RightAsset: stop.GetRightAsset(),
+			Price:      price,
+			Amount:     stop.GetAmount(),
+			Op:         stop.GetOp(),
+		}},
+		Ty:          orderTy,
+		Balance:     stop.GetAmount(),
+		Status:      et.Ordered,
+		EntrustAddr: order.EntrustAddr,
+		Addr:        order.Addr,
+		UpdateTime:  a.blocktime,
+		Index:       index,
+		Hash:        order.Hash,
+		CreateTime:  order.CreateTime,
+	}
+	// 撮合以订单地址作为主动方
+	fromaddr := a.fromaddr
+	a.fromaddr = order.Addr
+	receipt, err := a.matchOrder(or, leftAccountDB, rightAccountDB)
+	a.fromaddr = fromaddr
+	if err != nil {
+		return nil, err
+	}
+	logs = append(logs, receipt.Logs...)
+	kvs = append(kvs, receipt.KV...)
+	return &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}, nil
+}
+
+func (a *Action) setKVs(kvs []*types.KeyValue) {
+	for _, kv := range kvs {
+		err := a.statedb.Set(kv.Key, kv.Value)
+		if err != nil {
+			elog.Error("setKVs", "key", string(kv.Key), "err", err.Error())
+		}
+	}
+}
+
+func getLastPrice(statedb dbm.KV, left, right *et.Asset) int64 {
+	data, err := statedb.Get(calcLastPriceKey(left, right))
+	if err != nil {
+		return 0
+	}
+	var price types.Int64
+	if err := types.Decode(data, &price); err != nil {
+		return 0
+	}
+	return price.Data
+}
+
+// findTriggeredStopOrders 按触发价格从最先触及的一端开始查找, 价格相同时先下单的先触发
+func findTriggeredStopOrders(statedb dbm.KV, localdb dbm.KV, left, right *et.Asset, lastPrice int64, count int) ([]*et.Order, error) {
+	var orders []*et.Order
+	table := NewStopOrderTable(localdb)
+	for _, direction := range []int32{triggerRise, triggerFall} {
+		prefix := []byte(fmt.Sprintf("%s:%s:%d:", left.GetSymbol(), right.GetSymbol(), direction))
+		listDirection := et.ListASC
+		if direction == triggerFall {
+			listDirection = et.ListDESC
+		}
+		var primaryKey []byte
+		for len(orders) < count {
+			rows, err := table.ListIndex("trigger", prefix, primaryKey, et.Count, listDirection)
+			if err == types.ErrNotFound {
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+			done := false
+			for _, row := range rows {
+				stop := row.Data.(*et.Order).GetStopOrder()
+				if !isTriggered(stop, lastPrice) {
+					done = true
+					break
+				}
+				// 本次交易中已经触发或撤销的委托以状态数据库为准
+				order, err := findOrderByOrderID(statedb, localdb, row.Data.(*et.Order).OrderID)
+				if err != nil || order.Status != et.Ordered || order.GetStopOrder() == nil {
+					continue
+				}
+				orders = append(orders, order)
+				if len(orders) >= count {
+					break
+				}
+			}
+			if done || len(rows) < int(et.Count) {
+				break
+			}
+			primaryKey = rows[len(rows)-1].Primary
+		}
+	}
+	return orders, nil
+}
+
+//QueryStopOrderList 查询地址下未触发的条件委托
+func QueryStopOrderList(localdb dbm.KV, addr string, count, direction int32, primaryKey string) (types.Message, error) {
+	table := NewStopOrderTable(localdb)
+	if count == 0 {
+		count = et.Count
+	}
+	prefix := []byte(fmt.Sprintf("%s:%d", addr, et.Ordered))
+	var rows []*tab.Row
+	var err error
+	if primaryKey == "" {
+		rows, err = table.ListIndex("addr_status", prefix, nil, count, direction)
+	} else {
+		rows, err = table.ListIndex("addr_status", prefix, []byte(primaryKey), count, direction)
+	}
+	if err != nil {
+		elog.Error("QueryStopOrderList.", "addr", addr, "err", err.Error())
+		return nil, err
+	}
+	var orderList et.OrderList
+	for _, row := range rows {
+		orderList.List = append(orderList.List, row.Data.(*et.Order))
+	}
+	if len(rows) == int(count) {
+		orderList.PrimaryKey = string(rows[len(rows)-1].Primary)
+	}
+	return &orderList, nil
+}
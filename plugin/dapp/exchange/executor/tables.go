@@ -28,6 +28,12 @@ func calcOrderKey(orderID int64) []byte {
 	return []byte(key)
 }
 
+//状态数据库中存储交易对最新成交价格, 用于判断条件委托是否触发
+func calcLastPriceKey(left, right *ety.Asset) []byte {
+	key := fmt.Sprintf("%s"+"lastPrice:%s:%s", KeyPrefixStateDB, left.GetSymbol(), right.GetSymbol())
+	return []byte(key)
+}
+
 var opt_exchange_depth = &table.Option{
 	Prefix:  KeyPrefixLocalDB,
 	Name:    "depth",
@@ -50,6 +56,22 @@ var opt_exchange_history = &table.Option{
 	Index:   []string{"name", "addr_status"},
 }
 
+//未触发的条件委托
+var opt_exchange_stop = &table.Option{
+	Prefix:  KeyPrefixLocalDB,
+	Name:    "stop",
+	Primary: "orderID",
+	Index:   []string{"trigger", "addr_status"},
+}
+
+//按交易对和周期统计的K线
+var opt_exchange_candle = &table.Option{
+	Prefix:  KeyPrefixLocalDB,
+	Name:    "candle",
+	Primary: "openTime",
+	Index:   nil,
+}
+
 //NewMarketDepthTable 新建表
 func NewMarketDepthTable(kvdb db.KV) *table.Table {
 	rowmeta := NewMarketDepthRow()
@@ -80,6 +102,26 @@ func NewHistoryOrderTable(kvdb db.KV) *table.Table {
 	return table
 }
 
+//NewStopOrderTable ...
+func NewStopOrderTable(kvdb db.KV) *table.Table {
+	rowmeta := NewStopOrderRow()
+	table, err := table.NewTable(rowmeta, kvdb, opt_exchange_stop)
+	if err != nil {
+		panic(err)
+	}
+	return table
+}
+
+//NewCandleTable ...
+func NewCandleTable(kvdb db.KV) *table.Table {
+	rowmeta := NewCandleRow()
+	table, err := table.NewTable(rowmeta, kvdb, opt_exchange_candle)
+	if err != nil {
+		panic(err)
+	}
+	return table
+}
+
 //OrderRow table meta 结构
 type OrderRow struct {
 	*ety.Order
@@ -183,3 +225,72 @@ func (m *MarketDepthRow) Get(key string) ([]byte, error) {
 	}
 	return nil, types.ErrNotFound
 }
+
+//StopOrderRow table meta 结构
+type StopOrderRow struct {
+	*ety.Order
+}
+
+//NewStopOrderRow ...
+func NewStopOrderRow() *StopOrderRow {
+	return &StopOrderRow{Order: &ety.Order{Value: &ety.Order_StopOrder{StopOrder: &ety.StopOrder{}}}}
+}
+
+//CreateRow ...
+func (m *StopOrderRow) CreateRow() *table.Row {
+	return &table.Row{Data: &ety.Order{Value: &ety.Order_StopOrder{StopOrder: &ety.StopOrder{}}}}
+}
+
+//SetPayload 设置数据
+func (m *StopOrderRow) SetPayload(data types.Message) error {
+	if txdata, ok := data.(*ety.Order); ok {
+		m.Order = txdata
+		return nil
+	}
+	return types.ErrTypeAsset
+}
+
+//Get 按照indexName 查询 indexValue
+func (m *StopOrderRow) Get(key string) ([]byte, error) {
+	if key == "orderID" {
+		return []byte(fmt.Sprintf("%022d", m.OrderID)), nil
+	} else if key == "trigger" {
+		stop := m.GetStopOrder()
+		return []byte(fmt.Sprintf("%s:%s:%d:%016d", stop.GetLeftAsset().GetSymbol(), stop.GetRightAsset().GetSymbol(), triggerDirection(stop), stop.GetTriggerPrice())), nil
+	} else if key == "addr_status" {
+		return []byte(fmt.Sprintf("%s:%d", m.Addr, m.Status)), nil
+	}
+	return nil, types.ErrNotFound
+}
+
+//CandleRow table meta 结构
+type CandleRow struct {
+	*ety.Candle
+}
+
+//NewCandleRow ...
+func NewCandleRow() *CandleRow {
+	return &CandleRow{Candle: &ety.Candle{}}
+}
+
+//CreateRow ...
+func (m *CandleRow) CreateRow() *table.Row {
+	return &table.Row{Data: &ety.Candle{}}
+}
+
+//SetPayload 设置数据
+func (m *CandleRow) SetPayload(data types.Message) error {
+	if txdata, ok := data.(*ety.Candle); ok {
+		m.Candle = txdata
+		return nil
+	}
+	return types.ErrTypeAsset
+}
+
+//Get 按照indexName 查询 indexValue
+func (m *CandleRow) Get(key string) ([]byte, error) {
+	if key == "openTime" {
+		return calcCandleKey(m.LeftAsset, m.RightAsset, m.Interval, m.OpenTime), nil
+	}
+	return nil, types.ErrNotFound
+}
@@ -0,0 +1,106 @@
+package executor
+
+import (
+	"github.com/33cn/chain33/account"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	et "github.com/33cn/plugin/plugin/dapp/exchange/types"
+)
+
+//CheckTimeInForce 只有GTT和PostOnly订单可以设置过期高度或者时间, GTT订单必须设置其中之一
+func CheckTimeInForce(order *et.LimitOrder) bool {
+	if order.GetExpireHeight() < 0 || order.GetExpireTime() < 0 {
+		return false
+	}
+	hasExpiration := order.GetExpireHeight() > 0 || order.GetExpireTime() > 0
+	switch order.GetTimeInForce() {
+	case et.GTC, et.IOC, et.FOK:
+		return !hasExpiration
+	case et.PostOnly:
+		return true
+	case et.GTT:
+		return hasExpiration
+	}
+	return false
+}
+
+// hasTimeInForce 是否使用了分叉之后才支持的有效方式
+func hasTimeInForce(order *et.LimitOrder) bool {
+	return order.GetTimeInForce() != et.GTC || order.GetExpireHeight() != 0 || order.GetExpireTime() != 0
+}
+
+// isExpired 超过过期高度或者过期时间的订单失效
+func isExpired(order *et.LimitOrder, height, blocktime int64) bool {
+	if order.GetExpireHeight() > 0 && height > order.GetExpireHeight() {
+		return true
+	}
+	return order.GetExpireTime() > 0 && blocktime > order.GetExpireTime()
+}
+
+// markExpired 过期的挂单在撮合时才会被清理, 查询时按当前高度和时间标记为过期
+func markExpired(order *et.Order, height, blocktime int64) {
+	if order.Status == et.Ordered && order.GetLimitOrder() != nil && isExpired(order.GetLimitOrder(), height, blocktime) {
+		order.Status = et.Expired
+	}
+}
+
+// excludeExpiredDepth 从市场深度中扣除尚未被撮合清理的过期挂单, 扣除后数量为0的价格不再返回
+func excludeExpiredDepth(localdb dbm.KV, list *et.MarketDepthList, left, right *et.Asset, op int32, height, blocktime int64) (*et.MarketDepthList, error) {
+	var depths []*et.MarketDepth
+	for _, depth := range list.List {
+		var primaryKey string
+		for {
+			orderList, err := findOrderIDListByPrice(localdb, left, right, depth.Price, op, et.ListASC, primaryKey)
+			if err == types.ErrNotFound {
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+			for _, order := range orderList.List {
+				if isExpired(order.GetLimitOrder(), height, blocktime) {
+					depth.Amount -= order.Balance
+				}
+			}
+			if orderList.PrimaryKey == "" {
+				break
+			}
+			primaryKey = orderList.PrimaryKey
+		}
+		if depth.Amount > 0 {
+			depths = append(depths, depth)
+		}
+	}
+	list.List = depths
+	if len(list.List) == 0 && list.PrimaryKey == "" {
+		return nil, types.ErrNotFound
+	}
+	return list, nil
+}
+
+// isImmediateOrder 市价单和IOC订单不会挂单, 未成交部分直接撤销
+func isImmediateOrder(order *et.Order) bool {
+	return order.Ty == et.TyMarketOrderAction || order.GetLimitOrder().GetTimeInForce() == et.IOC
+}
+
+// expireOrder 撮合时遇到已经过期的挂单, 解冻剩余资金并标记为过期, 由本地执行从市场深度和挂单列表中删除
+func (a *Action) expireOrder(leftAccountDB, rightAccountDB *account.DB, order *et.Order) ([]*types.ReceiptLog, []*types.KeyValue, error) {
+	cfg := a.api.GetConfig()
+	limitOrder := order.GetLimitOrder()
+	amount := CalcActualCost(limitOrder.GetOp(), order.GetBalance(), limitOrder.GetPrice(), cfg.GetCoinPrecision())
+	accountDB := leftAccountDB
+	if limitOrder.GetOp() == et.OpBuy {
+		accountDB = rightAccountDB
+	}
+	receipt, err := accountDB.ExecActive(order.Addr, a.execaddr, amount)
+	if err != nil {
+		elog.Error("expireOrder.ExecActive", "addr", order.Addr, "orderID", order.OrderID, "amount", amount, "err", err.Error())
+		return nil, nil, err
+	}
+	order.Status = et.Expired
+	order.UpdateTime = a.blocktime
+	// 过期订单本次没有成交, 本地执行按剩余数量扣减市场深度
+	order.Executed = 0
+	kvs := append(receipt.KV, a.GetKVSet(order)...)
+	return receipt.Logs, kvs, nil
+}
@@ -13,9 +13,19 @@ var (
 	ErrAsset        = fmt.Errorf("%s", "The asset's execer or symbol can't be nil,The same assets cannot be exchanged!")
 	ErrCount        = fmt.Errorf("%s", "The param count can't large  20")
 	ErrDirection    = fmt.Errorf("%s", "The direction only 0 or 1!")
-	ErrStatus       = fmt.Errorf("%s", "The status only in  0 , 1, 2!")
+	ErrStatus       = fmt.Errorf("%s", "The status only in  0 , 1, 2, 3!")
 	ErrOrderID      = fmt.Errorf("%s", "Wrong OrderID!")
 
 	ErrCfgFmt   = fmt.Errorf("%s", "ErrCfgFmt")
 	ErrBindAddr = fmt.Errorf("%s", "The address is not bound")
+
+	ErrStopKind     = fmt.Errorf("%s", "The stop order kind only 1 or 2!")
+	ErrTriggerPrice = fmt.Errorf("%s", "The trigger price has been reached!")
+
+	ErrTimeInForce  = fmt.Errorf("%s", "The time in force or expiration is not valid!")
+	ErrOrderExpired = fmt.Errorf("%s", "The order has expired!")
+	ErrFillOrKill   = fmt.Errorf("%s", "The fill or kill order can not be filled entirely!")
+	ErrPostOnly     = fmt.Errorf("%s", "The post only order would be matched immediately!")
+
+	ErrCandleInterval = fmt.Errorf("%s", "The candle interval only in 60, 3600, 86400!")
 )
@@ -22,6 +22,7 @@ const (
 	TyExchangeBindAction
 	TyEntrustOrderAction
 	TyEntrustRevokeOrderAction
+	TyStopOrderAction
 
 	NameLimitOrderAction         = "LimitOrder"
 	NameMarketOrderAction        = "MarketOrder"
@@ -29,11 +30,15 @@ const (
 	NameExchangeBindAction       = "ExchangeBind"
 	NameEntrustOrderAction       = "EntrustOrder"
 	NameEntrustRevokeOrderAction = "EntrustRevokeOrder"
+	NameStopOrderAction          = "StopOrder"
 
 	FuncNameQueryMarketDepth      = "QueryMarketDepth"
 	FuncNameQueryHistoryOrderList = "QueryHistoryOrderList"
 	FuncNameQueryOrder            = "QueryOrder"
 	FuncNameQueryOrderList        = "QueryOrderList"
+	FuncNameQueryStopOrderList    = "QueryStopOrderList"
+	FuncNameQueryCandles          = "QueryCandles"
+	FuncNameQueryTicker           = "QueryTicker"
 )
 
 // log类型id值
@@ -44,6 +49,7 @@ const (
 	TyRevokeOrderLog
 
 	TyExchangeBindLog
+	TyStopOrderLog
 )
 
 // OP
@@ -52,11 +58,47 @@ const (
 	OpSell
 )
 
+//stop order kind
+const (
+	//StopLoss 止损
+	StopLoss = iota + 1
+	//TakeProfit 止盈
+	TakeProfit
+)
+
+//time in force
+const (
+	//GTC 一直有效直到撤单
+	GTC = iota
+	//IOC 立即成交, 未成交部分撤销
+	IOC
+	//FOK 全部成交, 否则交易失败
+	FOK
+	//PostOnly 只做挂单方, 会立即成交时交易失败
+	PostOnly
+	//GTT 到达过期高度或者时间后失效
+	GTT
+)
+
+//candle interval, 按区块时间统计
+const (
+	//CandleMinute 1分钟K线
+	CandleMinute = 60
+	//CandleHour 1小时K线
+	CandleHour = 3600
+	//CandleDay 1天K线
+	CandleDay = 86400
+)
+
+//CandleIntervals 本地执行时需要统计的K线周期
+var CandleIntervals = []int32{CandleMinute, CandleHour, CandleDay}
+
 //order status
 const (
 	Ordered = iota
 	Completed
 	Revoked
+	Expired
 )
 
 //const
@@ -71,6 +113,8 @@ const (
 	Count = int32(10)
 	//MaxMatchCount 系统最大撮合深度
 	MaxMatchCount = 100
+	//MaxTriggerCount 单笔交易最多触发的条件委托数量
+	MaxTriggerCount = 20
 )
 
 var (
@@ -84,6 +128,7 @@ var (
 		NameExchangeBindAction:       TyExchangeBindAction,
 		NameEntrustOrderAction:       TyEntrustOrderAction,
 		NameEntrustRevokeOrderAction: TyEntrustRevokeOrderAction,
+		NameStopOrderAction:          TyStopOrderAction,
 	}
 	//定义log的id和具体log类型及名称，填入具体自定义log类型
 	logMap = map[int64]*types.LogInfo{
@@ -91,6 +136,7 @@ var (
 		TyMarketOrderLog:  {Ty: reflect.TypeOf(ReceiptExchange{}), Name: "TyMarketOrderLog"},
 		TyRevokeOrderLog:  {Ty: reflect.TypeOf(ReceiptExchange{}), Name: "TyRevokeOrderLog"},
 		TyExchangeBindLog: {Ty: reflect.TypeOf(ReceiptExchangeBind{}), Name: "TyExchangeBindLog"},
+		TyStopOrderLog:    {Ty: reflect.TypeOf(ReceiptExchange{}), Name: "TyStopOrderLog"},
 	}
 	//tlog = log.New("module", "exchange.types")
 
@@ -106,6 +152,12 @@ var (
 	ForkParamV7 = "ForkParamV7"
 	ForkParamV8 = "ForkParamV8"
 	ForkParamV9 = "ForkParamV9"
+	// ForkMarketOrder 开启市价单
+	ForkMarketOrder = "ForkMarketOrder"
+	// ForkStopOrder 开启条件委托
+	ForkStopOrder = "ForkStopOrder"
+	// ForkTimeInForce 开启订单有效方式
+	ForkTimeInForce = "ForkTimeInForce"
 )
 
 // init defines a register function
@@ -129,6 +181,9 @@ func InitFork(cfg *types.Chain33Config) {
 	cfg.RegisterDappFork(ExchangeX, ForkParamV7, 0)
 	cfg.RegisterDappFork(ExchangeX, ForkParamV8, 0)
 	cfg.RegisterDappFork(ExchangeX, ForkParamV9, 0)
+	cfg.RegisterDappFork(ExchangeX, ForkMarketOrder, 0)
+	cfg.RegisterDappFork(ExchangeX, ForkStopOrder, 0)
+	cfg.RegisterDappFork(ExchangeX, ForkTimeInForce, 0)
 }
 
 // InitExecutor defines register executor
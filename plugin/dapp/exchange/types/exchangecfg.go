@@ -291,6 +291,9 @@ ForkParamV6 = 0
 ForkParamV7 = 0
 ForkParamV8 = 0
 ForkParamV9 = 0
+ForkMarketOrder = 0
+ForkStopOrder = 0
+ForkTimeInForce = 0
 
 `
 
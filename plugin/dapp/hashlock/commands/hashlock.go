@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/33cn/chain33/common"
 	cmdtypes "github.com/33cn/chain33/system/dapp/commands/types"
 	"github.com/pkg/errors"
 
@@ -31,6 +32,8 @@ func HashlockCmd() *cobra.Command {
 		HashlockLockCmd(),
 		HashlockUnlockCmd(),
 		HashlockSendCmd(),
+		HashlockRefundCmd(),
+		HashlockQueryCmd(),
 	)
 
 	return cmd
@@ -58,6 +61,9 @@ func addHashlockLockCmdFlags(cmd *cobra.Command) {
 	cmd.MarkFlagRequired("to")
 	cmd.Flags().StringP("return", "r", "", "return address")
 	cmd.MarkFlagRequired("return")
+	cmd.Flags().StringP("exec", "e", "", "asset executor, such as token, default coins")
+	cmd.Flags().StringP("symbol", "y", "", "asset symbol, required with exec")
+	cmd.Flags().Int64P("refund_height", "g", 0, "block height after which return address can refund, 0 means use delay")
 
 	cmd.Flags().Float64P("fee", "f", 0.0, "transaction fee")
 }
@@ -69,6 +75,9 @@ func hashlockLockCmd(cmd *cobra.Command, args []string) {
 	returnAddr, _ := cmd.Flags().GetString("return")
 	delay, _ := cmd.Flags().GetInt64("delay")
 	amount, _ := cmd.Flags().GetFloat64("amount")
+	assetExec, _ := cmd.Flags().GetString("exec")
+	assetSymbol, _ := cmd.Flags().GetString("symbol")
+	refundHeight, _ := cmd.Flags().GetInt64("refund_height")
 
 	cfg, err := cmdtypes.GetChainConfig(rpcLaddr)
 	if err != nil {
@@ -102,6 +111,10 @@ func hashlockLockCmd(cmd *cobra.Command, args []string) {
 		ToAddr:     toAddr,
 		ReturnAddr: returnAddr,
 		Fee:        feeInt64,
+
+		AssetExec:    assetExec,
+		AssetSymbol:  assetSymbol,
+		RefundHeight: refundHeight,
 	}
 
 	payLoad, err := json.Marshal(params)
@@ -221,3 +234,113 @@ func hashlockSendCmd(cmd *cobra.Command, args []string) {
 	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.CreateTransaction", paramWithExecAction, nil)
 	ctx.RunWithoutMarshal()
 }
+
+// HashlockRefundCmd construct refund tx
+func HashlockRefundCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "refund",
+		Short: "Create hashlock refund transaction by hash after timeout",
+		Run:   hashlockRefundCmd,
+	}
+	addHashlockRefundCmdFlags(cmd)
+	return cmd
+}
+
+func addHashlockRefundCmdFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("hash", "s", "", "hashlock hash, hex string of sha256(secret)")
+	cmd.MarkFlagRequired("hash")
+
+	cmd.Flags().Float64P("fee", "f", 0.0, "transaction fee")
+}
+
+func hashlockRefundCmd(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	hash, _ := cmd.Flags().GetString("hash")
+
+	cfg, err := cmdtypes.GetChainConfig(rpcLaddr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, errors.Wrapf(err, "GetChainConfig"))
+		return
+	}
+
+	fee, _ := cmd.Flags().GetFloat64("fee")
+	feeInt64, err := types.FormatFloatDisplay2Value(fee, cfg.CoinPrecision)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, errors.Wrapf(err, "FormatFloatDisplay2Value.fee"))
+		return
+	}
+	if feeInt64 < cfg.MinTxFeeRate {
+		feeInt64 = cfg.MinTxFeeRate
+	}
+	params := pty.HashlockRefundTx{
+		Hash: hash,
+		Fee:  feeInt64,
+	}
+	payLoad, err := json.Marshal(params)
+	if err != nil {
+		return
+	}
+
+	paramWithExecAction := rpctypes.CreateTxIn{
+		Execer:     "hashlock",
+		ActionName: "HashlockRefund",
+		Payload:    payLoad,
+	}
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.CreateTransaction", paramWithExecAction, nil)
+	ctx.RunWithoutMarshal()
+}
+
+// HashlockQueryCmd query hashlock by hash or address
+func HashlockQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query",
+		Short: "Query hashlock by hash or by address",
+		Run:   hashlockQueryCmd,
+	}
+	addHashlockQueryCmdFlags(cmd)
+	return cmd
+}
+
+func addHashlockQueryCmdFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("hash", "s", "", "hashlock hash, hex string of sha256(secret)")
+	cmd.Flags().StringP("addr", "a", "", "address of return or to")
+	cmd.Flags().Int32P("role", "r", 0, "address role, 0: return address, 1: to address")
+	cmd.Flags().StringP("primary", "p", "", "primary key of last page, hex hash")
+	cmd.Flags().Int32P("count", "c", 20, "count of hashlocks per page")
+	cmd.Flags().Int32P("direction", "d", 0, "query direction, 0: desc, 1: asc")
+}
+
+func hashlockQueryCmd(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	hash, _ := cmd.Flags().GetString("hash")
+	addr, _ := cmd.Flags().GetString("addr")
+	role, _ := cmd.Flags().GetInt32("role")
+	primary, _ := cmd.Flags().GetString("primary")
+	count, _ := cmd.Flags().GetInt32("count")
+	direction, _ := cmd.Flags().GetInt32("direction")
+
+	var params rpctypes.Query4Jrpc
+	params.Execer = pty.HashlockX
+	if hash != "" {
+		h, err := common.FromHex(hash)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, errors.Wrapf(err, "FromHex.hash"))
+			return
+		}
+		params.FuncName = "GetHashlockByHash"
+		params.Payload = types.MustPBToJSON(&pty.ReqHashlockByHash{Hash: h})
+		var res pty.Hashlock
+		ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+		ctx.Run()
+		return
+	}
+	if addr == "" {
+		fmt.Fprintln(os.Stderr, "Error: requires hash or addr")
+		return
+	}
+	params.FuncName = "GetHashlocksByAddr"
+	params.Payload = types.MustPBToJSON(&pty.ReqHashlockByAddr{Addr: addr, Role: role, PrimaryKey: primary, Count: count, Direction: direction})
+	var res pty.ReplyHashlocks
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.Run()
+}
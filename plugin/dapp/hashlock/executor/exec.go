@@ -31,7 +31,24 @@ func (h *Hashlock) Exec_Hlock(hlock *pty.HashlockLock, tx *types.Transaction, in
 		return nil, pty.ErrHashlockReturnAddrss
 	}
 
-	if hlock.Time <= minLockTime {
+	cfg := h.GetAPI().GetConfig()
+	if hlock.AssetExec != "" || hlock.AssetSymbol != "" || hlock.RefundHeight != 0 {
+		if !cfg.IsDappFork(h.GetHeight(), pty.HashlockX, pty.ForkHashlockAssetX) {
+			return nil, types.ErrNotAllow
+		}
+	}
+	if (hlock.AssetExec == "") != (hlock.AssetSymbol == "") {
+		clog.Warn("hashlock asset exec and symbol must be set together")
+		return nil, pty.ErrHashlockAsset
+	}
+
+	if hlock.RefundHeight != 0 {
+		//按高度锁定时不再检查锁定时间
+		if hlock.RefundHeight <= h.GetHeight() {
+			clog.Warn("exec hashlock refund height must be in future")
+			return nil, pty.ErrHashlockHeight
+		}
+	} else if hlock.Time <= minLockTime {
 		clog.Warn("exec hashlock time not enough")
 		return nil, pty.ErrHashlockTime
 	}
@@ -54,3 +71,15 @@ func (h *Hashlock) Exec_Hunlock(transfer *pty.HashlockUnlock, tx *types.Transact
 	actiondb := NewAction(h, tx, drivers.ExecAddress(string(tx.Execer)))
 	return actiondb.Hashlockunlock(transfer)
 }
+
+// Exec_Hrefund Action
+func (h *Hashlock) Exec_Hrefund(refund *pty.HashlockRefund, tx *types.Transaction, index int) (*types.Receipt, error) {
+	//refund 有两个条件：1. 达到退回高度或时间已经过期 2. 发起者凭hash退回原来的账户
+	clog.Debug("hashlockrefund action")
+	cfg := h.GetAPI().GetConfig()
+	if !cfg.IsDappFork(h.GetHeight(), pty.HashlockX, pty.ForkHashlockAssetX) {
+		return nil, types.ErrActionNotSupport
+	}
+	actiondb := NewAction(h, tx, drivers.ExecAddress(string(tx.Execer)))
+	return actiondb.Hashlockrefund(refund)
+}
@@ -17,7 +17,7 @@ func (h *Hashlock) ExecDelLocal_Hlock(hlock *pty.HashlockLock, tx *types.Transac
 	if err != nil {
 		return nil, err
 	}
-	return &types.LocalDBSet{KV: []*types.KeyValue{kv}}, nil
+	return h.execDelLocal(tx, receipt, kv)
 }
 
 // ExecDelLocal_Hsend Action
@@ -27,7 +27,7 @@ func (h *Hashlock) ExecDelLocal_Hsend(hsend *pty.HashlockSend, tx *types.Transac
 	if err != nil {
 		return nil, err
 	}
-	return &types.LocalDBSet{KV: []*types.KeyValue{kv}}, nil
+	return h.execDelLocal(tx, receipt, kv)
 }
 
 // ExecDelLocal_Hunlock Action
@@ -37,5 +37,31 @@ func (h *Hashlock) ExecDelLocal_Hunlock(hunlock *pty.HashlockUnlock, tx *types.T
 	if err != nil {
 		return nil, err
 	}
-	return &types.LocalDBSet{KV: []*types.KeyValue{kv}}, nil
+	return h.execDelLocal(tx, receipt, kv)
+}
+
+// ExecDelLocal_Hrefund Action
+func (h *Hashlock) ExecDelLocal_Hrefund(hrefund *pty.HashlockRefund, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	info := pty.Hashlockquery{Time: 0, Status: hashlockUnlocked, Amount: 0, CreateTime: 0, CurrentTime: 0}
+	kv, err := UpdateHashReciver(h.GetLocalDB(), hrefund.Hash, info)
+	if err != nil {
+		return nil, err
+	}
+	return h.execDelLocal(tx, receipt, kv)
+}
+
+func (h *Hashlock) execDelLocal(tx *types.Transaction, receipt *types.ReceiptData, kv *types.KeyValue) (*types.LocalDBSet, error) {
+	set := &types.LocalDBSet{KV: []*types.KeyValue{kv}}
+	for _, item := range receipt.Logs {
+		//只有分叉后产生日志的交易才保存了表的回滚数据
+		if item.Ty >= pty.TyLogHashlockLock && item.Ty <= pty.TyLogHashlockRefund {
+			kvs, err := h.DelRollbackKV(tx, tx.Execer)
+			if err != nil {
+				return nil, err
+			}
+			set.KV = append(set.KV, kvs...)
+			break
+		}
+	}
+	return set, nil
 }
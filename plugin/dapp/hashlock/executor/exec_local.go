@@ -6,6 +6,7 @@ package executor
 
 import (
 	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/db/table"
 	"github.com/33cn/chain33/types"
 	pty "github.com/33cn/plugin/plugin/dapp/hashlock/types"
 )
@@ -18,7 +19,7 @@ func (h *Hashlock) ExecLocal_Hlock(hlock *pty.HashlockLock, tx *types.Transactio
 	if err != nil {
 		return nil, err
 	}
-	return &types.LocalDBSet{KV: []*types.KeyValue{kv}}, nil
+	return h.execLocal(tx, receipt, kv)
 }
 
 // ExecLocal_Hsend Action
@@ -29,7 +30,7 @@ func (h *Hashlock) ExecLocal_Hsend(hsend *pty.HashlockSend, tx *types.Transactio
 	if err != nil {
 		return nil, err
 	}
-	return &types.LocalDBSet{KV: []*types.KeyValue{kv}}, nil
+	return h.execLocal(tx, receipt, kv)
 }
 
 // ExecLocal_Hunlock Action
@@ -40,5 +41,45 @@ func (h *Hashlock) ExecLocal_Hunlock(hunlock *pty.HashlockUnlock, tx *types.Tran
 	if err != nil {
 		return nil, err
 	}
-	return &types.LocalDBSet{KV: []*types.KeyValue{kv}}, nil
+	return h.execLocal(tx, receipt, kv)
+}
+
+// ExecLocal_Hrefund Action
+func (h *Hashlock) ExecLocal_Hrefund(hrefund *pty.HashlockRefund, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	info := pty.Hashlockquery{Time: 0, Status: hashlockUnlocked, Amount: 0, CreateTime: 0, CurrentTime: 0}
+	kv, err := UpdateHashReciver(h.GetLocalDB(), hrefund.Hash, info)
+	if err != nil {
+		return nil, err
+	}
+	return h.execLocal(tx, receipt, kv)
+}
+
+// execLocal 根据回执日志更新按地址查询的表, 表数据支持自动回滚
+func (h *Hashlock) execLocal(tx *types.Transaction, receipt *types.ReceiptData, kv *types.KeyValue) (*types.LocalDBSet, error) {
+	set := &types.LocalDBSet{KV: []*types.KeyValue{kv}}
+	var tab *table.Table
+	for _, item := range receipt.Logs {
+		if item.Ty < pty.TyLogHashlockLock || item.Ty > pty.TyLogHashlockRefund {
+			continue
+		}
+		var hash pty.Hashlock
+		if err := types.Decode(item.Log, &hash); err != nil {
+			return nil, err
+		}
+		if tab == nil {
+			tab = pty.NewTable(h.GetLocalDB())
+		}
+		if err := tab.Replace(&hash); err != nil {
+			return nil, err
+		}
+	}
+	if tab == nil {
+		return set, nil
+	}
+	kvs, err := tab.Save()
+	if err != nil {
+		return nil, err
+	}
+	set.KV = append(set.KV, h.AddRollbackKV(tx, tx.Execer, kvs)...)
+	return set, nil
 }
@@ -12,6 +12,7 @@ import (
 
 	"math/rand"
 
+	"github.com/33cn/chain33/account"
 	"github.com/33cn/chain33/client"
 	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/common/address"
@@ -21,6 +22,7 @@ import (
 	"github.com/33cn/chain33/types"
 	"github.com/33cn/chain33/util"
 	pty "github.com/33cn/plugin/plugin/dapp/hashlock/types"
+	"github.com/stretchr/testify/require"
 )
 
 var (
@@ -112,6 +114,93 @@ func TestExecHashsend(t *testing.T) {
 	}
 }
 
+func TestExecHashlockAssetRefund(t *testing.T) {
+	api := hashlock.(*Hashlock).GetAPI()
+	cfg := api.GetConfig()
+	h := newHashlock()
+	h.SetAPI(api)
+	_, _, kvdb := util.CreateTestDB()
+	h.SetStateDB(kvdb)
+	_, _, localdb := util.CreateTestDB()
+	h.SetLocalDB(localdb)
+	h.SetEnv(5, types.Now().Unix(), 1)
+
+	tokenAcc, err := account.NewAccountDB(cfg, "token", "TEST", kvdb)
+	require.Nil(t, err)
+	tokenAcc.SaveExecAccount(addrexec, &types.Account{Addr: returnAddr, Balance: 100})
+
+	htlcSecret := []byte("htlc-secret")
+	hash := common.Sha256(htlcSecret)
+	exec := func(tx *types.Transaction) error {
+		receipt, err := h.Exec(tx, 0)
+		if err != nil {
+			return err
+		}
+		for _, kv := range receipt.KV {
+			kvdb.Set(kv.Key, kv.Value)
+		}
+		set, err := h.ExecLocal(tx, &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}, 0)
+		require.Nil(t, err)
+		for _, kv := range set.KV {
+			localdb.Set(kv.Key, kv.Value)
+		}
+		return nil
+	}
+
+	lock := &pty.HashlockLock{Amount: 60, Hash: hash, ToAddress: toAddr, ReturnAddress: returnAddr, AssetExec: "token"}
+	require.Equal(t, pty.ErrHashlockAsset, exec(constructActionTx(&pty.HashlockAction_Hlock{Hlock: lock}, pty.HashlockActionLock, returnPriv)))
+	lock.AssetSymbol = "TEST"
+	lock.RefundHeight = 5
+	require.Equal(t, pty.ErrHashlockHeight, exec(constructActionTx(&pty.HashlockAction_Hlock{Hlock: lock}, pty.HashlockActionLock, returnPriv)))
+	lock.RefundHeight = 10
+	require.Nil(t, exec(constructActionTx(&pty.HashlockAction_Hlock{Hlock: lock}, pty.HashlockActionLock, returnPriv)))
+	acc := tokenAcc.LoadExecAccount(returnAddr, addrexec)
+	require.Equal(t, int64(40), acc.Balance)
+	require.Equal(t, int64(60), acc.Frozen)
+
+	//未达到退回高度不能退回, 达到后接收方不能提取
+	refund := &pty.HashlockAction_Hrefund{Hrefund: &pty.HashlockRefund{Hash: hash}}
+	require.Equal(t, pty.ErrHashlockHeight, exec(constructActionTx(refund, pty.HashlockActionRefund, returnPriv)))
+	h.SetEnv(10, types.Now().Unix(), 1)
+	send := &pty.HashlockAction_Hsend{Hsend: &pty.HashlockSend{Secret: htlcSecret}}
+	require.Equal(t, pty.ErrHashlockHeight, exec(constructActionTx(send, pty.HashlockActionSend, toPriv)))
+	require.Equal(t, pty.ErrHashlockReturnAddrss, exec(constructActionTx(refund, pty.HashlockActionRefund, toPriv)))
+	require.Nil(t, exec(constructActionTx(refund, pty.HashlockActionRefund, returnPriv)))
+	acc = tokenAcc.LoadExecAccount(returnAddr, addrexec)
+	require.Equal(t, int64(100), acc.Balance)
+	require.Equal(t, int64(0), acc.Frozen)
+
+	msg, err := h.Query("GetHashlockByHash", types.Encode(&pty.ReqHashlockByHash{Hash: hash}))
+	require.Nil(t, err)
+	info := msg.(*pty.Hashlock)
+	require.Equal(t, int32(hashlockUnlocked), info.Status)
+	require.Equal(t, "TEST", info.AssetSymbol)
+
+	msg, err = h.Query("GetHashlocksByAddr", types.Encode(&pty.ReqHashlockByAddr{Addr: toAddr, Role: pty.RoleTo}))
+	require.Nil(t, err)
+	reply := msg.(*pty.ReplyHashlocks)
+	require.Equal(t, 1, len(reply.Hashlocks))
+	require.Equal(t, int32(hashlockUnlocked), reply.Hashlocks[0].Status)
+	_, err = h.Query("GetHashlocksByAddr", types.Encode(&pty.ReqHashlockByAddr{Addr: toAddr, Role: pty.RoleReturn}))
+	require.Equal(t, types.ErrNotFound, err)
+}
+
+func constructActionTx(value interface{}, ty int32, priv crypto.PrivKey) *types.Transaction {
+	action := &pty.HashlockAction{Ty: ty}
+	switch v := value.(type) {
+	case *pty.HashlockAction_Hlock:
+		action.Value = v
+	case *pty.HashlockAction_Hsend:
+		action.Value = v
+	case *pty.HashlockAction_Hrefund:
+		action.Value = v
+	}
+	tx := &types.Transaction{Execer: []byte("hashlock"), Payload: types.Encode(action), Fee: 1e6, To: addrexec}
+	tx.Nonce = rand.Int63()
+	tx.Sign(types.SECP256K1, priv)
+	return tx
+}
+
 func constructHashlockInstance() drivers.Driver {
 	chainTestCfg := types.NewChain33Config(types.GetDefaultCfgstring())
 	Init(pty.HashlockX, chainTestCfg, nil)
@@ -178,3 +267,34 @@ func CompareRetrieveExecResult(rec1 *types.Receipt, err1 error, rec2 *types.Rece
 	}
 	return true
 }
+
+func TestGetHashlocksByAddrPage(t *testing.T) {
+	_, _, localdb := util.CreateTestDB()
+	tab := pty.NewTable(localdb)
+	//前缀相同的地址记录排在前面, 不能占用分页数量
+	for i := 0; i < 5; i++ {
+		require.Nil(t, tab.Add(&pty.Hashlock{HashlockId: []byte{0, byte(i)}, ToAddress: toAddr + "x", ReturnAddress: returnAddr}))
+	}
+	for i := 0; i < 3; i++ {
+		require.Nil(t, tab.Add(&pty.Hashlock{HashlockId: []byte{1, byte(i)}, ToAddress: toAddr, ReturnAddress: returnAddr}))
+	}
+	kvs, err := tab.Save()
+	require.Nil(t, err)
+	for _, kv := range kvs {
+		localdb.Set(kv.Key, kv.Value)
+	}
+
+	msg, err := getHashlocksByAddr(localdb, &pty.ReqHashlockByAddr{Addr: toAddr, Role: pty.RoleTo, Count: 2})
+	require.Nil(t, err)
+	reply := msg.(*pty.ReplyHashlocks)
+	require.Equal(t, 2, len(reply.Hashlocks))
+	for _, hash := range reply.Hashlocks {
+		require.Equal(t, toAddr, hash.ToAddress)
+	}
+	msg, err = getHashlocksByAddr(localdb, &pty.ReqHashlockByAddr{Addr: toAddr, Role: pty.RoleTo, Count: 2,
+		PrimaryKey: common.ToHex(reply.Hashlocks[1].HashlockId)})
+	require.Nil(t, err)
+	require.Equal(t, 1, len(msg.(*pty.ReplyHashlocks).Hashlocks))
+	_, err = getHashlocksByAddr(localdb, &pty.ReqHashlockByAddr{Addr: toAddr + "y", Role: pty.RoleTo})
+	require.Equal(t, types.ErrNotFound, err)
+}
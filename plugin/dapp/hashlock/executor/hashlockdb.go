@@ -105,8 +105,15 @@ func (action *Action) Hashlocklock(hlock *pty.HashlockLock) (*types.Receipt, err
 	}
 
 	h := NewDB(hlock.Hash, action.fromaddr, hlock.ToAddress, action.blocktime, hlock.Amount, hlock.Time)
+	h.AssetExec = hlock.AssetExec
+	h.AssetSymbol = hlock.AssetSymbol
+	h.RefundHeight = hlock.RefundHeight
+	acc, err := action.assetAccount(&h.Hashlock)
+	if err != nil {
+		return nil, err
+	}
 	//冻结子账户资金
-	receipt, err := action.coinsAccount.ExecFrozen(action.fromaddr, action.execaddr, hlock.Amount)
+	receipt, err := acc.ExecFrozen(action.fromaddr, action.execaddr, hlock.Amount)
 
 	if err != nil {
 		hlog.Error("Hashlocklock.Frozen", "addr", action.fromaddr, "execaddr", action.execaddr, "amount", hlock.Amount)
@@ -116,7 +123,7 @@ func (action *Action) Hashlocklock(hlock *pty.HashlockLock) (*types.Receipt, err
 	h.Save(action.db)
 	logs = append(logs, receipt.Logs...)
 	kv = append(kv, receipt.KV...)
-	//logs = append(logs, h.GetReceiptLog())
+	logs = append(logs, action.getReceiptLog(h, pty.TyLogHashlockLock)...)
 	kv = append(kv, h.GetKVSet()...)
 
 	receipt = &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}
@@ -125,95 +132,150 @@ func (action *Action) Hashlocklock(hlock *pty.HashlockLock) (*types.Receipt, err
 
 // Hashlockunlock Action
 func (action *Action) Hashlockunlock(unlock *pty.HashlockUnlock) (*types.Receipt, error) {
+	return action.returnLocked(common.Sha256(unlock.Secret), pty.TyLogHashlockUnlock)
+}
+
+// Hashlocksend Action
+func (action *Action) Hashlocksend(send *pty.HashlockSend) (*types.Receipt, error) {
 
 	var logs []*types.ReceiptLog
 	var kv []*types.KeyValue
 
-	hash, err := readHashlock(action.db, common.Sha256(unlock.Secret))
+	hash, err := readHashlock(action.db, common.Sha256(send.Secret))
 	if err != nil {
-		hlog.Error("Hashlockunlock", "unlock.Secret", unlock.Secret)
+		hlog.Error("Hashlocksend", "send.Secret", send.Secret)
 		return nil, err
 	}
 
-	if hash.ReturnAddress != action.fromaddr {
-		hlog.Error("Hashlockunlock.Frozen", "action.fromaddr", action.fromaddr)
-		return nil, pty.ErrHashlockReturnAddrss
-	}
-
 	if hash.Status != hashlockLocked {
-		hlog.Error("Hashlockunlock", "hash.Status", hash.Status)
+		hlog.Error("Hashlocksend", "hash.Status", hash.Status)
 		return nil, pty.ErrHashlockStatus
 	}
 
-	if action.blocktime-hash.GetCreateTime() < hash.Frozentime {
-		hlog.Error("Hashlockunlock", "action.blocktime-hash.GetCreateTime", action.blocktime-hash.GetCreateTime())
+	if action.fromaddr != hash.ToAddress {
+		hlog.Error("Hashlocksend", "action.fromaddr", action.fromaddr, "hash.ToAddress", hash.ToAddress)
+		return nil, pty.ErrHashlockSendAddress
+	}
+
+	if hash.RefundHeight > 0 {
+		//按高度锁定时, 达到退回高度后接收方不能再提取
+		if action.height >= hash.RefundHeight {
+			hlog.Error("Hashlocksend", "height", action.height, "refundHeight", hash.RefundHeight)
+			return nil, pty.ErrHashlockHeight
+		}
+	} else if action.blocktime-hash.GetCreateTime() > hash.Frozentime {
+		hlog.Error("Hashlocksend", "action.blocktime-hash.GetCreateTime", action.blocktime-hash.GetCreateTime())
 		return nil, pty.ErrTime
 	}
 
 	//different with typedef in C
 	h := &DB{*hash}
-	receipt, errR := action.coinsAccount.ExecActive(h.ReturnAddress, action.execaddr, h.Amount)
+	acc, err := action.assetAccount(&h.Hashlock)
+	if err != nil {
+		return nil, err
+	}
+	receipt, errR := acc.ExecTransferFrozen(h.ReturnAddress, h.ToAddress, action.execaddr, h.Amount)
 	if errR != nil {
-		hlog.Error("ExecActive error", "ReturnAddress", h.ReturnAddress, "execaddr", action.execaddr, "amount", h.Amount)
+		hlog.Error("ExecTransferFrozen error", "ReturnAddress", h.ReturnAddress, "ToAddress", h.ToAddress, "execaddr", action.execaddr, "amount", h.Amount)
 		return nil, errR
 	}
-
-	h.Status = hashlockUnlocked
+	h.Status = hashlockSent
 	h.Save(action.db)
 	logs = append(logs, receipt.Logs...)
 	kv = append(kv, receipt.KV...)
-	//logs = append(logs, t.GetReceiptLog())
+	logs = append(logs, action.getReceiptLog(h, pty.TyLogHashlockSend)...)
 	kv = append(kv, h.GetKVSet()...)
 
 	receipt = &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}
 	return receipt, nil
 }
 
-// Hashlocksend Action
-func (action *Action) Hashlocksend(send *pty.HashlockSend) (*types.Receipt, error) {
+// Hashlockrefund Action, 超时后发起方凭hash退回资产
+func (action *Action) Hashlockrefund(refund *pty.HashlockRefund) (*types.Receipt, error) {
+	return action.returnLocked(refund.Hash, pty.TyLogHashlockRefund)
+}
+
+// returnLocked 超时后将锁定资产退回发起方, unlock与refund共用
+func (action *Action) returnLocked(id []byte, logTy int32) (*types.Receipt, error) {
 
 	var logs []*types.ReceiptLog
 	var kv []*types.KeyValue
 
-	hash, err := readHashlock(action.db, common.Sha256(send.Secret))
+	hash, err := readHashlock(action.db, id)
 	if err != nil {
-		hlog.Error("Hashlocksend", "send.Secret", send.Secret)
+		hlog.Error("returnLocked", "id", id)
 		return nil, err
 	}
 
-	if hash.Status != hashlockLocked {
-		hlog.Error("Hashlocksend", "hash.Status", hash.Status)
-		return nil, pty.ErrHashlockStatus
+	if hash.ReturnAddress != action.fromaddr {
+		hlog.Error("returnLocked", "action.fromaddr", action.fromaddr)
+		return nil, pty.ErrHashlockReturnAddrss
 	}
 
-	if action.fromaddr != hash.ToAddress {
-		hlog.Error("Hashlocksend", "action.fromaddr", action.fromaddr, "hash.ToAddress", hash.ToAddress)
-		return nil, pty.ErrHashlockSendAddress
+	if hash.Status != hashlockLocked {
+		hlog.Error("returnLocked", "hash.Status", hash.Status)
+		return nil, pty.ErrHashlockStatus
 	}
 
-	if action.blocktime-hash.GetCreateTime() > hash.Frozentime {
-		hlog.Error("Hashlocksend", "action.blocktime-hash.GetCreateTime", action.blocktime-hash.GetCreateTime())
-		return nil, pty.ErrTime
+	if err := action.checkRefundable(hash); err != nil {
+		return nil, err
 	}
 
 	//different with typedef in C
 	h := &DB{*hash}
-	receipt, errR := action.coinsAccount.ExecTransferFrozen(h.ReturnAddress, h.ToAddress, action.execaddr, h.Amount)
-	if errR != nil {
-		hlog.Error("ExecTransferFrozen error", "ReturnAddress", h.ReturnAddress, "ToAddress", h.ToAddress, "execaddr", action.execaddr, "amount", h.Amount)
-		return nil, errR
+	acc, err := action.assetAccount(&h.Hashlock)
+	if err != nil {
+		return nil, err
 	}
-	h.Status = hashlockSent
+	receipt, err := acc.ExecActive(h.ReturnAddress, action.execaddr, h.Amount)
+	if err != nil {
+		hlog.Error("ExecActive error", "ReturnAddress", h.ReturnAddress, "execaddr", action.execaddr, "amount", h.Amount)
+		return nil, err
+	}
+
+	h.Status = hashlockUnlocked
 	h.Save(action.db)
 	logs = append(logs, receipt.Logs...)
 	kv = append(kv, receipt.KV...)
-	//logs = append(logs, t.GetReceiptLog())
+	logs = append(logs, action.getReceiptLog(h, logTy)...)
 	kv = append(kv, h.GetKVSet()...)
 
 	receipt = &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}
 	return receipt, nil
 }
 
+// checkRefundable 按高度锁定时需达到退回高度, 否则需超过锁定时间
+func (action *Action) checkRefundable(hash *pty.Hashlock) error {
+	if hash.RefundHeight > 0 {
+		if action.height < hash.RefundHeight {
+			hlog.Error("checkRefundable", "height", action.height, "refundHeight", hash.RefundHeight)
+			return pty.ErrHashlockHeight
+		}
+		return nil
+	}
+	if action.blocktime-hash.GetCreateTime() < hash.Frozentime {
+		hlog.Error("checkRefundable", "action.blocktime-hash.GetCreateTime", action.blocktime-hash.GetCreateTime())
+		return pty.ErrTime
+	}
+	return nil
+}
+
+// assetAccount 锁定资产对应的账户, 未指定资产时为主币
+func (action *Action) assetAccount(hash *pty.Hashlock) (*account.DB, error) {
+	if hash.AssetExec == "" {
+		return action.coinsAccount, nil
+	}
+	return account.NewAccountDB(action.api.GetConfig(), hash.AssetExec, hash.AssetSymbol, action.db)
+}
+
+func (action *Action) getReceiptLog(h *DB, ty int32) []*types.ReceiptLog {
+	cfg := action.api.GetConfig()
+	if !cfg.IsDappFork(action.height, pty.HashlockX, pty.ForkHashlockAssetX) {
+		return nil
+	}
+	return []*types.ReceiptLog{{Ty: ty, Log: types.Encode(&h.Hashlock)}}
+}
+
 func readHashlock(db dbm.KV, id []byte) (*pty.Hashlock, error) {
 	data, err := db.Get(Key(id))
 	if err != nil {
@@ -344,3 +406,54 @@ func (n *Hashlock) GetTxsByHashlockID(hashlockID []byte, differTime int64) (type
 	//	qresult := types.Hashlockquery{query.Time, query.Status, query.Amount, query.CreateTime, currentTime}
 	return query, nil
 }
+
+// getHashlocksByAddr 按地址查询hashlock
+func getHashlocksByAddr(db dbm.KVDB, req *pty.ReqHashlockByAddr) (types.Message, error) {
+	if req.Addr == "" {
+		return nil, types.ErrInvalidParam
+	}
+	index := "return"
+	if req.Role == pty.RoleTo {
+		index = "to"
+	} else if req.Role != pty.RoleReturn {
+		return nil, types.ErrInvalidParam
+	}
+	count := req.Count
+	if count <= 0 {
+		count = pty.DefaultCount
+	}
+	var primary []byte
+	if req.PrimaryKey != "" {
+		primary = []byte(req.PrimaryKey)
+	}
+	query := pty.NewTable(db).GetQuery(db)
+	reply := &pty.ReplyHashlocks{}
+	//索引按前缀匹配, 需先过滤掉地址不完全相同的记录再分页, 否则返回的记录数会少于count
+	for int32(len(reply.Hashlocks)) < count {
+		rows, err := query.ListIndex(index, []byte(req.Addr), primary, count, req.Direction)
+		if err == types.ErrNotFound {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			hash := row.Data.(*pty.Hashlock)
+			if (req.Role == pty.RoleTo && hash.ToAddress != req.Addr) || (req.Role == pty.RoleReturn && hash.ReturnAddress != req.Addr) {
+				continue
+			}
+			reply.Hashlocks = append(reply.Hashlocks, hash)
+			if int32(len(reply.Hashlocks)) == count {
+				break
+			}
+		}
+		if int32(len(rows)) < count {
+			break
+		}
+		primary = rows[len(rows)-1].Primary
+	}
+	if len(reply.Hashlocks) == 0 {
+		return nil, types.ErrNotFound
+	}
+	return reply, nil
+}
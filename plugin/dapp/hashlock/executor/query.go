@@ -4,7 +4,10 @@
 
 package executor
 
-import "github.com/33cn/chain33/types"
+import (
+	"github.com/33cn/chain33/types"
+	pty "github.com/33cn/plugin/plugin/dapp/hashlock/types"
+)
 
 // Query_GetHashlocKById get hashlock instance
 func (h *Hashlock) Query_GetHashlocKById(in []byte) (types.Message, error) {
@@ -12,3 +15,13 @@ func (h *Hashlock) Query_GetHashlocKById(in []byte) (types.Message, error) {
 	clog.Error("Query action")
 	return h.GetTxsByHashlockID(in, differTime)
 }
+
+// Query_GetHashlockByHash 按hash查询hashlock状态
+func (h *Hashlock) Query_GetHashlockByHash(in *pty.ReqHashlockByHash) (types.Message, error) {
+	return readHashlock(h.GetStateDB(), in.Hash)
+}
+
+// Query_GetHashlocksByAddr 按发起方或接收方地址查询hashlock列表
+func (h *Hashlock) Query_GetHashlocksByAddr(in *pty.ReqHashlockByAddr) (types.Message, error) {
+	return getHashlocksByAddr(h.GetLocalDB(), in)
+}
@@ -16,4 +16,6 @@ var (
 	ErrHashlockTime         = errors.New("ErrHashlockTime")
 	ErrHashlockReapeathash  = errors.New("ErrHashlockReapeathash")
 	ErrHashlockSendAddress  = errors.New("ErrHashlockSendAddress")
+	ErrHashlockAsset        = errors.New("ErrHashlockAsset")
+	ErrHashlockHeight       = errors.New("ErrHashlockHeight")
 )
@@ -6,6 +6,7 @@ package types
 
 import (
 	"encoding/json"
+	"reflect"
 
 	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/common/address"
@@ -27,6 +28,7 @@ func init() {
 func InitFork(cfg *types.Chain33Config) {
 	cfg.RegisterDappFork(HashlockX, "Enable", 0)
 	cfg.RegisterDappFork(HashlockX, ForkBadRepeatSecretX, 0)
+	cfg.RegisterDappFork(HashlockX, ForkHashlockAssetX, 0)
 }
 
 //InitExecutor ...
@@ -86,6 +88,14 @@ func (hashlock *HashlockType) CreateTx(action string, message json.RawMessage) (
 			return nil, types.ErrInvalidParam
 		}
 		return CreateRawHashlockSendTx(cfg, &param)
+	} else if action == "HashlockRefund" {
+		var param HashlockRefundTx
+		err := json.Unmarshal(message, &param)
+		if err != nil {
+			hlog.Error("CreateTx", "Error", err)
+			return nil, types.ErrInvalidParam
+		}
+		return CreateRawHashlockRefundTx(cfg, &param)
 	}
 	return nil, types.ErrNotSupport
 
@@ -97,12 +107,18 @@ func (hashlock *HashlockType) GetTypeMap() map[string]int32 {
 		"Hlock":   HashlockActionLock,
 		"Hsend":   HashlockActionSend,
 		"Hunlock": HashlockActionUnlock,
+		"Hrefund": HashlockActionRefund,
 	}
 }
 
 // GetLogMap method
 func (hashlock *HashlockType) GetLogMap() map[int64]*types.LogInfo {
-	return map[int64]*types.LogInfo{}
+	return map[int64]*types.LogInfo{
+		TyLogHashlockLock:   {Ty: reflect.TypeOf(Hashlock{}), Name: "LogHashlockLock"},
+		TyLogHashlockSend:   {Ty: reflect.TypeOf(Hashlock{}), Name: "LogHashlockSend"},
+		TyLogHashlockUnlock: {Ty: reflect.TypeOf(Hashlock{}), Name: "LogHashlockUnlock"},
+		TyLogHashlockRefund: {Ty: reflect.TypeOf(Hashlock{}), Name: "LogHashlockRefund"},
+	}
 }
 
 // CreateRawHashlockLockTx method
@@ -118,6 +134,9 @@ func CreateRawHashlockLockTx(cfg *types.Chain33Config, parm *HashlockLockTx) (*t
 		Hash:          common.Sha256([]byte(parm.Secret)),
 		ToAddress:     parm.ToAddr,
 		ReturnAddress: parm.ReturnAddr,
+		AssetExec:     parm.AssetExec,
+		AssetSymbol:   parm.AssetSymbol,
+		RefundHeight:  parm.RefundHeight,
 	}
 	lock := &HashlockAction{
 		Ty:    HashlockActionLock,
@@ -192,3 +211,35 @@ func CreateRawHashlockSendTx(cfg *types.Chain33Config, parm *HashlockSendTx) (*t
 
 	return tx, nil
 }
+
+// CreateRawHashlockRefundTx method
+func CreateRawHashlockRefundTx(cfg *types.Chain33Config, parm *HashlockRefundTx) (*types.Transaction, error) {
+	if parm == nil {
+		hlog.Error("CreateRawHashlockRefundTx", "parm", parm)
+		return nil, types.ErrInvalidParam
+	}
+	hash, err := common.FromHex(parm.Hash)
+	if err != nil || len(hash) == 0 {
+		hlog.Error("CreateRawHashlockRefundTx", "hash", parm.Hash)
+		return nil, types.ErrInvalidParam
+	}
+
+	v := &HashlockRefund{
+		Hash: hash,
+	}
+	refund := &HashlockAction{
+		Ty:    HashlockActionRefund,
+		Value: &HashlockAction_Hrefund{v},
+	}
+	tx := &types.Transaction{
+		Execer:  []byte(cfg.ExecName(HashlockX)),
+		Payload: types.Encode(refund),
+		Fee:     parm.Fee,
+		To:      address.ExecAddress(cfg.ExecName(HashlockX)),
+	}
+	tx, err = types.FormatTx(cfg, cfg.ExecName(HashlockX), tx)
+	if err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
@@ -33,6 +33,9 @@ type Hashlock struct {
 	ReturnAddress string `protobuf:"bytes,5,opt,name=returnAddress,proto3" json:"returnAddress,omitempty"`
 	Amount        int64  `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
 	Frozentime    int64  `protobuf:"varint,7,opt,name=frozentime,proto3" json:"frozentime,omitempty"`
+	AssetExec     string `protobuf:"bytes,8,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
+	AssetSymbol   string `protobuf:"bytes,9,opt,name=assetSymbol,proto3" json:"assetSymbol,omitempty"`
+	RefundHeight  int64  `protobuf:"varint,10,opt,name=refundHeight,proto3" json:"refundHeight,omitempty"`
 }
 
 func (x *Hashlock) Reset() {
@@ -116,6 +119,27 @@ func (x *Hashlock) GetFrozentime() int64 {
 	return 0
 }
 
+func (x *Hashlock) GetAssetExec() string {
+	if x != nil {
+		return x.AssetExec
+	}
+	return ""
+}
+
+func (x *Hashlock) GetAssetSymbol() string {
+	if x != nil {
+		return x.AssetSymbol
+	}
+	return ""
+}
+
+func (x *Hashlock) GetRefundHeight() int64 {
+	if x != nil {
+		return x.RefundHeight
+	}
+	return 0
+}
+
 type HashlockLock struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -126,6 +150,11 @@ type HashlockLock struct {
 	Hash          []byte `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
 	ToAddress     string `protobuf:"bytes,4,opt,name=toAddress,proto3" json:"toAddress,omitempty"`
 	ReturnAddress string `protobuf:"bytes,5,opt,name=returnAddress,proto3" json:"returnAddress,omitempty"`
+	//锁定资产, 为空时为主币
+	AssetExec   string `protobuf:"bytes,6,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
+	AssetSymbol string `protobuf:"bytes,7,opt,name=assetSymbol,proto3" json:"assetSymbol,omitempty"`
+	//大于0时按区块高度控制: 高度达到refundHeight前接收方可提取, 之后发起方可退回
+	RefundHeight int64 `protobuf:"varint,8,opt,name=refundHeight,proto3" json:"refundHeight,omitempty"`
 }
 
 func (x *HashlockLock) Reset() {
@@ -195,6 +224,27 @@ func (x *HashlockLock) GetReturnAddress() string {
 	return ""
 }
 
+func (x *HashlockLock) GetAssetExec() string {
+	if x != nil {
+		return x.AssetExec
+	}
+	return ""
+}
+
+func (x *HashlockLock) GetAssetSymbol() string {
+	if x != nil {
+		return x.AssetSymbol
+	}
+	return ""
+}
+
+func (x *HashlockLock) GetRefundHeight() int64 {
+	if x != nil {
+		return x.RefundHeight
+	}
+	return 0
+}
+
 type HashlockSend struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -423,6 +473,54 @@ func (x *HashlockUnlock) GetSecret() []byte {
 	return nil
 }
 
+// 超时后发起方凭hash退回资产, 不需要知道secret
+type HashlockRefund struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (x *HashlockRefund) Reset() {
+	*x = HashlockRefund{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hashlock_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HashlockRefund) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HashlockRefund) ProtoMessage() {}
+
+func (x *HashlockRefund) ProtoReflect() protoreflect.Message {
+	mi := &file_hashlock_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HashlockRefund.ProtoReflect.Descriptor instead.
+func (*HashlockRefund) Descriptor() ([]byte, []int) {
+	return file_hashlock_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *HashlockRefund) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
 // message for hashlock
 type HashlockAction struct {
 	state         protoimpl.MessageState
@@ -433,6 +531,7 @@ type HashlockAction struct {
 	//	*HashlockAction_Hlock
 	//	*HashlockAction_Hsend
 	//	*HashlockAction_Hunlock
+	//	*HashlockAction_Hrefund
 	Value isHashlockAction_Value `protobuf_oneof:"value"`
 	Ty    int32                  `protobuf:"varint,4,opt,name=ty,proto3" json:"ty,omitempty"`
 }
@@ -440,7 +539,7 @@ type HashlockAction struct {
 func (x *HashlockAction) Reset() {
 	*x = HashlockAction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hashlock_proto_msgTypes[6]
+		mi := &file_hashlock_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -453,7 +552,7 @@ func (x *HashlockAction) String() string {
 func (*HashlockAction) ProtoMessage() {}
 
 func (x *HashlockAction) ProtoReflect() protoreflect.Message {
-	mi := &file_hashlock_proto_msgTypes[6]
+	mi := &file_hashlock_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -466,7 +565,7 @@ func (x *HashlockAction) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HashlockAction.ProtoReflect.Descriptor instead.
 func (*HashlockAction) Descriptor() ([]byte, []int) {
-	return file_hashlock_proto_rawDescGZIP(), []int{6}
+	return file_hashlock_proto_rawDescGZIP(), []int{7}
 }
 
 func (m *HashlockAction) GetValue() isHashlockAction_Value {
@@ -497,6 +596,13 @@ func (x *HashlockAction) GetHunlock() *HashlockUnlock {
 	return nil
 }
 
+func (x *HashlockAction) GetHrefund() *HashlockRefund {
+	if x, ok := x.GetValue().(*HashlockAction_Hrefund); ok {
+		return x.Hrefund
+	}
+	return nil
+}
+
 func (x *HashlockAction) GetTy() int32 {
 	if x != nil {
 		return x.Ty
@@ -520,17 +626,197 @@ type HashlockAction_Hunlock struct {
 	Hunlock *HashlockUnlock `protobuf:"bytes,3,opt,name=hunlock,proto3,oneof"`
 }
 
+type HashlockAction_Hrefund struct {
+	Hrefund *HashlockRefund `protobuf:"bytes,5,opt,name=hrefund,proto3,oneof"`
+}
+
 func (*HashlockAction_Hlock) isHashlockAction_Value() {}
 
 func (*HashlockAction_Hsend) isHashlockAction_Value() {}
 
 func (*HashlockAction_Hunlock) isHashlockAction_Value() {}
 
+func (*HashlockAction_Hrefund) isHashlockAction_Value() {}
+
+type ReqHashlockByHash struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (x *ReqHashlockByHash) Reset() {
+	*x = ReqHashlockByHash{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hashlock_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqHashlockByHash) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqHashlockByHash) ProtoMessage() {}
+
+func (x *ReqHashlockByHash) ProtoReflect() protoreflect.Message {
+	mi := &file_hashlock_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqHashlockByHash.ProtoReflect.Descriptor instead.
+func (*ReqHashlockByHash) Descriptor() ([]byte, []int) {
+	return file_hashlock_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ReqHashlockByHash) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+type ReqHashlockByAddr struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	// 0: 作为发起方(returnAddress), 1: 作为接收方(toAddress)
+	Role       int32  `protobuf:"varint,2,opt,name=role,proto3" json:"role,omitempty"`
+	PrimaryKey string `protobuf:"bytes,3,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	Count      int32  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	Direction  int32  `protobuf:"varint,5,opt,name=direction,proto3" json:"direction,omitempty"`
+}
+
+func (x *ReqHashlockByAddr) Reset() {
+	*x = ReqHashlockByAddr{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hashlock_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqHashlockByAddr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqHashlockByAddr) ProtoMessage() {}
+
+func (x *ReqHashlockByAddr) ProtoReflect() protoreflect.Message {
+	mi := &file_hashlock_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqHashlockByAddr.ProtoReflect.Descriptor instead.
+func (*ReqHashlockByAddr) Descriptor() ([]byte, []int) {
+	return file_hashlock_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ReqHashlockByAddr) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *ReqHashlockByAddr) GetRole() int32 {
+	if x != nil {
+		return x.Role
+	}
+	return 0
+}
+
+func (x *ReqHashlockByAddr) GetPrimaryKey() string {
+	if x != nil {
+		return x.PrimaryKey
+	}
+	return ""
+}
+
+func (x *ReqHashlockByAddr) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ReqHashlockByAddr) GetDirection() int32 {
+	if x != nil {
+		return x.Direction
+	}
+	return 0
+}
+
+type ReplyHashlocks struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hashlocks []*Hashlock `protobuf:"bytes,1,rep,name=hashlocks,proto3" json:"hashlocks,omitempty"`
+}
+
+func (x *ReplyHashlocks) Reset() {
+	*x = ReplyHashlocks{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hashlock_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplyHashlocks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplyHashlocks) ProtoMessage() {}
+
+func (x *ReplyHashlocks) ProtoReflect() protoreflect.Message {
+	mi := &file_hashlock_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplyHashlocks.ProtoReflect.Descriptor instead.
+func (*ReplyHashlocks) Descriptor() ([]byte, []int) {
+	return file_hashlock_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ReplyHashlocks) GetHashlocks() []*Hashlock {
+	if x != nil {
+		return x.Hashlocks
+	}
+	return nil
+}
+
 var File_hashlock_proto protoreflect.FileDescriptor
 
 var file_hashlock_proto_rawDesc = []byte{
 	0x0a, 0x0e, 0x68, 0x61, 0x73, 0x68, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x12, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x22, 0xde, 0x01, 0x0a, 0x08, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x22, 0xc2, 0x02, 0x0a, 0x08, 0x48, 0x61, 0x73, 0x68,
 	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1e, 0x0a, 0x0a, 0x68, 0x61, 0x73, 0x68, 0x6c, 0x6f, 0x63, 0x6b,
 	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x68, 0x61, 0x73, 0x68, 0x6c, 0x6f,
 	0x63, 0x6b, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02,
@@ -544,50 +830,84 @@ var file_hashlock_proto_rawDesc = []byte{
 	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
 	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x7a,
 	0x65, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x66, 0x72,
-	0x6f, 0x7a, 0x65, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x92, 0x01, 0x0a, 0x0c, 0x48, 0x61, 0x73,
-	0x68, 0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x6f, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x74, 0x75, 0x72,
-	0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x26, 0x0a,
-	0x0c, 0x48, 0x61, 0x73, 0x68, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x65, 0x6e, 0x64, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73,
-	0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x95, 0x01, 0x0a, 0x0d, 0x48, 0x61, 0x73, 0x68, 0x6c, 0x6f,
-	0x63, 0x6b, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x62, 0x0a,
-	0x08, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x63, 0x76, 0x12, 0x1e, 0x0a, 0x0a, 0x48, 0x61, 0x73,
-	0x68, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x48,
-	0x61, 0x73, 0x68, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x0b, 0x49, 0x6e, 0x66,
-	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x6c, 0x6f, 0x63, 0x6b, 0x71,
-	0x75, 0x65, 0x72, 0x79, 0x52, 0x0b, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x22, 0x28, 0x0a, 0x0e, 0x48, 0x61, 0x73, 0x68, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x6e, 0x6c,
-	0x6f, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0xb6, 0x01, 0x0a, 0x0e,
-	0x48, 0x61, 0x73, 0x68, 0x6c, 0x6f, 0x63, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b,
-	0x0a, 0x05, 0x68, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x6f,
-	0x63, 0x6b, 0x48, 0x00, 0x52, 0x05, 0x68, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x2b, 0x0a, 0x05, 0x68,
-	0x73, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x65, 0x6e, 0x64, 0x48,
-	0x00, 0x52, 0x05, 0x68, 0x73, 0x65, 0x6e, 0x64, 0x12, 0x31, 0x0a, 0x07, 0x68, 0x75, 0x6e, 0x6c,
-	0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b,
-	0x48, 0x00, 0x52, 0x07, 0x68, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x74,
-	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x74, 0x79, 0x42, 0x07, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x42, 0x0a, 0x5a, 0x08, 0x2e, 0x2e, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x7a, 0x65, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x45, 0x78, 0x65, 0x63, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x45, 0x78, 0x65, 0x63, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x53,
+	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x75,
+	0x6e, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xf6, 0x01, 0x0a,
+	0x0c, 0x48, 0x61, 0x73, 0x68, 0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x6f, 0x63, 0x6b, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x6f, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x74, 0x6f, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x72,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x45, 0x78, 0x65, 0x63, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x45, 0x78, 0x65, 0x63, 0x12,
+	0x20, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x53, 0x79, 0x6d, 0x62, 0x6f,
+	0x6c, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x26, 0x0a, 0x0c, 0x48, 0x61, 0x73, 0x68, 0x6c, 0x6f, 0x63,
+	0x6b, 0x53, 0x65, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x95, 0x01,
+	0x0a, 0x0d, 0x48, 0x61, 0x73, 0x68, 0x6c, 0x6f, 0x63, 0x6b, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74,
+	0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x62, 0x0a, 0x08, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x63,
+	0x76, 0x12, 0x1e, 0x0a, 0x0a, 0x48, 0x61, 0x73, 0x68, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x48, 0x61, 0x73, 0x68, 0x6c, 0x6f, 0x63, 0x6b, 0x49,
+	0x64, 0x12, 0x36, 0x0a, 0x0b, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x48,
+	0x61, 0x73, 0x68, 0x6c, 0x6f, 0x63, 0x6b, 0x71, 0x75, 0x65, 0x72, 0x79, 0x52, 0x0b, 0x49, 0x6e,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x28, 0x0a, 0x0e, 0x48, 0x61, 0x73,
+	0x68, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x22, 0x24, 0x0a, 0x0e, 0x48, 0x61, 0x73, 0x68, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
+	0x65, 0x66, 0x75, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0xe9, 0x01, 0x0a, 0x0e, 0x48, 0x61,
+	0x73, 0x68, 0x6c, 0x6f, 0x63, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x05,
+	0x68, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x6f, 0x63, 0x6b,
+	0x48, 0x00, 0x52, 0x05, 0x68, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x2b, 0x0a, 0x05, 0x68, 0x73, 0x65,
+	0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x48, 0x61, 0x73, 0x68, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x65, 0x6e, 0x64, 0x48, 0x00, 0x52,
+	0x05, 0x68, 0x73, 0x65, 0x6e, 0x64, 0x12, 0x31, 0x0a, 0x07, 0x68, 0x75, 0x6e, 0x6c, 0x6f, 0x63,
+	0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x48, 0x61, 0x73, 0x68, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x00,
+	0x52, 0x07, 0x68, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x31, 0x0a, 0x07, 0x68, 0x72, 0x65,
+	0x66, 0x75, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x66, 0x75, 0x6e,
+	0x64, 0x48, 0x00, 0x52, 0x07, 0x68, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x12, 0x0e, 0x0a, 0x02,
+	0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x74, 0x79, 0x42, 0x07, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x27, 0x0a, 0x11, 0x52, 0x65, 0x71, 0x48, 0x61, 0x73, 0x68,
+	0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79, 0x48, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0x8f,
+	0x01, 0x0a, 0x11, 0x52, 0x65, 0x71, 0x48, 0x61, 0x73, 0x68, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79,
+	0x41, 0x64, 0x64, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x3f, 0x0a, 0x0e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x61, 0x73, 0x68, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x12, 0x2d, 0x0a, 0x09, 0x68, 0x61, 0x73, 0x68, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x48, 0x61,
+	0x73, 0x68, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x09, 0x68, 0x61, 0x73, 0x68, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x42, 0x0a, 0x5a, 0x08, 0x2e, 0x2e, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -602,26 +922,32 @@ func file_hashlock_proto_rawDescGZIP() []byte {
 	return file_hashlock_proto_rawDescData
 }
 
-var file_hashlock_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_hashlock_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_hashlock_proto_goTypes = []interface{}{
-	(*Hashlock)(nil),       // 0: types.Hashlock
-	(*HashlockLock)(nil),   // 1: types.HashlockLock
-	(*HashlockSend)(nil),   // 2: types.HashlockSend
-	(*Hashlockquery)(nil),  // 3: types.Hashlockquery
-	(*HashRecv)(nil),       // 4: types.HashRecv
-	(*HashlockUnlock)(nil), // 5: types.HashlockUnlock
-	(*HashlockAction)(nil), // 6: types.HashlockAction
+	(*Hashlock)(nil),          // 0: types.Hashlock
+	(*HashlockLock)(nil),      // 1: types.HashlockLock
+	(*HashlockSend)(nil),      // 2: types.HashlockSend
+	(*Hashlockquery)(nil),     // 3: types.Hashlockquery
+	(*HashRecv)(nil),          // 4: types.HashRecv
+	(*HashlockUnlock)(nil),    // 5: types.HashlockUnlock
+	(*HashlockRefund)(nil),    // 6: types.HashlockRefund
+	(*HashlockAction)(nil),    // 7: types.HashlockAction
+	(*ReqHashlockByHash)(nil), // 8: types.ReqHashlockByHash
+	(*ReqHashlockByAddr)(nil), // 9: types.ReqHashlockByAddr
+	(*ReplyHashlocks)(nil),    // 10: types.ReplyHashlocks
 }
 var file_hashlock_proto_depIdxs = []int32{
 	3, // 0: types.HashRecv.Information:type_name -> types.Hashlockquery
 	1, // 1: types.HashlockAction.hlock:type_name -> types.HashlockLock
 	2, // 2: types.HashlockAction.hsend:type_name -> types.HashlockSend
 	5, // 3: types.HashlockAction.hunlock:type_name -> types.HashlockUnlock
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	6, // 4: types.HashlockAction.hrefund:type_name -> types.HashlockRefund
+	0, // 5: types.ReplyHashlocks.hashlocks:type_name -> types.Hashlock
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_hashlock_proto_init() }
@@ -703,6 +1029,18 @@ func file_hashlock_proto_init() {
 			}
 		}
 		file_hashlock_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HashlockRefund); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hashlock_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*HashlockAction); i {
 			case 0:
 				return &v.state
@@ -714,11 +1052,48 @@ func file_hashlock_proto_init() {
 				return nil
 			}
 		}
+		file_hashlock_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqHashlockByHash); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hashlock_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqHashlockByAddr); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hashlock_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplyHashlocks); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_hashlock_proto_msgTypes[6].OneofWrappers = []interface{}{
+	file_hashlock_proto_msgTypes[7].OneofWrappers = []interface{}{
 		(*HashlockAction_Hlock)(nil),
 		(*HashlockAction_Hsend)(nil),
 		(*HashlockAction_Hunlock)(nil),
+		(*HashlockAction_Hrefund)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -726,7 +1101,7 @@ func file_hashlock_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_hashlock_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,71 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/common/db/table"
+	"github.com/33cn/chain33/types"
+)
+
+/*
+table  struct
+data:  hashlock
+index: return,to
+*/
+
+var opt = &table.Option{
+	Prefix:  "LODB-hashlock",
+	Name:    "htlc",
+	Primary: "hashlockid",
+	Index:   []string{"return", "to"},
+}
+
+// NewTable 新建表
+func NewTable(kvdb db.KV) *table.Table {
+	rowmeta := NewHashlockRow()
+	table, err := table.NewTable(rowmeta, kvdb, opt)
+	if err != nil {
+		panic(err)
+	}
+	return table
+}
+
+// HashlockRow table meta 结构
+type HashlockRow struct {
+	*Hashlock
+}
+
+// NewHashlockRow 新建一个meta 结构
+func NewHashlockRow() *HashlockRow {
+	return &HashlockRow{Hashlock: &Hashlock{}}
+}
+
+// CreateRow 新建数据行
+func (h *HashlockRow) CreateRow() *table.Row {
+	return &table.Row{Data: &Hashlock{}}
+}
+
+// SetPayload 设置数据
+func (h *HashlockRow) SetPayload(data types.Message) error {
+	if txdata, ok := data.(*Hashlock); ok {
+		h.Hashlock = txdata
+		return nil
+	}
+	return types.ErrTypeAsset
+}
+
+// Get 按照indexName 查询 indexValue
+func (h *HashlockRow) Get(key string) ([]byte, error) {
+	if key == "hashlockid" {
+		return []byte(common.ToHex(h.HashlockId)), nil
+	} else if key == "return" {
+		return []byte(h.ReturnAddress), nil
+	} else if key == "to" {
+		return []byte(h.ToAddress), nil
+	}
+	return nil, types.ErrNotFound
+}
@@ -12,6 +12,10 @@ type HashlockLockTx struct {
 	ToAddr     string `json:"toAddr"`
 	ReturnAddr string `json:"returnAddr"`
 	Fee        int64  `json:"fee"`
+	//为空时锁定主币
+	AssetExec    string `json:"assetExec"`
+	AssetSymbol  string `json:"assetSymbol"`
+	RefundHeight int64  `json:"refundHeight"`
 }
 
 // HashlockUnlockTx for construction
@@ -25,3 +29,9 @@ type HashlockSendTx struct {
 	Secret string `json:"secret"`
 	Fee    int64  `json:"fee"`
 }
+
+// HashlockRefundTx for construction
+type HashlockRefundTx struct {
+	Hash string `json:"hash"`
+	Fee  int64  `json:"fee"`
+}
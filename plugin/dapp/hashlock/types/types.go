@@ -9,10 +9,31 @@ const (
 	HashlockActionLock   = 1
 	HashlockActionSend   = 2
 	HashlockActionUnlock = 3
+	HashlockActionRefund = 4
+)
+
+// hashlock log type
+const (
+	TyLogHashlockLock   = 1201
+	TyLogHashlockSend   = 1202
+	TyLogHashlockUnlock = 1203
+	TyLogHashlockRefund = 1204
+)
+
+// query addr role
+const (
+	// RoleReturn 作为发起方(returnAddress)
+	RoleReturn = 0
+	// RoleTo 作为接收方(toAddress)
+	RoleTo = 1
+	// DefaultCount 默认一次取多少条记录
+	DefaultCount = int32(20)
 )
 
 // HashlockX name
 var (
 	HashlockX            = "hashlock"
 	ForkBadRepeatSecretX = "ForkBadRepeatSecret"
+	// ForkHashlockAssetX 支持任意执行器资产, 按高度退回及查询
+	ForkHashlockAssetX = "ForkHashlockAsset"
 )
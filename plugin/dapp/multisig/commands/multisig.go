@@ -11,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/33cn/chain33/common"
 	commandtypes "github.com/33cn/chain33/system/dapp/commands/types"
 	"github.com/pkg/errors"
 
@@ -83,6 +84,7 @@ func MultiSigTxCmd() *cobra.Command {
 	}
 	cmd.AddCommand(
 		CreateMultiSigConfirmTxCmd(),
+		CreateMultiSigExecTxCmd(),
 		CreateMultiSigAccTransferInCmd(),
 		CreateMultiSigAccTransferOutCmd(),
 		GetMultiSigAccTxCountCmd(),
@@ -231,6 +233,7 @@ func createOwnerAddTransferFlags(cmd *cobra.Command) {
 	cmd.Flags().Uint64P("owner_weight", "w", 0, "weight of owner")
 	cmd.MarkFlagRequired("owner_weight")
 
+	addTimeLockFlags(cmd)
 }
 
 func createOwnerAddTransfer(cmd *cobra.Command, args []string) {
@@ -238,12 +241,15 @@ func createOwnerAddTransfer(cmd *cobra.Command, args []string) {
 	multiSigAddr, _ := cmd.Flags().GetString("multisig_addr")
 	ownerAddr, _ := cmd.Flags().GetString("owner_addr")
 	ownerWeight, _ := cmd.Flags().GetUint64("owner_weight")
+	executeAfter, expireAt := getTimeLockFlags(cmd)
 
 	params := &mty.MultiSigOwnerOperate{
 		MultiSigAccAddr: multiSigAddr,
 		NewOwner:        ownerAddr,
 		NewWeight:       ownerWeight,
 		OperateFlag:     mty.OwnerAdd,
+		ExecuteAfter:    executeAfter,
+		ExpireAt:        expireAt,
 	}
 	var res string
 	ctx := jsonclient.NewRPCCtx(rpcLaddr, "multisig.MultiSigOwnerOperateTx", params, &res)
@@ -268,17 +274,22 @@ func createOwnerDelTransferFlags(cmd *cobra.Command) {
 
 	cmd.Flags().StringP("owner_addr", "o", "", "address of owner")
 	cmd.MarkFlagRequired("owner_addr")
+
+	addTimeLockFlags(cmd)
 }
 
 func createOwnerDelTransfer(cmd *cobra.Command, args []string) {
 	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
 	multiSigAddr, _ := cmd.Flags().GetString("multisig_addr")
 	ownerAddr, _ := cmd.Flags().GetString("owner_addr")
+	executeAfter, expireAt := getTimeLockFlags(cmd)
 
 	params := &mty.MultiSigOwnerOperate{
 		MultiSigAccAddr: multiSigAddr,
 		OldOwner:        ownerAddr,
 		OperateFlag:     mty.OwnerDel,
+		ExecuteAfter:    executeAfter,
+		ExpireAt:        expireAt,
 	}
 	var res string
 	ctx := jsonclient.NewRPCCtx(rpcLaddr, "multisig.MultiSigOwnerOperateTx", params, &res)
@@ -304,6 +315,8 @@ func createOwnerModifyTransferFlags(cmd *cobra.Command) {
 	cmd.MarkFlagRequired("owner_addr")
 	cmd.Flags().Uint64P("owner_weight", "w", 0, "new weight of owner")
 	cmd.MarkFlagRequired("owner_weight")
+
+	addTimeLockFlags(cmd)
 }
 
 func createOwnerModifyTransfer(cmd *cobra.Command, args []string) {
@@ -311,12 +324,15 @@ func createOwnerModifyTransfer(cmd *cobra.Command, args []string) {
 	multiSigAddr, _ := cmd.Flags().GetString("multisig_addr")
 	ownerAddr, _ := cmd.Flags().GetString("owner_addr")
 	ownerWeight, _ := cmd.Flags().GetUint64("owner_weight")
+	executeAfter, expireAt := getTimeLockFlags(cmd)
 
 	params := &mty.MultiSigOwnerOperate{
 		MultiSigAccAddr: multiSigAddr,
 		OldOwner:        ownerAddr,
 		NewWeight:       ownerWeight,
 		OperateFlag:     mty.OwnerModify,
+		ExecuteAfter:    executeAfter,
+		ExpireAt:        expireAt,
 	}
 	var res string
 	ctx := jsonclient.NewRPCCtx(rpcLaddr, "multisig.MultiSigOwnerOperateTx", params, &res)
@@ -342,6 +358,8 @@ func createOwnerReplaceTransferFlags(cmd *cobra.Command) {
 	cmd.MarkFlagRequired("owner_addr")
 	cmd.Flags().StringP("new_owner", "n", "", "address of new owner")
 	cmd.MarkFlagRequired("new_owner")
+
+	addTimeLockFlags(cmd)
 }
 
 func createOwnerReplaceTransfer(cmd *cobra.Command, args []string) {
@@ -349,12 +367,15 @@ func createOwnerReplaceTransfer(cmd *cobra.Command, args []string) {
 	multiSigAddr, _ := cmd.Flags().GetString("multisig_addr")
 	ownerAddr, _ := cmd.Flags().GetString("owner_addr")
 	newOwner, _ := cmd.Flags().GetString("new_owner")
+	executeAfter, expireAt := getTimeLockFlags(cmd)
 
 	params := &mty.MultiSigOwnerOperate{
 		MultiSigAccAddr: multiSigAddr,
 		OldOwner:        ownerAddr,
 		NewOwner:        newOwner,
 		OperateFlag:     mty.OwnerReplace,
+		ExecuteAfter:    executeAfter,
+		ExpireAt:        expireAt,
 	}
 	var res string
 	ctx := jsonclient.NewRPCCtx(rpcLaddr, "multisig.MultiSigOwnerOperateTx", params, &res)
@@ -377,17 +398,22 @@ func createMultiSigAccWeightModifyTransferFlags(cmd *cobra.Command) {
 	cmd.Flags().StringP("multisig_addr", "a", "", "address of multisig account")
 	cmd.MarkFlagRequired("multisig_addr")
 	cmd.Flags().Uint64P("weight", "w", 0, "new required weight of multisig account ")
+
+	addTimeLockFlags(cmd)
 }
 
 func createMultiSigAccWeightModifyTransfer(cmd *cobra.Command, args []string) {
 	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
 	multiSigAddr, _ := cmd.Flags().GetString("multisig_addr")
 	weight, _ := cmd.Flags().GetUint64("weight")
+	executeAfter, expireAt := getTimeLockFlags(cmd)
 
 	params := &mty.MultiSigAccOperate{
 		MultiSigAccAddr:   multiSigAddr,
 		NewRequiredWeight: weight,
 		OperateFlag:       mty.AccWeightOp,
+		ExecuteAfter:      executeAfter,
+		ExpireAt:          expireAt,
 	}
 	var res string
 	ctx := jsonclient.NewRPCCtx(rpcLaddr, "multisig.MultiSigAccOperateTx", params, &res)
@@ -418,6 +444,8 @@ func createMultiSigAccDailyLimitModifyTransferFlags(cmd *cobra.Command) {
 
 	cmd.Flags().Float64P("daily_limit", "d", 0, "daily_limit of assets ")
 	cmd.MarkFlagRequired("daily_limit")
+
+	addTimeLockFlags(cmd)
 }
 
 func createMultiSigAccDailyLimitModifyTransfer(cmd *cobra.Command, args []string) {
@@ -426,6 +454,7 @@ func createMultiSigAccDailyLimitModifyTransfer(cmd *cobra.Command, args []string
 	execer, _ := cmd.Flags().GetString("execer")
 	symbol, _ := cmd.Flags().GetString("symbol")
 	dailylimit, _ := cmd.Flags().GetFloat64("daily_limit")
+	executeAfter, expireAt := getTimeLockFlags(cmd)
 	cfg, err := commandtypes.GetChainConfig(rpcLaddr)
 	if err != nil {
 		fmt.Fprintln(os.Stderr, errors.Wrapf(err, "GetChainConfig"))
@@ -451,6 +480,8 @@ func createMultiSigAccDailyLimitModifyTransfer(cmd *cobra.Command, args []string
 		MultiSigAccAddr: multiSigAddr,
 		DailyLimit:      assetsDailyLimit,
 		OperateFlag:     mty.AccDailyLimitOp,
+		ExecuteAfter:    executeAfter,
+		ExpireAt:        expireAt,
 	}
 	var res string
 	ctx := jsonclient.NewRPCCtx(rpcLaddr, "multisig.MultiSigAccOperateTx", params, &res)
@@ -502,6 +533,61 @@ func createMultiSigConfirmTransfer(cmd *cobra.Command, args []string) {
 	ctx.RunWithoutMarshal()
 }
 
+// CreateMultiSigExecTxCmd create raw MultiSigExecTx transaction
+func CreateMultiSigExecTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exec",
+		Short: "Create a transaction executing other contract's tx from multisig account",
+		Run:   createMultiSigExecTx,
+	}
+	createMultiSigExecTxFlags(cmd)
+	return cmd
+}
+
+func createMultiSigExecTxFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("multisig_addr", "a", "", "address of multisig account")
+	cmd.MarkFlagRequired("multisig_addr")
+
+	cmd.Flags().StringP("tx", "t", "", "unsigned raw tx to execute from multisig account")
+	cmd.MarkFlagRequired("tx")
+
+	cmd.Flags().StringP("create_hash", "c", "", "hash of the multisig account create tx, only required by old accounts")
+
+	addTimeLockFlags(cmd)
+}
+
+func createMultiSigExecTx(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	multiSigAddr, _ := cmd.Flags().GetString("multisig_addr")
+	rawTx, _ := cmd.Flags().GetString("tx")
+	createHash, _ := cmd.Flags().GetString("create_hash")
+	executeAfter, expireAt := getTimeLockFlags(cmd)
+
+	data, err := common.FromHex(rawTx)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	var tx types.Transaction
+	err = types.Decode(data, &tx)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	params := &mty.MultiSigExecTx{
+		MultiSigAccAddr: multiSigAddr,
+		Execer:          string(tx.Execer),
+		Payload:         tx.Payload,
+		To:              tx.To,
+		CreateTxHash:    createHash,
+		ExecuteAfter:    executeAfter,
+		ExpireAt:        expireAt,
+	}
+	var res string
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "multisig.MultiSigExecTx", params, &res)
+	ctx.RunWithoutMarshal()
+}
+
 // CreateMultiSigAccTransferInCmd create raw MultiSigAccTransferInCmd transaction
 func CreateMultiSigAccTransferInCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -592,6 +678,8 @@ func createMultiSigAccTransferOutFlags(cmd *cobra.Command) {
 
 	cmd.Flags().Float64P("amount", "a", 0, "transaction amount")
 	cmd.MarkFlagRequired("amount")
+
+	addTimeLockFlags(cmd)
 }
 
 func createMultiSigAccTransferOut(cmd *cobra.Command, args []string) {
@@ -602,6 +690,7 @@ func createMultiSigAccTransferOut(cmd *cobra.Command, args []string) {
 	symbol, _ := cmd.Flags().GetString("symbol")
 	note, _ := cmd.Flags().GetString("note")
 	amount, _ := cmd.Flags().GetFloat64("amount")
+	executeAfter, expireAt := getTimeLockFlags(cmd)
 
 	if float64(types.MaxCoin) < amount {
 		fmt.Fprintln(os.Stderr, types.ErrAmount)
@@ -618,12 +707,14 @@ func createMultiSigAccTransferOut(cmd *cobra.Command, args []string) {
 		return
 	}
 	params := &mty.MultiSigExecTransferFrom{
-		Symbol:   symbol,
-		Amount:   amountInt64,
-		Note:     note,
-		Execname: execer,
-		From:     from,
-		To:       to,
+		Symbol:       symbol,
+		Amount:       amountInt64,
+		Note:         note,
+		Execname:     execer,
+		From:         from,
+		To:           to,
+		ExecuteAfter: executeAfter,
+		ExpireAt:     expireAt,
 	}
 	var res string
 	ctx := jsonclient.NewRPCCtx(rpcLaddr, "multisig.MultiSigAccTransferOutTx", params, &res)
@@ -832,6 +923,8 @@ func getMultiSigTxidsCmdFlags(cmd *cobra.Command) {
 
 	cmd.Flags().StringP("executed", "x", "t", "whether executed tx (0/f/false for No; 1/t/true for Yes)")
 
+	cmd.Flags().StringP("expired", "r", "f", "whether expired tx (0/f/false for No; 1/t/true for Yes)")
+
 }
 
 func getMultiSigTxids(cmd *cobra.Command, args []string) {
@@ -859,12 +952,20 @@ func getMultiSigTxids(cmd *cobra.Command, args []string) {
 		return
 	}
 
+	expired, _ := cmd.Flags().GetString("expired")
+	expiredBool, err := strconv.ParseBool(expired)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+
 	req := mty.ReqMultiSigTxids{
 		MultiSigAddr: addr,
 		FromTxId:     start,
 		ToTxId:       end,
 		Pending:      pendingBool,
 		Executed:     executedBool,
+		Expired:      expiredBool,
 	}
 
 	var params rpctypes.Query4Jrpc
@@ -1188,3 +1289,15 @@ func getMultiSigAccByOwner(cmd *cobra.Command, args []string) {
 	ctx := jsonclient.NewRPCCtx(rpcLaddr, "multisig.MultiSigAddresList", params, &res)
 	ctx.Run()
 }
+
+//addTimeLockFlags 提交多重签名交易时可以设置的执行高度和过期高度
+func addTimeLockFlags(cmd *cobra.Command) {
+	cmd.Flags().Int64("execute_after", 0, "block height after which the tx can be executed, 0 for no limit")
+	cmd.Flags().Int64("expire_at", 0, "block height after which the pending tx expires, 0 for never")
+}
+
+func getTimeLockFlags(cmd *cobra.Command) (int64, int64) {
+	executeAfter, _ := cmd.Flags().GetInt64("execute_after")
+	expireAt, _ := cmd.Flags().GetInt64("expire_at")
+	return executeAfter, expireAt
+}
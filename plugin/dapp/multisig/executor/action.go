@@ -5,12 +5,14 @@
 package executor
 
 import (
+	"encoding/binary"
 	"encoding/hex"
 
 	"github.com/33cn/chain33/system/address/btc"
 
 	"github.com/33cn/chain33/account"
 	"github.com/33cn/chain33/client"
+	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/common/address"
 	dbm "github.com/33cn/chain33/common/db"
 	"github.com/33cn/chain33/system/dapp"
@@ -30,13 +32,15 @@ type action struct {
 	index        int32
 	execaddr     string
 	api          client.QueueProtocolAPI
+	driver       *MultiSig
+	fee          int64
 }
 
 func newAction(t *MultiSig, tx *types.Transaction, index int32) *action {
 	hash := tx.Hash()
 	fromaddr := tx.From()
 	return &action{t.GetCoinsAccount(), t.GetStateDB(), t.GetLocalDB(), hash, fromaddr,
-		t.GetBlockTime(), t.GetHeight(), index, dapp.ExecAddress(string(tx.Execer)), t.GetAPI()}
+		t.GetBlockTime(), t.GetHeight(), index, dapp.ExecAddress(string(tx.Execer)), t.GetAPI(), t, tx.Fee}
 }
 
 //MultiSigAccCreate 创建多重签名账户
@@ -102,6 +106,10 @@ func (a *action) MultiSigAccCreate(accountCreate *mty.MultiSigAccCreate) (*types
 	}
 
 	multiSigAccount.MultiSigAddr = addr
+	//分叉之后记录创建交易的哈希，用于MultiSigExecTx构造以多重签名账户为发送者的交易
+	if a.api.GetConfig().IsDappFork(a.height, mty.MultiSigX, mty.ForkMultiSigExecTxX) {
+		multiSigAccount.CreateTxHash = hex.EncodeToString(a.txhash)
+	}
 	receiptLog := &types.ReceiptLog{}
 	receiptLog.Ty = mty.TyLogMultiSigAccCreate
 	receiptLog.Log = types.Encode(multiSigAccount)
@@ -160,6 +168,10 @@ func (a *action) MultiSigAccOperate(AccountOperate *mty.MultiSigAccOperate) (*ty
 	confirmOwner := &mty.Owner{OwnerAddr: owneraddr, Weight: ownerWeight}
 	newMultiSigTx.ConfirmedOwner = append(newMultiSigTx.ConfirmedOwner, confirmOwner)
 
+	if err := a.setTimeLock(newMultiSigTx, AccountOperate); err != nil {
+		return nil, err
+	}
+
 	return a.executeAccOperateTx(multiSigAccount, newMultiSigTx, AccountOperate, confirmOwner, true)
 }
 
@@ -197,9 +209,59 @@ func (a *action) MultiSigOwnerOperate(AccOwnerOperate *mty.MultiSigOwnerOperate)
 	confirmOwner := &mty.Owner{OwnerAddr: owneraddr, Weight: ownerWeight}
 	newMultiSigTx.ConfirmedOwner = append(newMultiSigTx.ConfirmedOwner, confirmOwner)
 
+	if err := a.setTimeLock(newMultiSigTx, AccOwnerOperate); err != nil {
+		return nil, err
+	}
+
 	return a.executeOwnerOperateTx(multiSigAccount, newMultiSigTx, AccOwnerOperate, confirmOwner, true)
 }
 
+//MultiSigExecTx 提交以多重签名账户为发送者执行其他合约的交易，权重满足时直接执行，否则只记录交易信息等待其他owner确认
+func (a *action) MultiSigExecTx(execTx *mty.MultiSigExecTx) (*types.Receipt, error) {
+	if execTx == nil {
+		return nil, types.ErrInvalidParam
+	}
+	//首先从statedb中获取MultiSigAccAddr的状态信息
+	multiSigAccAddr := execTx.MultiSigAccAddr
+	multiSigAcc, err := getMultiSigAccFromDb(a.db, multiSigAccAddr)
+	if err != nil {
+		multisiglog.Error("MultiSigExecTx:getMultiSigAccFromDb", "MultiSigAccAddr", multiSigAccAddr, "err", err)
+		return nil, err
+	}
+	if multiSigAcc == nil {
+		multisiglog.Error("MultiSigExecTx:getMultiSigAccFromDb is nil", "MultiSigAccAddr", multiSigAccAddr)
+		return nil, types.ErrAccountNotExist
+	}
+
+	//校验交易提交者是否是本账户的owner
+	owneraddr := a.fromaddr
+	ownerWeight, isowner := isOwner(multiSigAcc, owneraddr)
+	if !isowner {
+		return nil, mty.ErrIsNotOwner
+	}
+	//提交时就校验创建交易的哈希，避免确认阶段才发现无法执行
+	if _, err := getCreateTxHash(multiSigAcc, execTx); err != nil {
+		return nil, err
+	}
+
+	//生成新的txid,并将此交易信息添加到Txs列表中
+	txID := multiSigAcc.TxCount
+	newMultiSigTx := &mty.MultiSigTx{}
+	newMultiSigTx.Txid = txID
+	newMultiSigTx.TxHash = hex.EncodeToString(a.txhash)
+	newMultiSigTx.Executed = false
+	newMultiSigTx.TxType = mty.ExecOperate
+	newMultiSigTx.MultiSigAddr = multiSigAccAddr
+	confirmOwner := &mty.Owner{OwnerAddr: owneraddr, Weight: ownerWeight}
+	newMultiSigTx.ConfirmedOwner = append(newMultiSigTx.ConfirmedOwner, confirmOwner)
+
+	if err := a.setTimeLock(newMultiSigTx, execTx); err != nil {
+		return nil, err
+	}
+
+	return a.executeExecTx(multiSigAcc, newMultiSigTx, execTx, confirmOwner, mty.IsSubmit)
+}
+
 //MultiSigExecTransferFrom 首先判断转账的额度是否大于每日限量，小于就直接执行交易，调用ExecTransferFrozen进行转账
 //大于每日限量只需要将交易信息记录
 //合约中多重签名账户转账到外部账户，multiSigAddr--->Addr
@@ -243,6 +305,10 @@ func (a *action) MultiSigExecTransferFrom(multiSigAccTransfer *mty.MultiSigExecT
 	confirmOwner := &mty.Owner{OwnerAddr: owneraddr, Weight: ownerWeight}
 	newMultiSigTx.ConfirmedOwner = append(newMultiSigTx.ConfirmedOwner, confirmOwner)
 
+	if err := a.setTimeLock(newMultiSigTx, multiSigAccTransfer); err != nil {
+		return nil, err
+	}
+
 	//确认并执行此交易
 	return a.executeTransferTx(multiSigAcc, newMultiSigTx, multiSigAccTransfer, confirmOwner, mty.IsSubmit)
 }
@@ -336,12 +402,21 @@ func (a *action) MultiSigConfirmTx(ConfirmTx *mty.MultiSigConfirmTx) (*types.Rec
 	if multiSigTx.Executed {
 		return nil, mty.ErrTxHasExecuted
 	}
+	//已经过期的交易不可以再确认/撤销
+	if isExpired(multiSigTx, a.height) {
+		return nil, mty.ErrTxExpired
+	}
 	//此owneraddr是否已经确认过此txid对应的交易
 	findindex, exist := isOwnerConfirmedTx(multiSigTx, owneraddr)
 
-	//不能重复确认同一笔交易直接返回
+	//不能重复确认同一笔交易直接返回，设置了executeAfter的交易在权重满足并且时间锁到期后可以由已确认的owner触发执行
+	executeOnly := false
 	if exist && ConfirmTx.ConfirmOrRevoke {
-		return nil, mty.ErrDupConfirmed
+		if !a.api.GetConfig().IsDappFork(a.height, mty.MultiSigX, mty.ForkMultiSigTimeLockX) || multiSigTx.ExecuteAfter <= 0 ||
+			!isConfirmed(multiSigAcc.RequiredWeight, multiSigTx) || !isUnlocked(multiSigTx, a.height) {
+			return nil, mty.ErrDupConfirmed
+		}
+		executeOnly = true
 	}
 	//需要撤销的确认信息没有找到直接返回
 	if !exist && !ConfirmTx.ConfirmOrRevoke {
@@ -359,7 +434,7 @@ func (a *action) MultiSigConfirmTx(ConfirmTx *mty.MultiSigConfirmTx) (*types.Rec
 	}
 
 	multiSigTxOwner := &mty.MultiSigTxOwner{MultiSigAddr: multiSigAccAddr, Txid: ConfirmTx.TxId, ConfirmedOwner: owner}
-	isConfirm := isConfirmed(multiSigAcc.RequiredWeight, multiSigTx)
+	isConfirm := isConfirmed(multiSigAcc.RequiredWeight, multiSigTx) && isUnlocked(multiSigTx, a.height)
 
 	//权重未达到要求或者撤销确认交易，构造MultiSigConfirmTx的receiptLog
 	if !isConfirm || !ConfirmTx.ConfirmOrRevoke {
@@ -374,6 +449,10 @@ func (a *action) MultiSigConfirmTx(ConfirmTx *mty.MultiSigConfirmTx) (*types.Rec
 	if err != nil {
 		return nil, err
 	}
+	//只触发执行时不增加确认owner
+	if executeOnly {
+		owner = nil
+	}
 
 	//根据不同的交易类型调用各自的处理函数，区分 操作owner/account 和转账的交易
 	if multiSigTx.TxType == mty.OwnerOperate && payload != nil {
@@ -385,6 +464,9 @@ func (a *action) MultiSigConfirmTx(ConfirmTx *mty.MultiSigConfirmTx) (*types.Rec
 	} else if multiSigTx.TxType == mty.TransferOperate {
 		transfer := payload.GetMultiSigExecTransferFrom()
 		return a.executeTransferTx(multiSigAcc, multiSigTx, transfer, owner, mty.IsConfirm)
+	} else if multiSigTx.TxType == mty.ExecOperate {
+		execTx := payload.GetMultiSigExecTx()
+		return a.executeExecTx(multiSigAcc, multiSigTx, execTx, owner, mty.IsConfirm)
 	}
 	multisiglog.Error("MultiSigConfirmTx:GetMultiSigTx", "multiSigAccAddr", multiSigAccAddr, "Confirm TxId", ConfirmTx.TxId, "TxType unknown", multiSigTx.TxType)
 	return nil, mty.ErrTxTypeNoMatch
@@ -706,6 +788,7 @@ func (a *action) receiptTxCountUpdate(multiSigAccAddr string) (*types.KeyValue,
 }
 
 //组装MultiSigAccTx的receipt信息
+//owner为nil表示已确认的owner在时间锁到期后触发执行
 func (a *action) receiptMultiSigTx(multiSigTx *mty.MultiSigTx, owner *mty.Owner, prevExecutes, subOrConfirm bool) (*types.KeyValue, *types.ReceiptLog) {
 	receiptLog := &types.ReceiptLog{}
 
@@ -717,9 +800,12 @@ func (a *action) receiptMultiSigTx(multiSigTx *mty.MultiSigTx, owner *mty.Owner,
 	receiptLogTx.PrevExecuted = prevExecutes
 	receiptLogTx.CurExecuted = multiSigTx.Executed
 	receiptLogTx.SubmitOrConfirm = subOrConfirm
+	receiptLogTx.ExecuteOnly = owner == nil
 	if subOrConfirm {
 		receiptLogTx.TxHash = multiSigTx.TxHash
 		receiptLogTx.TxType = multiSigTx.TxType
+		receiptLogTx.ExecuteAfter = multiSigTx.ExecuteAfter
+		receiptLogTx.ExpireAt = multiSigTx.ExpireAt
 	}
 
 	receiptLog.Ty = mty.TyLogMultiSigTx
@@ -767,7 +853,8 @@ func (a *action) executeTransferTx(multiSigAcc *mty.MultiSig, newMultiSigTx *mty
 	var kv []*types.KeyValue
 
 	//权重满足或者小于每日限额，允许执行此交易，如果转账交易执行失败，不应该直接返回，需要继续更新多重签名账户和tx列表的状态信息
-	if confirmed || underLimit {
+	//设置了executeAfter的交易在到达此高度之前都不执行
+	if isUnlocked(newMultiSigTx, a.height) && (confirmed || underLimit) {
 
 		//执行此交易，从多重签名账户转币到指定账户，在multiSig合约中转账
 		symbol := getRealSymbol(transfer.Symbol)
@@ -833,7 +920,7 @@ func (a *action) executeTransferTx(multiSigAcc *mty.MultiSig, newMultiSigTx *mty
 func (a *action) executeAccOperateTx(multiSigAcc *mty.MultiSig, newMultiSigTx *mty.MultiSigTx, accountOperate *mty.MultiSigAccOperate, confOwner *mty.Owner, subOrConfirm bool) (*types.Receipt, error) {
 
 	//确认权重是否已达到要求
-	confirmed := isConfirmed(multiSigAcc.RequiredWeight, newMultiSigTx)
+	confirmed := isConfirmed(multiSigAcc.RequiredWeight, newMultiSigTx) && isUnlocked(newMultiSigTx, a.height)
 	prevExecuted := newMultiSigTx.Executed
 
 	var logs []*types.ReceiptLog
@@ -894,7 +981,7 @@ func (a *action) executeOwnerOperateTx(multiSigAccount *mty.MultiSig, newMultiSi
 	var receiptLog *types.ReceiptLog
 	var err error
 	//确认权重是否已达到要求
-	confirmed := isConfirmed(multiSigAccount.RequiredWeight, newMultiSigTx)
+	confirmed := isConfirmed(multiSigAccount.RequiredWeight, newMultiSigTx) && isUnlocked(newMultiSigTx, a.height)
 	prevExecuted := newMultiSigTx.Executed
 
 	flag := accountOperate.OperateFlag
@@ -962,6 +1049,165 @@ func (a *action) executeOwnerOperateTx(multiSigAccount *mty.MultiSig, newMultiSi
 	}, nil
 }
 
+//设置提交交易的executeAfter和expireAt，分叉之前忽略
+func (a *action) setTimeLock(multiSigTx *mty.MultiSigTx, lock timeLocker) error {
+	if !a.api.GetConfig().IsDappFork(a.height, mty.MultiSigX, mty.ForkMultiSigTimeLockX) {
+		return nil
+	}
+	if err := checkTimeLock(lock.GetExecuteAfter(), lock.GetExpireAt(), a.height); err != nil {
+		return err
+	}
+	multiSigTx.ExecuteAfter = lock.GetExecuteAfter()
+	multiSigTx.ExpireAt = lock.GetExpireAt()
+	return nil
+}
+
+//确认并执行以多重签名账户为发送者的交易：区分submitTx和confirmtx阶段。
+func (a *action) executeExecTx(multiSigAcc *mty.MultiSig, newMultiSigTx *mty.MultiSigTx, execTx *mty.MultiSigExecTx, confOwner *mty.Owner, subOrConfirm bool) (*types.Receipt, error) {
+	var logs []*types.ReceiptLog
+	var kv []*types.KeyValue
+
+	if execTx == nil {
+		return nil, mty.ErrInvalidExecTx
+	}
+	//确认权重是否已达到要求
+	confirmed := isConfirmed(multiSigAcc.RequiredWeight, newMultiSigTx) && isUnlocked(newMultiSigTx, a.height)
+	prevExecuted := newMultiSigTx.Executed
+
+	//权重满足，以多重签名账户为发送者执行交易
+	if confirmed {
+		receipt, err := a.execInnerTx(multiSigAcc, newMultiSigTx, execTx)
+		if err != nil {
+			multisiglog.Error("executeExecTx:execInnerTx", "multiSigAddr", multiSigAcc.MultiSigAddr, "txid", newMultiSigTx.Txid, "err", err)
+			return nil, err
+		}
+		logs = append(logs, receipt.Logs...)
+		kv = append(kv, receipt.KV...)
+
+		//标识此交易已经被执行
+		newMultiSigTx.Executed = true
+	}
+
+	//更新multiSigAcc状态:txcount有增加在submit阶段
+	if subOrConfirm {
+		keyvalue, receiptlog, err := a.receiptTxCountUpdate(multiSigAcc.MultiSigAddr)
+		if err != nil {
+			multisiglog.Error("executeExecTx:receiptTxCountUpdate", "error", err)
+			return nil, err
+		}
+		kv = append(kv, keyvalue)
+		logs = append(logs, receiptlog)
+	}
+	//更新newMultiSigTx的状态：MultiSigTx增加一个确认owner，交易的执行状态可能有更新
+	keyvaluetx, receiptlogtx := a.receiptMultiSigTx(newMultiSigTx, confOwner, prevExecuted, subOrConfirm)
+	logs = append(logs, receiptlogtx)
+	kv = append(kv, keyvaluetx)
+
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+//获取多重签名账户的创建交易哈希，并校验和多重签名地址是否匹配
+func getCreateTxHash(multiSigAcc *mty.MultiSig, execTx *mty.MultiSigExecTx) ([]byte, error) {
+	hashStr := multiSigAcc.CreateTxHash
+	if hashStr == "" {
+		hashStr = execTx.CreateTxHash
+	}
+	hash, err := common.FromHex(hashStr)
+	if err != nil || len(hash) == 0 {
+		return nil, mty.ErrCreateTxHashNoMatch
+	}
+	if btc.FormatBtcAddr(address.MultiSignVer, hash) != multiSigAcc.MultiSigAddr {
+		return nil, mty.ErrCreateTxHashNoMatch
+	}
+	return hash, nil
+}
+
+//构造以多重签名账户为发送者的交易，签名的公钥是创建交易的哈希，使得交易的from地址就是多重签名地址
+//nonce取自提交交易的哈希，保证不同提交生成的交易哈希不同
+//交易费取自触发执行的交易(已经由触发执行的owner支付)
+func (a *action) newInnerTx(multiSigAcc *mty.MultiSig, multiSigTx *mty.MultiSigTx, execTx *mty.MultiSigExecTx) (*types.Transaction, error) {
+	createHash, err := getCreateTxHash(multiSigAcc, execTx)
+	if err != nil {
+		return nil, err
+	}
+	submitHash, err := common.FromHex(multiSigTx.TxHash)
+	if err != nil || len(submitHash) < 8 {
+		return nil, mty.ErrTxHashNoMatch
+	}
+	to := execTx.To
+	if to == "" {
+		to = dapp.ExecAddress(execTx.Execer)
+	}
+	tx := &types.Transaction{
+		Execer:  []byte(execTx.Execer),
+		Payload: execTx.Payload,
+		To:      to,
+		Fee:     a.fee,
+		Nonce:   int64(binary.BigEndian.Uint64(submitHash[:8]) >> 1),
+		ChainID: a.api.GetConfig().GetChainID(),
+		Signature: &types.Signature{
+			Ty:     types.EncodeSignID(types.SECP256K1, btc.MultiSignAddressID),
+			Pubkey: createHash,
+		},
+	}
+	if tx.From() != multiSigAcc.MultiSigAddr {
+		return nil, mty.ErrCreateTxHashNoMatch
+	}
+	return tx, nil
+}
+
+//加载交易对应的执行器并执行，执行器的运行环境和multisig合约保持一致
+//被执行的合约需要在IsFriend中允许multisig交易写入自己的数据，只允许mty.IsExecTxAllowed中的合约和action
+func (a *action) execInnerTx(multiSigAcc *mty.MultiSig, multiSigTx *mty.MultiSigTx, execTx *mty.MultiSigExecTx) (*types.Receipt, error) {
+	if !mty.IsExecTxAllowed(execTx.Execer, execTx.Payload) {
+		return nil, mty.ErrInvalidExecTx
+	}
+	tx, err := a.newInnerTx(multiSigAcc, multiSigTx, execTx)
+	if err != nil {
+		return nil, err
+	}
+	m := a.driver
+	driver, err := dapp.LoadDriverWithClient(a.api, execTx.Execer, a.height)
+	if err != nil {
+		return nil, err
+	}
+	driver.SetEnv(a.height, 0, 0)
+	if err := driver.Allow(tx, 0); err != nil {
+		return nil, err
+	}
+	driver.SetName(string(types.GetRealExecName(tx.Execer)))
+	driver.SetCurrentExecName(execTx.Execer)
+	driver.SetCoinsAccount(m.GetCoinsAccount())
+	driver.SetStateDB(m.GetStateDB())
+	driver.SetLocalDB(m.GetLocalDB())
+	driver.SetEnv(m.GetHeight(), m.GetBlockTime(), m.GetDifficulty())
+	driver.SetBlockInfo(m.GetParentHash(), m.GetLastHash(), m.GetMainHeight())
+	driver.SetExecutorAPI(a.api, nil)
+	driver.SetTxs([]*types.Transaction{tx})
+	driver.SetReceipt(nil)
+
+	if err := driver.CheckTx(tx, 0); err != nil {
+		return nil, err
+	}
+	receipt, err := driver.Exec(tx, 0)
+	if err != nil {
+		return nil, err
+	}
+	if receipt == nil {
+		receipt = &types.Receipt{}
+	} else if receipt.Ty != types.ExecOk {
+		return nil, mty.ErrExecTxFailed
+	}
+	execLog := &mty.ReceiptExecTx{
+		MultiSigAddr: multiSigAcc.MultiSigAddr,
+		Txid:         multiSigTx.Txid,
+		Execer:       execTx.Execer,
+		TxHash:       hex.EncodeToString(tx.Hash()),
+	}
+	logs := append(receipt.Logs, &types.ReceiptLog{Ty: mty.TyLogMultiSigExecTx, Log: types.Encode(execLog)})
+	return &types.Receipt{Ty: types.ExecOk, KV: receipt.KV, Logs: logs}, nil
+}
+
 //构造确认交易的receiptLog
 func (a *action) confirmTransaction(multiSigTx *mty.MultiSigTx, multiSigTxOwner *mty.MultiSigTxOwner, ConfirmOrRevoke bool) (*types.Receipt, error) {
 	receiptLog := &types.ReceiptLog{}
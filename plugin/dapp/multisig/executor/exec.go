@@ -44,3 +44,13 @@ func (m *MultiSig) Exec_MultiSigExecTransferFrom(payload *mty.MultiSigExecTransf
 	action := newAction(m, tx, int32(index))
 	return action.MultiSigExecTransferFrom(payload)
 }
+
+//Exec_MultiSigExecTx 提交以多重签名账户为发送者执行其他合约的交易
+func (m *MultiSig) Exec_MultiSigExecTx(payload *mty.MultiSigExecTx, tx *types.Transaction, index int) (*types.Receipt, error) {
+	cfg := m.GetAPI().GetConfig()
+	if !cfg.IsDappFork(m.GetHeight(), mty.MultiSigX, mty.ForkMultiSigExecTxX) {
+		return nil, types.ErrActionNotSupport
+	}
+	action := newAction(m, tx, int32(index))
+	return action.MultiSigExecTx(payload)
+}
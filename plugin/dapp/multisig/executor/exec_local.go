@@ -91,3 +91,19 @@ func (m *MultiSig) ExecLocal_MultiSigExecTransferFrom(payload *mty.MultiSigExecT
 	}
 	return &types.LocalDBSet{KV: kv}, nil
 }
+
+//ExecLocal_MultiSigExecTx 以多重签名账户为发送者执行其他合约的交易
+//框架只允许写入LODB-multisig-前缀的本地数据，被执行合约自身的ExecLocal数据无法在这里写入，
+//这里只更新multisig的交易索引，被执行合约的日志保存在交易回执中
+func (m *MultiSig) ExecLocal_MultiSigExecTx(payload *mty.MultiSigExecTx, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	if receiptData.GetTy() != types.ExecOk {
+		return &types.LocalDBSet{}, nil
+	}
+
+	kv, err := m.execLocalMultiSigReceipt(receiptData, tx, true)
+	if err != nil {
+		multisiglog.Error("ExecLocal_MultiSigExecTx", "err", err)
+		return nil, err
+	}
+	return &types.LocalDBSet{KV: kv}, nil
+}
@@ -5,6 +5,7 @@
 package executor
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/33cn/chain33/system/address/btc"
@@ -13,6 +14,8 @@ import (
 	"github.com/33cn/chain33/common"
 	commonlog "github.com/33cn/chain33/common/log"
 	drivers "github.com/33cn/chain33/system/dapp"
+	coins "github.com/33cn/chain33/system/dapp/coins/executor"
+	cty "github.com/33cn/chain33/system/dapp/coins/types"
 	"github.com/stretchr/testify/assert"
 
 	apimock "github.com/33cn/chain33/client/mocks"
@@ -21,8 +24,14 @@ import (
 	dbm "github.com/33cn/chain33/common/db"
 	dbmock "github.com/33cn/chain33/common/db/mocks"
 	"github.com/33cn/chain33/types"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
 	mty "github.com/33cn/plugin/plugin/dapp/multisig/types"
+	paracross "github.com/33cn/plugin/plugin/dapp/paracross/executor"
+	pt "github.com/33cn/plugin/plugin/dapp/paracross/types"
+	tokenty "github.com/33cn/plugin/plugin/dapp/token/types"
 	"github.com/stretchr/testify/mock"
+
+	_ "github.com/33cn/plugin/plugin/crypto/bls"
 )
 
 type execEnv struct {
@@ -64,6 +73,8 @@ func init() {
 	commonlog.SetLogLevel("debug")
 	types.AllowUserExec = append(types.AllowUserExec, []byte("coins"))
 	Init(mty.MultiSigX, chainTestCfg, nil)
+	coins.Init(cty.CoinsX, chainTestCfg, nil)
+	paracross.Init(pt.ParaX, chainTestCfg, nil)
 }
 
 //创建一个多重签名的账户
@@ -154,6 +165,206 @@ func TestMultiSigAccCreate(t *testing.T) {
 
 }
 
+//以多重签名账户为发送者执行paracross转账交易
+func TestMultiSigExecTx(t *testing.T) {
+	env := execEnv{
+		1539918074,
+		chainTestCfg.GetDappFork("multisig", "ForkMultiSigV1"),
+		2,
+		1539918074,
+		"hash",
+	}
+
+	stateDB, _ := dbm.NewGoMemDB("state", "state", 100)
+	localDB := new(dbmock.KVDB)
+	api := new(apimock.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(chainTestCfg, nil)
+
+	coinsAccount := account.NewCoinsAccount(chainTestCfg)
+	coinsAccount.SetDB(stateDB)
+
+	driver := newMultiSig()
+	driver.SetEnv(env.blockHeight, env.blockTime, env.difficulty)
+	driver.SetAPI(api)
+	driver.SetStateDB(stateDB)
+	driver.SetLocalDB(localDB)
+	driver.SetCoinsAccount(coinsAccount)
+
+	multiSigAddr, err := testMultiSigAccCreate(t, driver, env, localDB)
+	assert.Nil(t, err)
+	paraAccount, _ := account.NewAccountDB(chainTestCfg, pt.ParaX, "TEST", stateDB)
+	paraAccount.SaveAccount(&types.Account{Addr: multiSigAddr, Balance: 1000})
+
+	//coins合约不能通过multisig执行
+	param := &mty.MultiSigExecTx{
+		MultiSigAccAddr: multiSigAddr,
+		Execer:          cty.CoinsX,
+		Payload:         types.Encode(&cty.CoinsAction{Ty: cty.CoinsActionTransfer, Value: &cty.CoinsAction_Transfer{Transfer: &types.AssetsTransfer{Amount: 100}}}),
+		To:              AddrB,
+	}
+	tx, _ := multiSigExecTx(param)
+	tx, _ = signTx(tx, PrivKeyC)
+	assert.Equal(t, mty.ErrInvalidExecTx, driver.CheckTx(tx, env.index))
+
+	param.Execer = pt.ParaX
+	param.Payload = paraTransferPayload("TEST", 100)
+	param.To = ""
+	tx, _ = multiSigExecTx(param)
+	tx, _ = signTx(tx, PrivKeyC)
+	assert.Nil(t, driver.CheckTx(tx, env.index))
+
+	//addrC的权重不够，交易只被记录不执行
+	receipt, err := driver.Exec(tx, env.index)
+	assert.Nil(t, err)
+	var receiptTx mty.ReceiptMultiSigTx
+	err = types.Decode(receipt.Logs[1].Log, &receiptTx)
+	assert.Nil(t, err)
+	assert.Equal(t, mty.ExecOperate, receiptTx.TxType)
+	assert.Equal(t, false, receiptTx.CurExecuted)
+	assert.Equal(t, int64(1000), paraAccount.LoadAccount(multiSigAddr).Balance)
+
+	txDetails := &types.TransactionDetails{Txs: []*types.TransactionDetail{{Tx: tx}}}
+	api.On("GetTransactionByHash", &types.ReqHashes{Hashes: [][]byte{tx.Hash()}}).Return(txDetails, nil)
+
+	//addrD确认后权重满足，以多重签名账户为发送者执行转账
+	confirm := &mty.MultiSigConfirmTx{
+		MultiSigAccAddr: multiSigAddr,
+		TxId:            receiptTx.MultiSigTxOwner.Txid,
+		ConfirmOrRevoke: true,
+	}
+	txConfirm, _ := multiSigConfirmTx(confirm)
+	txConfirm, _ = signTx(txConfirm, PrivKeyD)
+	receipt, err = driver.Exec(txConfirm, env.index)
+	assert.Nil(t, err)
+
+	var execLog mty.ReceiptExecTx
+	for _, log := range receipt.Logs {
+		if log.Ty == mty.TyLogMultiSigExecTx {
+			err = types.Decode(log.Log, &execLog)
+			assert.Nil(t, err)
+		}
+	}
+	assert.Equal(t, multiSigAddr, execLog.MultiSigAddr)
+	assert.Equal(t, pt.ParaX, execLog.Execer)
+	assert.Equal(t, int64(900), paraAccount.LoadAccount(multiSigAddr).Balance)
+	assert.Equal(t, int64(100), paraAccount.LoadAccount(address.ExecAddress(pt.ParaX)).Balance)
+
+	//已经执行的交易不能再次确认
+	txConfirm, _ = multiSigConfirmTx(confirm)
+	txConfirm, _ = signTx(txConfirm, PrivKeyB)
+	_, err = driver.Exec(txConfirm, env.index)
+	assert.NotNil(t, err)
+}
+
+//设置了executeAfter和expireAt的交易
+func TestMultiSigTxTimeLock(t *testing.T) {
+	env := execEnv{
+		1539918074,
+		100,
+		2,
+		1539918074,
+		"hash",
+	}
+
+	stateDB, _ := dbm.NewGoMemDB("state", "state", 100)
+	localDB := new(dbmock.KVDB)
+	api := new(apimock.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(chainTestCfg, nil)
+
+	coinsAccount := account.NewCoinsAccount(chainTestCfg)
+	coinsAccount.SetDB(stateDB)
+
+	driver := newMultiSig()
+	driver.SetEnv(env.blockHeight, env.blockTime, env.difficulty)
+	driver.SetAPI(api)
+	driver.SetStateDB(stateDB)
+	driver.SetLocalDB(localDB)
+	driver.SetCoinsAccount(coinsAccount)
+
+	multiSigAddr, err := testMultiSigAccCreate(t, driver, env, localDB)
+	assert.Nil(t, err)
+	paraAccount, _ := account.NewAccountDB(chainTestCfg, pt.ParaX, "TEST", stateDB)
+	paraAccount.SaveAccount(&types.Account{Addr: multiSigAddr, Balance: 1000})
+
+	param := &mty.MultiSigExecTx{
+		MultiSigAccAddr: multiSigAddr,
+		Execer:          pt.ParaX,
+		Payload:         paraTransferPayload("TEST", 100),
+		ExecuteAfter:    110,
+		ExpireAt:        100,
+	}
+	//过期高度必须大于executeAfter
+	tx, _ := multiSigExecTx(param)
+	tx, _ = signTx(tx, PrivKeyC)
+	assert.Equal(t, mty.ErrInvalidTimeLock, driver.CheckTx(tx, env.index))
+	_, err = driver.Exec(tx, env.index)
+	assert.Equal(t, mty.ErrInvalidTimeLock, err)
+
+	param.ExpireAt = 120
+	tx, _ = multiSigExecTx(param)
+	tx, _ = signTx(tx, PrivKeyD)
+	assert.Nil(t, driver.CheckTx(tx, env.index))
+
+	//addrD的权重满足，但是没有到达executeAfter高度，交易只被记录
+	receipt, err := driver.Exec(tx, env.index)
+	assert.Nil(t, err)
+	var receiptTx mty.ReceiptMultiSigTx
+	err = types.Decode(receipt.Logs[1].Log, &receiptTx)
+	assert.Nil(t, err)
+	assert.Equal(t, false, receiptTx.CurExecuted)
+	assert.Equal(t, int64(110), receiptTx.ExecuteAfter)
+	assert.Equal(t, int64(120), receiptTx.ExpireAt)
+	txid := receiptTx.MultiSigTxOwner.Txid
+
+	txDetails := &types.TransactionDetails{Txs: []*types.TransactionDetail{{Tx: tx}}}
+	api.On("GetTransactionByHash", &types.ReqHashes{Hashes: [][]byte{tx.Hash()}}).Return(txDetails, nil)
+
+	confirm := &mty.MultiSigConfirmTx{
+		MultiSigAccAddr: multiSigAddr,
+		TxId:            txid,
+		ConfirmOrRevoke: true,
+	}
+	txConfirm, _ := multiSigConfirmTx(confirm)
+	txConfirm, _ = signTx(txConfirm, PrivKeyD)
+	_, err = driver.Exec(txConfirm, env.index)
+	assert.Equal(t, mty.ErrDupConfirmed, err)
+
+	//到达executeAfter高度后由已确认的owner触发执行
+	driver.SetEnv(110, env.blockTime, env.difficulty)
+	receipt, err = driver.Exec(txConfirm, env.index)
+	assert.Nil(t, err)
+	for _, log := range receipt.Logs {
+		if log.Ty == mty.TyLogMultiSigTx {
+			err = types.Decode(log.Log, &receiptTx)
+			assert.Nil(t, err)
+		}
+	}
+	assert.Equal(t, true, receiptTx.ExecuteOnly)
+	assert.Equal(t, true, receiptTx.CurExecuted)
+	assert.Equal(t, int64(900), paraAccount.LoadAccount(multiSigAddr).Balance)
+
+	//超过expireAt高度的交易不能再确认
+	param.ExecuteAfter = 0
+	param.ExpireAt = 115
+	tx, _ = multiSigExecTx(param)
+	tx, _ = signTx(tx, PrivKeyC)
+	receipt, err = driver.Exec(tx, env.index)
+	assert.Nil(t, err)
+	err = types.Decode(receipt.Logs[1].Log, &receiptTx)
+	assert.Nil(t, err)
+	assert.Equal(t, false, receiptTx.CurExecuted)
+
+	driver.SetEnv(116, env.blockTime, env.difficulty)
+	multiSigTx, err := getMultiSigAccTxFromDb(stateDB, multiSigAddr, receiptTx.MultiSigTxOwner.Txid)
+	assert.Nil(t, err)
+	assert.Equal(t, mty.TxStatusExpired, getMultiSigTxStatus(multiSigTx, 116))
+	confirm.TxId = receiptTx.MultiSigTxOwner.Txid
+	txConfirm, _ = multiSigConfirmTx(confirm)
+	txConfirm, _ = signTx(txConfirm, PrivKeyD)
+	_, err = driver.Exec(txConfirm, env.index)
+	assert.Equal(t, mty.ErrTxExpired, err)
+}
+
 func testMultiSigAccCreate(t *testing.T, driver drivers.Driver, env execEnv, localDB *dbmock.KVDB) (string, error) {
 	//---------测试账户创建--------------------
 	var owners []*mty.Owner
@@ -1018,3 +1229,195 @@ func multiSigExecTransferFrom(parm *mty.MultiSigExecTransferFrom, fromOrTo bool)
 	}
 	return types.CreateFormatTx(chainTestCfg, chainTestCfg.ExecName(mty.MultiSigX), types.Encode(multiSig))
 }
+
+func multiSigExecTx(parm *mty.MultiSigExecTx) (*types.Transaction, error) {
+	if parm == nil {
+		return nil, types.ErrInvalidParam
+	}
+	multiSig := &mty.MultiSigAction{
+		Ty:    mty.ActionMultiSigExecTx,
+		Value: &mty.MultiSigAction_MultiSigExecTx{MultiSigExecTx: parm},
+	}
+	return types.CreateFormatTx(chainTestCfg, chainTestCfg.ExecName(mty.MultiSigX), types.Encode(multiSig))
+}
+
+//主链上paracross交易的to必须是合约地址，资产转入paracross合约地址
+func paraTransferPayload(symbol string, amount int64) []byte {
+	transfer := &pt.ParacrossAction{
+		Ty:    pt.ParacrossActionTransfer,
+		Value: &pt.ParacrossAction_Transfer{Transfer: &types.AssetsTransfer{Cointoken: symbol, Amount: amount}},
+	}
+	return types.Encode(transfer)
+}
+
+//被执行交易的ExecLocal不会执行，依赖ExecLocal写本地数据的合约和action不能通过multisig执行
+func TestMultiSigExecTxNotAllowed(t *testing.T) {
+	env := execEnv{
+		1539918074,
+		100,
+		2,
+		1539918074,
+		"hash",
+	}
+
+	stateDB, _ := dbm.NewGoMemDB("state", "state", 100)
+	localDB := new(dbmock.KVDB)
+	api := new(apimock.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(chainTestCfg, nil)
+
+	coinsAccount := account.NewCoinsAccount(chainTestCfg)
+	coinsAccount.SetDB(stateDB)
+
+	driver := newMultiSig()
+	driver.SetEnv(env.blockHeight, env.blockTime, env.difficulty)
+	driver.SetAPI(api)
+	driver.SetStateDB(stateDB)
+	driver.SetLocalDB(localDB)
+	driver.SetCoinsAccount(coinsAccount)
+
+	multiSigAddr, err := testMultiSigAccCreate(t, driver, env, localDB)
+	assert.Nil(t, err)
+
+	//evm合约的storage在ForkEVMState之后保存在本地数据中
+	code, _ := common.FromHex("600a600c600039600a6000f3602a60005260206000f3")
+	evmAction := &evmtypes.EVMContractAction{Code: code, ContractAddr: address.ExecAddress(evmtypes.ExecutorName)}
+	tokenAction := &tokenty.TokenAction{
+		Ty:    tokenty.ActionTransfer,
+		Value: &tokenty.TokenAction_Transfer{Transfer: &types.AssetsTransfer{Cointoken: "TEST", Amount: 100}},
+	}
+	nodeConfig := &pt.ParacrossAction{
+		Ty:    pt.ParacrossActionNodeConfig,
+		Value: &pt.ParacrossAction_NodeConfig{NodeConfig: &pt.ParaNodeAddrConfig{Title: "user.p.test.", Op: pt.ParaOpNewApply, Addr: AddrB}},
+	}
+	params := []*mty.MultiSigExecTx{
+		{MultiSigAccAddr: multiSigAddr, Execer: evmtypes.ExecutorName, Payload: types.Encode(evmAction)},
+		{MultiSigAccAddr: multiSigAddr, Execer: "user.evm.test", Payload: types.Encode(evmAction)},
+		{MultiSigAccAddr: multiSigAddr, Execer: tokenty.TokenX, Payload: types.Encode(tokenAction), To: AddrB},
+		{MultiSigAccAddr: multiSigAddr, Execer: pt.ParaX, Payload: types.Encode(nodeConfig)},
+		{MultiSigAccAddr: multiSigAddr, Execer: pt.ParaX, Payload: []byte("invalid")},
+	}
+	for _, param := range params {
+		tx, _ := multiSigExecTx(param)
+		tx.Fee = 1e6
+		tx, _ = signTx(tx, PrivKeyD)
+		assert.Equal(t, mty.ErrInvalidExecTx, driver.CheckTx(tx, env.index), param.Execer)
+		_, err = driver.Exec(tx, env.index)
+		assert.Equal(t, mty.ErrInvalidExecTx, err, param.Execer)
+	}
+}
+
+//分叉之前不记录创建交易哈希，也不支持MultiSigExecTx
+func TestMultiSigExecTxFork(t *testing.T) {
+	cfg := types.NewChain33Config(strings.Replace(types.GetDefaultCfgstring(), "Title=\"local\"", "Title=\"chain33\"", 1))
+	cfg.SetDappFork(mty.MultiSigX, mty.ForkMultiSigExecTxX, 1000)
+	env := execEnv{
+		1539918074,
+		100,
+		2,
+		1539918074,
+		"hash",
+	}
+
+	stateDB, _ := dbm.NewGoMemDB("state", "state", 100)
+	localDB := new(dbmock.KVDB)
+	api := new(apimock.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg, nil)
+
+	driver := newMultiSig()
+	driver.SetEnv(env.blockHeight, env.blockTime, env.difficulty)
+	driver.SetAPI(api)
+	driver.SetStateDB(stateDB)
+	driver.SetLocalDB(localDB)
+
+	multiSigAddr, err := testMultiSigAccCreate(t, driver, env, localDB)
+	assert.Nil(t, err)
+	multiSigAcc, err := getMultiSigAccFromDb(stateDB, multiSigAddr)
+	assert.Nil(t, err)
+	assert.Equal(t, "", multiSigAcc.CreateTxHash)
+
+	param := &mty.MultiSigExecTx{
+		MultiSigAccAddr: multiSigAddr,
+		Execer:          pt.ParaX,
+		Payload:         paraTransferPayload("TEST", 100),
+	}
+	tx, _ := multiSigExecTx(param)
+	tx, _ = signTx(tx, PrivKeyD)
+	_, err = driver.Exec(tx, env.index)
+	assert.Equal(t, types.ErrActionNotSupport, err)
+}
+
+//分叉之前忽略交易的executeAfter和expireAt，已确认的owner不能重复确认
+func TestMultiSigTxTimeLockFork(t *testing.T) {
+	cfg := types.NewChain33Config(strings.Replace(types.GetDefaultCfgstring(), "Title=\"local\"", "Title=\"chain33\"", 1))
+	cfg.SetDappFork(mty.MultiSigX, mty.ForkMultiSigTimeLockX, 1000)
+	env := execEnv{
+		1539918074,
+		100,
+		2,
+		1539918074,
+		"hash",
+	}
+
+	stateDB, _ := dbm.NewGoMemDB("state", "state", 100)
+	localDB := new(dbmock.KVDB)
+	api := new(apimock.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg, nil)
+
+	driver := newMultiSig()
+	driver.SetEnv(env.blockHeight, env.blockTime, env.difficulty)
+	driver.SetAPI(api)
+	driver.SetStateDB(stateDB)
+	driver.SetLocalDB(localDB)
+
+	multiSigAddr, err := testMultiSigAccCreate(t, driver, env, localDB)
+	assert.Nil(t, err)
+	paraAccount, _ := account.NewAccountDB(cfg, pt.ParaX, "TEST", stateDB)
+	paraAccount.SaveAccount(&types.Account{Addr: multiSigAddr, Balance: 1000})
+
+	param := &mty.MultiSigExecTx{
+		MultiSigAccAddr: multiSigAddr,
+		Execer:          pt.ParaX,
+		Payload:         paraTransferPayload("TEST", 100),
+		ExecuteAfter:    110,
+		ExpireAt:        100,
+	}
+	tx, _ := multiSigExecTx(param)
+	tx, _ = signTx(tx, PrivKeyC)
+	assert.Nil(t, driver.CheckTx(tx, env.index))
+
+	//addrC的权重不满足，交易只被记录，时间锁被忽略
+	receipt, err := driver.Exec(tx, env.index)
+	assert.Nil(t, err)
+	var receiptTx mty.ReceiptMultiSigTx
+	err = types.Decode(receipt.Logs[1].Log, &receiptTx)
+	assert.Nil(t, err)
+	assert.Equal(t, false, receiptTx.CurExecuted)
+	assert.Equal(t, int64(0), receiptTx.ExecuteAfter)
+	assert.Equal(t, int64(0), receiptTx.ExpireAt)
+
+	txDetails := &types.TransactionDetails{Txs: []*types.TransactionDetail{{Tx: tx}}}
+	api.On("GetTransactionByHash", &types.ReqHashes{Hashes: [][]byte{tx.Hash()}}).Return(txDetails, nil)
+	confirm := &mty.MultiSigConfirmTx{
+		MultiSigAccAddr: multiSigAddr,
+		TxId:            receiptTx.MultiSigTxOwner.Txid,
+		ConfirmOrRevoke: true,
+	}
+	txConfirm, _ := multiSigConfirmTx(confirm)
+	txConfirm, _ = signTx(txConfirm, PrivKeyC)
+	_, err = driver.Exec(txConfirm, env.index)
+	assert.Equal(t, mty.ErrDupConfirmed, err)
+
+	//addrD确认后权重满足，立即执行
+	txConfirm, _ = signTx(txConfirm, PrivKeyD)
+	receipt, err = driver.Exec(txConfirm, env.index)
+	assert.Nil(t, err)
+	for _, log := range receipt.Logs {
+		if log.Ty == mty.TyLogMultiSigTx {
+			err = types.Decode(log.Log, &receiptTx)
+			assert.Nil(t, err)
+		}
+	}
+	assert.Equal(t, false, receiptTx.ExecuteOnly)
+	assert.Equal(t, true, receiptTx.CurExecuted)
+	assert.Equal(t, int64(900), paraAccount.LoadAccount(multiSigAddr).Balance)
+}
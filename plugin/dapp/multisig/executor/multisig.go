@@ -98,6 +98,13 @@ func (m *MultiSig) CheckTx(tx *types.Transaction, index int) error {
 		return err
 	}
 
+	//提交多重签名交易时的时间锁检测
+	if lock, ok := payload.(timeLocker); ok && m.GetAPI().GetConfig().IsDappFork(m.GetHeight(), mty.MultiSigX, mty.ForkMultiSigTimeLockX) {
+		if err := checkTimeLock(lock.GetExecuteAfter(), lock.GetExpireAt(), m.GetHeight()); err != nil {
+			return err
+		}
+	}
+
 	//MultiSigOwnerOperate 交易的检测
 	if ato, ok := payload.(*mty.MultiSigOwnerOperate); ok {
 		return checkOwnerOperateTx(ato, m.GetHeight(), multiSignDriver)
@@ -136,9 +143,37 @@ func (m *MultiSig) CheckTx(tx *types.Transaction, index int) error {
 		//assets check
 		return mty.IsAssetsInvalid(ato.GetExecname(), ato.GetSymbol())
 	}
+	//MultiSigExecTx 交易的检测
+	if ato, ok := payload.(*mty.MultiSigExecTx); ok {
+		return checkExecTx(ato, m.GetHeight(), multiSignDriver)
+	}
 
 	return nil
 }
+
+//检测需要执行的交易：执行器和action必须允许通过multisig执行并且可以解析payload，不允许嵌套执行multisig交易
+func checkExecTx(ato *mty.MultiSigExecTx, blockHeight int64, multiSignDriver address.Driver) error {
+	if err := multiSignDriver.ValidateAddr(ato.GetMultiSigAccAddr()); err != nil {
+		return types.ErrInvalidAddress
+	}
+	execer := ato.GetExecer()
+	if !mty.IsExecTxAllowed(execer, ato.GetPayload()) {
+		return mty.ErrInvalidExecTx
+	}
+	if ato.GetTo() != "" {
+		if err := address.CheckAddress(ato.GetTo(), blockHeight); err != nil {
+			return types.ErrInvalidAddress
+		}
+	}
+	ety := types.LoadExecutorType(execer)
+	if ety == nil {
+		return types.ErrExecNotFound
+	}
+	if _, err := ety.DecodePayload(&types.Transaction{Execer: []byte(execer), Payload: ato.GetPayload()}); err != nil {
+		return mty.ErrInvalidExecTx
+	}
+	return nil
+}
 func checkAccountCreateTx(ato *mty.MultiSigAccCreate, blockHeight int64) error {
 	var totalweight uint64
 	var ownerCount int
@@ -749,6 +784,8 @@ func (m *MultiSig) saveMultiSigTx(execTx mty.ReceiptMultiSigTx, addOrRollback bo
 	temMultiSigTx.TxHash = execTx.TxHash
 	temMultiSigTx.TxType = execTx.TxType
 	temMultiSigTx.Executed = false
+	temMultiSigTx.ExecuteAfter = execTx.ExecuteAfter
+	temMultiSigTx.ExpireAt = execTx.ExpireAt
 	//获取多重签名交易信息从db中
 	multiSigTx, err := getMultiSigTx(m.GetLocalDB(), multiSigAddr, txid)
 	if err != nil {
@@ -771,6 +808,23 @@ func (m *MultiSig) saveMultiSigTx(execTx mty.ReceiptMultiSigTx, addOrRollback bo
 		multiSigTx = temMultiSigTx
 	}
 
+	//时间锁到期后由已确认的owner触发执行，只更新执行状态
+	if execTx.ExecuteOnly {
+		if addOrRollback {
+			if prevExecuted != multiSigTx.Executed {
+				return nil, mty.ErrExecutedNoMatch
+			}
+			multiSigTx.Executed = curExecuted
+		} else {
+			multiSigTx.Executed = prevExecuted
+		}
+		err = setMultiSigTx(m.GetLocalDB(), multiSigTx, true)
+		if err != nil {
+			return nil, err
+		}
+		return []*types.KeyValue{getMultiSigTxKV(multiSigTx, true)}, nil
+	}
+
 	index, exist := isOwnerConfirmedTx(multiSigTx, owner.OwnerAddr)
 	if addOrRollback { //正常添加交易
 		if !exist { //add Confirmed Owner and modify Executed
@@ -939,6 +993,44 @@ func isConfirmed(requiredWeight uint64, multiSigTx *mty.MultiSigTx) bool {
 	return totalweight >= requiredWeight
 }
 
+//提交多重签名交易的action都可以设置executeAfter和expireAt
+type timeLocker interface {
+	GetExecuteAfter() int64
+	GetExpireAt() int64
+}
+
+//交易是否已经到达允许执行的高度
+func isUnlocked(multiSigTx *mty.MultiSigTx, height int64) bool {
+	return height >= multiSigTx.ExecuteAfter
+}
+
+//未执行的交易超过expireAt高度后过期，expireAt为0表示不过期
+func isExpired(multiSigTx *mty.MultiSigTx, height int64) bool {
+	return !multiSigTx.Executed && multiSigTx.ExpireAt > 0 && height > multiSigTx.ExpireAt
+}
+
+//根据当前高度获取交易的状态
+func getMultiSigTxStatus(multiSigTx *mty.MultiSigTx, height int64) int32 {
+	if multiSigTx.Executed {
+		return mty.TxStatusExecuted
+	}
+	if isExpired(multiSigTx, height) {
+		return mty.TxStatusExpired
+	}
+	return mty.TxStatusPending
+}
+
+//提交交易时设置的executeAfter和expireAt的合法性检测，过期高度必须大于当前高度以及executeAfter
+func checkTimeLock(executeAfter, expireAt, height int64) error {
+	if executeAfter < 0 || expireAt < 0 {
+		return mty.ErrInvalidTimeLock
+	}
+	if expireAt > 0 && (expireAt < height || expireAt <= executeAfter) {
+		return mty.ErrInvalidTimeLock
+	}
+	return nil
+}
+
 //确认某笔交易的额度是否满足每日限额,返回是否满足，以及新的newLastDay时间
 func isUnderLimit(blocktime int64, amount uint64, dailyLimit *mty.DailyLimit) (bool, int64) {
 
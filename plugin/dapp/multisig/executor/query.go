@@ -126,7 +126,7 @@ func (m *MultiSig) Query_MultiSigAccTxCount(in *mty.ReqMultiSigAccInfo) (types.M
 	return &mty.Uint64{Data: multiSigAcc.TxCount}, nil
 }
 
-//Query_MultiSigTxids 获取txids通过设置的过滤条件和区间，pending, executed
+//Query_MultiSigTxids 获取txids通过设置的过滤条件和区间，pending, executed, expired
 //输入：
 //message ReqMultiSigTxids {
 //  string multisigaddr = 1;
@@ -134,6 +134,7 @@ func (m *MultiSig) Query_MultiSigAccTxCount(in *mty.ReqMultiSigAccInfo) (types.M
 //	uint64 totxid = 3;
 //	bool   pending = 4;
 //	bool   executed	= 5;
+//	bool   expired	= 6;
 // 返回:
 //message ReplyMultiSigTxids {
 //  string 			multisigaddr = 1;
@@ -166,8 +167,10 @@ func (m *MultiSig) Query_MultiSigTxids(in *mty.ReqMultiSigTxids) (types.Message,
 			continue
 		}
 		findTxid := txid
-		//查找Pending/Executed的交易txid
-		if in.Pending && !multiSigTx.Executed || in.Executed && multiSigTx.Executed {
+		//查找Pending/Executed/Expired的交易txid
+		status := getMultiSigTxStatus(multiSigTx, m.GetHeight())
+		if in.Pending && status == mty.TxStatusPending || in.Executed && status == mty.TxStatusExecuted ||
+			in.Expired && status == mty.TxStatusExpired {
 			multiSigTxids.Txids = append(multiSigTxids.Txids, findTxid)
 		}
 	}
@@ -204,6 +207,7 @@ func (m *MultiSig) Query_MultiSigTxInfo(in *mty.ReqMultiSigTxInfo) (types.Messag
 		multiSigTx = &mty.MultiSigTx{}
 	} else { //由于代码中使用hex.EncodeToString()接口转换的，没有加0x，为了方便上层统一处理再次返回时增加0x即可
 		multiSigTx.TxHash = "0x" + multiSigTx.TxHash
+		multiSigTx.Status = getMultiSigTxStatus(multiSigTx, m.GetHeight())
 	}
 	return multiSigTx, nil
 }
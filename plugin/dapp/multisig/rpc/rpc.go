@@ -67,6 +67,20 @@ func (c *Jrpc) MultiSigConfirmTx(param *mty.MultiSigConfirmTx, result *interface
 	return nil
 }
 
+// MultiSigExecTx :构造以多重签名账户为发送者执行其他合约交易的交易
+func (c *Jrpc) MultiSigExecTx(param *mty.MultiSigExecTx, result *interface{}) error {
+	if param == nil {
+		return types.ErrInvalidParam
+	}
+	cfg := c.cli.GetConfig()
+	data, err := types.CallCreateTx(cfg, cfg.ExecName(mty.MultiSigX), "MultiSigExecTx", param)
+	if err != nil {
+		return err
+	}
+	*result = hex.EncodeToString(data)
+	return nil
+}
+
 // MultiSigAccTransferInTx :构造在多重签名合约中转账到多重签名账户的交易
 func (c *Jrpc) MultiSigAccTransferInTx(param *mty.MultiSigExecTransferTo, result *interface{}) error {
 	if param == nil {
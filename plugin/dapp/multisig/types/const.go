@@ -24,6 +24,11 @@ var (
 	OwnerOperate    uint64 = 1
 	AccountOperate  uint64 = 2
 	TransferOperate uint64 = 3
+	ExecOperate     uint64 = 4 //以多重签名账户为发送者执行其他合约的交易
+	//TxStatusPending 多重签名交易的状态：pending，executed，expired
+	TxStatusPending  int32 = 0
+	TxStatusExecuted int32 = 1
+	TxStatusExpired  int32 = 2
 	//IsSubmit ：
 	IsSubmit  = true
 	IsConfirm = false
@@ -37,6 +42,12 @@ var (
 	Multisiglog = log15.New("module", MultiSigX)
 )
 
+//ForkMultiSigExecTxX 支持以多重签名账户为发送者执行其他合约的交易
+const ForkMultiSigExecTxX = "ForkMultiSigExecTx"
+
+//ForkMultiSigTimeLockX 支持提交交易时设置executeAfter和expireAt，以及到期后由已确认的owner触发执行
+const ForkMultiSigTimeLockX = "ForkMultiSigTimeLock"
+
 // MultiSig 交易的actionid
 const (
 	ActionMultiSigAccCreate        = 10000
@@ -45,6 +56,7 @@ const (
 	ActionMultiSigConfirmTx        = 10003
 	ActionMultiSigExecTransferTo   = 10004
 	ActionMultiSigExecTransferFrom = 10005
+	ActionMultiSigExecTx           = 10006
 )
 
 //多重签名账户执行输出的logid
@@ -66,6 +78,7 @@ const (
 	TyLogDailyLimitUpdate = 10010 //DailyLimit更新，DailyLimit在Submit和Confirm阶段都可能有变化
 	TyLogMultiSigTx       = 10011 //在Submit提交交易阶段才会有更新
 	TyLogTxCountUpdate    = 10012 //txcount只在在Submit阶段提交新的交易是才会增加计数
+	TyLogMultiSigExecTx   = 10013 //以多重签名账户为发送者执行的交易
 
 )
 
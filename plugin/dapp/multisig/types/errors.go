@@ -41,4 +41,9 @@ var (
 	ErrInvalidExec          = errors.New("ErrInvalidExec")
 	ErrInvalidWeight        = errors.New("ErrInvalidWeight")
 	ErrInvalidDailyLimit    = errors.New("ErrInvalidDailyLimit")
+	ErrCreateTxHashNoMatch  = errors.New("ErrCreateTxHashNoMatch")
+	ErrInvalidExecTx        = errors.New("ErrInvalidExecTx")
+	ErrExecTxFailed         = errors.New("ErrExecTxFailed")
+	ErrInvalidTimeLock      = errors.New("ErrInvalidTimeLock")
+	ErrTxExpired            = errors.New("ErrTxExpired")
 )
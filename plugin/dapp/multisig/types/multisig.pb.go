@@ -32,6 +32,7 @@ const (
 // DailyLimit: 不同资产的每日限额，通过symbol来区分，本连的原生币，以及跨链过来的其他链的原生币
 // txCount:记录此多重签名地址上提交的withdraw交易数
 // requiredweight:确认一笔withdraw交易需要的权重。
+// createTxHash:创建交易的哈希, 多重签名地址由它生成, 执行MultiSigExecTx时作为发送者的公钥
 type MultiSig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -43,6 +44,7 @@ type MultiSig struct {
 	DailyLimits    []*DailyLimit `protobuf:"bytes,4,rep,name=dailyLimits,proto3" json:"dailyLimits,omitempty"`
 	TxCount        uint64        `protobuf:"varint,5,opt,name=txCount,proto3" json:"txCount,omitempty"`
 	RequiredWeight uint64        `protobuf:"varint,6,opt,name=requiredWeight,proto3" json:"requiredWeight,omitempty"`
+	CreateTxHash   string        `protobuf:"bytes,7,opt,name=createTxHash,proto3" json:"createTxHash,omitempty"`
 }
 
 func (x *MultiSig) Reset() {
@@ -119,6 +121,13 @@ func (x *MultiSig) GetRequiredWeight() uint64 {
 	return 0
 }
 
+func (x *MultiSig) GetCreateTxHash() string {
+	if x != nil {
+		return x.CreateTxHash
+	}
+	return ""
+}
+
 //这个地址是否已经确认某个交易
 type ConfirmedOwner struct {
 	state         protoimpl.MessageState
@@ -169,6 +178,8 @@ func (x *ConfirmedOwner) GetConfirmedOwner() []*Owner {
 
 //记录提交的交易详情，在满足确认条件后执行data中的交易
 // txHash:用于存贮提交的确认交易。存贮在localdb中，通过txhash可以获取
+// executeAfter:达到此高度之后才允许执行，expireAt:超过此高度还未执行的交易过期，0表示不限制
+// status:交易状态pending/executed/expired，只在查询时根据当前高度设置
 type MultiSigTx struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -180,6 +191,9 @@ type MultiSigTx struct {
 	TxType         uint64   `protobuf:"varint,4,opt,name=txType,proto3" json:"txType,omitempty"`
 	MultiSigAddr   string   `protobuf:"bytes,5,opt,name=multiSigAddr,proto3" json:"multiSigAddr,omitempty"`
 	ConfirmedOwner []*Owner `protobuf:"bytes,6,rep,name=confirmedOwner,proto3" json:"confirmedOwner,omitempty"`
+	ExecuteAfter   int64    `protobuf:"varint,7,opt,name=executeAfter,proto3" json:"executeAfter,omitempty"`
+	ExpireAt       int64    `protobuf:"varint,8,opt,name=expireAt,proto3" json:"expireAt,omitempty"`
+	Status         int32    `protobuf:"varint,9,opt,name=status,proto3" json:"status,omitempty"`
 }
 
 func (x *MultiSigTx) Reset() {
@@ -256,6 +270,27 @@ func (x *MultiSigTx) GetConfirmedOwner() []*Owner {
 	return nil
 }
 
+func (x *MultiSigTx) GetExecuteAfter() int64 {
+	if x != nil {
+		return x.ExecuteAfter
+	}
+	return 0
+}
+
+func (x *MultiSigTx) GetExpireAt() int64 {
+	if x != nil {
+		return x.ExpireAt
+	}
+	return 0
+}
+
+func (x *MultiSigTx) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
 // owner 结构体：owner账户地址，以及权重
 type Owner struct {
 	state         protoimpl.MessageState
@@ -470,6 +505,7 @@ type MultiSigAction struct {
 	//	*MultiSigAction_MultiSigConfirmTx
 	//	*MultiSigAction_MultiSigExecTransferTo
 	//	*MultiSigAction_MultiSigExecTransferFrom
+	//	*MultiSigAction_MultiSigExecTx
 	Value isMultiSigAction_Value `protobuf_oneof:"value"`
 	Ty    int32                  `protobuf:"varint,7,opt,name=Ty,proto3" json:"Ty,omitempty"`
 }
@@ -555,6 +591,13 @@ func (x *MultiSigAction) GetMultiSigExecTransferFrom() *MultiSigExecTransferFrom
 	return nil
 }
 
+func (x *MultiSigAction) GetMultiSigExecTx() *MultiSigExecTx {
+	if x, ok := x.GetValue().(*MultiSigAction_MultiSigExecTx); ok {
+		return x.MultiSigExecTx
+	}
+	return nil
+}
+
 func (x *MultiSigAction) GetTy() int32 {
 	if x != nil {
 		return x.Ty
@@ -590,6 +633,10 @@ type MultiSigAction_MultiSigExecTransferFrom struct {
 	MultiSigExecTransferFrom *MultiSigExecTransferFrom `protobuf:"bytes,6,opt,name=multiSigExecTransferFrom,proto3,oneof"` //合约中多重签名账户转账到外部账户，multiSigAddr--->Addr
 }
 
+type MultiSigAction_MultiSigExecTx struct {
+	MultiSigExecTx *MultiSigExecTx `protobuf:"bytes,8,opt,name=multiSigExecTx,proto3,oneof"` //以多重签名账户为发送者执行其他合约的交易
+}
+
 func (*MultiSigAction_MultiSigAccCreate) isMultiSigAction_Value() {}
 
 func (*MultiSigAction_MultiSigOwnerOperate) isMultiSigAction_Value() {}
@@ -602,6 +649,8 @@ func (*MultiSigAction_MultiSigExecTransferTo) isMultiSigAction_Value() {}
 
 func (*MultiSigAction_MultiSigExecTransferFrom) isMultiSigAction_Value() {}
 
+func (*MultiSigAction_MultiSigExecTx) isMultiSigAction_Value() {}
+
 //创建多重签名账户时需要的信息：创建时最少初始化两个owners，资产的每日限额初始时可以不设置
 type MultiSigAccCreate struct {
 	state         protoimpl.MessageState
@@ -677,6 +726,8 @@ type MultiSigOwnerOperate struct {
 	NewOwner        string `protobuf:"bytes,3,opt,name=newOwner,proto3" json:"newOwner,omitempty"`
 	NewWeight       uint64 `protobuf:"varint,4,opt,name=newWeight,proto3" json:"newWeight,omitempty"`
 	OperateFlag     uint64 `protobuf:"varint,5,opt,name=operateFlag,proto3" json:"operateFlag,omitempty"`
+	ExecuteAfter    int64  `protobuf:"varint,6,opt,name=executeAfter,proto3" json:"executeAfter,omitempty"`
+	ExpireAt        int64  `protobuf:"varint,7,opt,name=expireAt,proto3" json:"expireAt,omitempty"`
 }
 
 func (x *MultiSigOwnerOperate) Reset() {
@@ -746,6 +797,20 @@ func (x *MultiSigOwnerOperate) GetOperateFlag() uint64 {
 	return 0
 }
 
+func (x *MultiSigOwnerOperate) GetExecuteAfter() int64 {
+	if x != nil {
+		return x.ExecuteAfter
+	}
+	return 0
+}
+
+func (x *MultiSigOwnerOperate) GetExpireAt() int64 {
+	if x != nil {
+		return x.ExpireAt
+	}
+	return 0
+}
+
 //对MultiSigAccount账户的操作：modify/add:SymbolDailyLimit,requiredweight
 //修改或者添加每日限额，或者请求权重的值。
 type MultiSigAccOperate struct {
@@ -757,6 +822,8 @@ type MultiSigAccOperate struct {
 	DailyLimit        *SymbolDailyLimit `protobuf:"bytes,2,opt,name=dailyLimit,proto3" json:"dailyLimit,omitempty"`
 	NewRequiredWeight uint64            `protobuf:"varint,3,opt,name=newRequiredWeight,proto3" json:"newRequiredWeight,omitempty"`
 	OperateFlag       bool              `protobuf:"varint,4,opt,name=operateFlag,proto3" json:"operateFlag,omitempty"`
+	ExecuteAfter      int64             `protobuf:"varint,5,opt,name=executeAfter,proto3" json:"executeAfter,omitempty"`
+	ExpireAt          int64             `protobuf:"varint,6,opt,name=expireAt,proto3" json:"expireAt,omitempty"`
 }
 
 func (x *MultiSigAccOperate) Reset() {
@@ -819,6 +886,20 @@ func (x *MultiSigAccOperate) GetOperateFlag() bool {
 	return false
 }
 
+func (x *MultiSigAccOperate) GetExecuteAfter() int64 {
+	if x != nil {
+		return x.ExecuteAfter
+	}
+	return 0
+}
+
+func (x *MultiSigAccOperate) GetExpireAt() int64 {
+	if x != nil {
+		return x.ExpireAt
+	}
+	return 0
+}
+
 //多重签名合约中账户之间转币操作:增加一个from的字段实现MultiSigAddr--->addr之间的转账
 //需要判断from地址是否是多重签名地址
 //将MultiSig合约中from地址上execname+symbol的资产转移到to地址
@@ -827,12 +908,14 @@ type MultiSigExecTransferFrom struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Symbol   string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	Amount   int64  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Note     string `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
-	Execname string `protobuf:"bytes,4,opt,name=execname,proto3" json:"execname,omitempty"`
-	To       string `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
-	From     string `protobuf:"bytes,6,opt,name=from,proto3" json:"from,omitempty"`
+	Symbol       string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Amount       int64  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Note         string `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	Execname     string `protobuf:"bytes,4,opt,name=execname,proto3" json:"execname,omitempty"`
+	To           string `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	From         string `protobuf:"bytes,6,opt,name=from,proto3" json:"from,omitempty"`
+	ExecuteAfter int64  `protobuf:"varint,7,opt,name=executeAfter,proto3" json:"executeAfter,omitempty"`
+	ExpireAt     int64  `protobuf:"varint,8,opt,name=expireAt,proto3" json:"expireAt,omitempty"`
 }
 
 func (x *MultiSigExecTransferFrom) Reset() {
@@ -909,6 +992,20 @@ func (x *MultiSigExecTransferFrom) GetFrom() string {
 	return ""
 }
 
+func (x *MultiSigExecTransferFrom) GetExecuteAfter() int64 {
+	if x != nil {
+		return x.ExecuteAfter
+	}
+	return 0
+}
+
+func (x *MultiSigExecTransferFrom) GetExpireAt() int64 {
+	if x != nil {
+		return x.ExpireAt
+	}
+	return 0
+}
+
 //多重签名合约中账户之间转币操作: addr --->MultiSigAddr之间的转账
 //需要判断to地址是否是多重签名地址
 //将MultiSig合约中签名地址上execname+symbol的资产转移到to地址
@@ -991,6 +1088,104 @@ func (x *MultiSigExecTransferTo) GetTo() string {
 	return ""
 }
 
+//以多重签名账户为发送者执行任意合约的交易, 权重达到requiredWeight后执行
+// execer:交易的执行器, payload:执行器对应的action编码, to:交易的to地址, 为空时使用执行器地址
+// createTxHash:多重签名账户的创建交易哈希, 只有账户信息中没有记录时才需要提供
+type MultiSigExecTx struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MultiSigAccAddr string `protobuf:"bytes,1,opt,name=multiSigAccAddr,proto3" json:"multiSigAccAddr,omitempty"`
+	Execer          string `protobuf:"bytes,2,opt,name=execer,proto3" json:"execer,omitempty"`
+	Payload         []byte `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	To              string `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	CreateTxHash    string `protobuf:"bytes,5,opt,name=createTxHash,proto3" json:"createTxHash,omitempty"`
+	ExecuteAfter    int64  `protobuf:"varint,6,opt,name=executeAfter,proto3" json:"executeAfter,omitempty"`
+	ExpireAt        int64  `protobuf:"varint,7,opt,name=expireAt,proto3" json:"expireAt,omitempty"`
+}
+
+func (x *MultiSigExecTx) Reset() {
+	*x = MultiSigExecTx{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_multisig_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MultiSigExecTx) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiSigExecTx) ProtoMessage() {}
+
+func (x *MultiSigExecTx) ProtoReflect() protoreflect.Message {
+	mi := &file_multisig_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiSigExecTx.ProtoReflect.Descriptor instead.
+func (*MultiSigExecTx) Descriptor() ([]byte, []int) {
+	return file_multisig_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MultiSigExecTx) GetMultiSigAccAddr() string {
+	if x != nil {
+		return x.MultiSigAccAddr
+	}
+	return ""
+}
+
+func (x *MultiSigExecTx) GetExecer() string {
+	if x != nil {
+		return x.Execer
+	}
+	return ""
+}
+
+func (x *MultiSigExecTx) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *MultiSigExecTx) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *MultiSigExecTx) GetCreateTxHash() string {
+	if x != nil {
+		return x.CreateTxHash
+	}
+	return ""
+}
+
+func (x *MultiSigExecTx) GetExecuteAfter() int64 {
+	if x != nil {
+		return x.ExecuteAfter
+	}
+	return 0
+}
+
+func (x *MultiSigExecTx) GetExpireAt() int64 {
+	if x != nil {
+		return x.ExpireAt
+	}
+	return 0
+}
+
 //多重签名账户withdraw交易的确认或者取消确认
 // multisigaccaddr:多重签名账户地址
 // transactionid:多重签名账户上的withdraw交易的内部id
@@ -1007,7 +1202,7 @@ type MultiSigConfirmTx struct {
 func (x *MultiSigConfirmTx) Reset() {
 	*x = MultiSigConfirmTx{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_multisig_proto_msgTypes[12]
+		mi := &file_multisig_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1020,7 +1215,7 @@ func (x *MultiSigConfirmTx) String() string {
 func (*MultiSigConfirmTx) ProtoMessage() {}
 
 func (x *MultiSigConfirmTx) ProtoReflect() protoreflect.Message {
-	mi := &file_multisig_proto_msgTypes[12]
+	mi := &file_multisig_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1033,7 +1228,7 @@ func (x *MultiSigConfirmTx) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MultiSigConfirmTx.ProtoReflect.Descriptor instead.
 func (*MultiSigConfirmTx) Descriptor() ([]byte, []int) {
-	return file_multisig_proto_rawDescGZIP(), []int{12}
+	return file_multisig_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *MultiSigConfirmTx) GetMultiSigAccAddr() string {
@@ -1070,7 +1265,7 @@ type ReqMultiSigAccs struct {
 func (x *ReqMultiSigAccs) Reset() {
 	*x = ReqMultiSigAccs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_multisig_proto_msgTypes[13]
+		mi := &file_multisig_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1083,7 +1278,7 @@ func (x *ReqMultiSigAccs) String() string {
 func (*ReqMultiSigAccs) ProtoMessage() {}
 
 func (x *ReqMultiSigAccs) ProtoReflect() protoreflect.Message {
-	mi := &file_multisig_proto_msgTypes[13]
+	mi := &file_multisig_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1096,7 +1291,7 @@ func (x *ReqMultiSigAccs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReqMultiSigAccs.ProtoReflect.Descriptor instead.
 func (*ReqMultiSigAccs) Descriptor() ([]byte, []int) {
-	return file_multisig_proto_rawDescGZIP(), []int{13}
+	return file_multisig_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ReqMultiSigAccs) GetStart() int64 {
@@ -1124,7 +1319,7 @@ type ReplyMultiSigAccs struct {
 func (x *ReplyMultiSigAccs) Reset() {
 	*x = ReplyMultiSigAccs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_multisig_proto_msgTypes[14]
+		mi := &file_multisig_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1137,7 +1332,7 @@ func (x *ReplyMultiSigAccs) String() string {
 func (*ReplyMultiSigAccs) ProtoMessage() {}
 
 func (x *ReplyMultiSigAccs) ProtoReflect() protoreflect.Message {
-	mi := &file_multisig_proto_msgTypes[14]
+	mi := &file_multisig_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1150,7 +1345,7 @@ func (x *ReplyMultiSigAccs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReplyMultiSigAccs.ProtoReflect.Descriptor instead.
 func (*ReplyMultiSigAccs) Descriptor() ([]byte, []int) {
-	return file_multisig_proto_rawDescGZIP(), []int{14}
+	return file_multisig_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ReplyMultiSigAccs) GetAddress() []string {
@@ -1172,7 +1367,7 @@ type ReqMultiSigAccInfo struct {
 func (x *ReqMultiSigAccInfo) Reset() {
 	*x = ReqMultiSigAccInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_multisig_proto_msgTypes[15]
+		mi := &file_multisig_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1185,7 +1380,7 @@ func (x *ReqMultiSigAccInfo) String() string {
 func (*ReqMultiSigAccInfo) ProtoMessage() {}
 
 func (x *ReqMultiSigAccInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_multisig_proto_msgTypes[15]
+	mi := &file_multisig_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1198,7 +1393,7 @@ func (x *ReqMultiSigAccInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReqMultiSigAccInfo.ProtoReflect.Descriptor instead.
 func (*ReqMultiSigAccInfo) Descriptor() ([]byte, []int) {
-	return file_multisig_proto_rawDescGZIP(), []int{15}
+	return file_multisig_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ReqMultiSigAccInfo) GetMultiSigAccAddr() string {
@@ -1225,7 +1420,7 @@ type ReplyMultiSigAccInfo struct {
 func (x *ReplyMultiSigAccInfo) Reset() {
 	*x = ReplyMultiSigAccInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_multisig_proto_msgTypes[16]
+		mi := &file_multisig_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1238,7 +1433,7 @@ func (x *ReplyMultiSigAccInfo) String() string {
 func (*ReplyMultiSigAccInfo) ProtoMessage() {}
 
 func (x *ReplyMultiSigAccInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_multisig_proto_msgTypes[16]
+	mi := &file_multisig_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1251,7 +1446,7 @@ func (x *ReplyMultiSigAccInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReplyMultiSigAccInfo.ProtoReflect.Descriptor instead.
 func (*ReplyMultiSigAccInfo) Descriptor() ([]byte, []int) {
-	return file_multisig_proto_rawDescGZIP(), []int{16}
+	return file_multisig_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ReplyMultiSigAccInfo) GetCreateAddr() string {
@@ -1307,12 +1502,13 @@ type ReqMultiSigTxids struct {
 	ToTxId       uint64 `protobuf:"varint,3,opt,name=toTxId,proto3" json:"toTxId,omitempty"`
 	Pending      bool   `protobuf:"varint,4,opt,name=pending,proto3" json:"pending,omitempty"`
 	Executed     bool   `protobuf:"varint,5,opt,name=executed,proto3" json:"executed,omitempty"`
+	Expired      bool   `protobuf:"varint,6,opt,name=expired,proto3" json:"expired,omitempty"`
 }
 
 func (x *ReqMultiSigTxids) Reset() {
 	*x = ReqMultiSigTxids{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_multisig_proto_msgTypes[17]
+		mi := &file_multisig_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1325,7 +1521,7 @@ func (x *ReqMultiSigTxids) String() string {
 func (*ReqMultiSigTxids) ProtoMessage() {}
 
 func (x *ReqMultiSigTxids) ProtoReflect() protoreflect.Message {
-	mi := &file_multisig_proto_msgTypes[17]
+	mi := &file_multisig_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1338,7 +1534,7 @@ func (x *ReqMultiSigTxids) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReqMultiSigTxids.ProtoReflect.Descriptor instead.
 func (*ReqMultiSigTxids) Descriptor() ([]byte, []int) {
-	return file_multisig_proto_rawDescGZIP(), []int{17}
+	return file_multisig_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ReqMultiSigTxids) GetMultiSigAddr() string {
@@ -1376,6 +1572,13 @@ func (x *ReqMultiSigTxids) GetExecuted() bool {
 	return false
 }
 
+func (x *ReqMultiSigTxids) GetExpired() bool {
+	if x != nil {
+		return x.Expired
+	}
+	return false
+}
+
 type ReplyMultiSigTxids struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1388,7 +1591,7 @@ type ReplyMultiSigTxids struct {
 func (x *ReplyMultiSigTxids) Reset() {
 	*x = ReplyMultiSigTxids{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_multisig_proto_msgTypes[18]
+		mi := &file_multisig_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1401,7 +1604,7 @@ func (x *ReplyMultiSigTxids) String() string {
 func (*ReplyMultiSigTxids) ProtoMessage() {}
 
 func (x *ReplyMultiSigTxids) ProtoReflect() protoreflect.Message {
-	mi := &file_multisig_proto_msgTypes[18]
+	mi := &file_multisig_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1414,7 +1617,7 @@ func (x *ReplyMultiSigTxids) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReplyMultiSigTxids.ProtoReflect.Descriptor instead.
 func (*ReplyMultiSigTxids) Descriptor() ([]byte, []int) {
-	return file_multisig_proto_rawDescGZIP(), []int{18}
+	return file_multisig_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ReplyMultiSigTxids) GetMultiSigAddr() string {
@@ -1444,7 +1647,7 @@ type ReqMultiSigTxInfo struct {
 func (x *ReqMultiSigTxInfo) Reset() {
 	*x = ReqMultiSigTxInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_multisig_proto_msgTypes[19]
+		mi := &file_multisig_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1457,7 +1660,7 @@ func (x *ReqMultiSigTxInfo) String() string {
 func (*ReqMultiSigTxInfo) ProtoMessage() {}
 
 func (x *ReqMultiSigTxInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_multisig_proto_msgTypes[19]
+	mi := &file_multisig_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1470,7 +1673,7 @@ func (x *ReqMultiSigTxInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReqMultiSigTxInfo.ProtoReflect.Descriptor instead.
 func (*ReqMultiSigTxInfo) Descriptor() ([]byte, []int) {
-	return file_multisig_proto_rawDescGZIP(), []int{19}
+	return file_multisig_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ReqMultiSigTxInfo) GetMultiSigAddr() string {
@@ -1498,7 +1701,7 @@ type ReplyMultiSigTxInfo struct {
 func (x *ReplyMultiSigTxInfo) Reset() {
 	*x = ReplyMultiSigTxInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_multisig_proto_msgTypes[20]
+		mi := &file_multisig_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1511,7 +1714,7 @@ func (x *ReplyMultiSigTxInfo) String() string {
 func (*ReplyMultiSigTxInfo) ProtoMessage() {}
 
 func (x *ReplyMultiSigTxInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_multisig_proto_msgTypes[20]
+	mi := &file_multisig_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1524,7 +1727,7 @@ func (x *ReplyMultiSigTxInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReplyMultiSigTxInfo.ProtoReflect.Descriptor instead.
 func (*ReplyMultiSigTxInfo) Descriptor() ([]byte, []int) {
-	return file_multisig_proto_rawDescGZIP(), []int{20}
+	return file_multisig_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *ReplyMultiSigTxInfo) GetMultiSigTxInfo() *MultiSigTx {
@@ -1548,7 +1751,7 @@ type ReqMultiSigAccUnSpentToday struct {
 func (x *ReqMultiSigAccUnSpentToday) Reset() {
 	*x = ReqMultiSigAccUnSpentToday{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_multisig_proto_msgTypes[21]
+		mi := &file_multisig_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1561,7 +1764,7 @@ func (x *ReqMultiSigAccUnSpentToday) String() string {
 func (*ReqMultiSigAccUnSpentToday) ProtoMessage() {}
 
 func (x *ReqMultiSigAccUnSpentToday) ProtoReflect() protoreflect.Message {
-	mi := &file_multisig_proto_msgTypes[21]
+	mi := &file_multisig_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1574,7 +1777,7 @@ func (x *ReqMultiSigAccUnSpentToday) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReqMultiSigAccUnSpentToday.ProtoReflect.Descriptor instead.
 func (*ReqMultiSigAccUnSpentToday) Descriptor() ([]byte, []int) {
-	return file_multisig_proto_rawDescGZIP(), []int{21}
+	return file_multisig_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ReqMultiSigAccUnSpentToday) GetMultiSigAddr() string {
@@ -1609,7 +1812,7 @@ type ReplyUnSpentAssets struct {
 func (x *ReplyUnSpentAssets) Reset() {
 	*x = ReplyUnSpentAssets{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_multisig_proto_msgTypes[22]
+		mi := &file_multisig_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1622,7 +1825,7 @@ func (x *ReplyUnSpentAssets) String() string {
 func (*ReplyUnSpentAssets) ProtoMessage() {}
 
 func (x *ReplyUnSpentAssets) ProtoReflect() protoreflect.Message {
-	mi := &file_multisig_proto_msgTypes[22]
+	mi := &file_multisig_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1635,7 +1838,7 @@ func (x *ReplyUnSpentAssets) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReplyUnSpentAssets.ProtoReflect.Descriptor instead.
 func (*ReplyUnSpentAssets) Descriptor() ([]byte, []int) {
-	return file_multisig_proto_rawDescGZIP(), []int{22}
+	return file_multisig_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *ReplyUnSpentAssets) GetUnSpentAssets() []*UnSpentAssets {
@@ -1657,7 +1860,7 @@ type UnSpentAssets struct {
 func (x *UnSpentAssets) Reset() {
 	*x = UnSpentAssets{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_multisig_proto_msgTypes[23]
+		mi := &file_multisig_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1670,7 +1873,7 @@ func (x *UnSpentAssets) String() string {
 func (*UnSpentAssets) ProtoMessage() {}
 
 func (x *UnSpentAssets) ProtoReflect() protoreflect.Message {
-	mi := &file_multisig_proto_msgTypes[23]
+	mi := &file_multisig_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1683,7 +1886,7 @@ func (x *UnSpentAssets) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnSpentAssets.ProtoReflect.Descriptor instead.
 func (*UnSpentAssets) Descriptor() ([]byte, []int) {
-	return file_multisig_proto_rawDescGZIP(), []int{23}
+	return file_multisig_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *UnSpentAssets) GetAssets() *Assets {
@@ -1712,7 +1915,7 @@ type ReceiptMultiSig struct {
 func (x *ReceiptMultiSig) Reset() {
 	*x = ReceiptMultiSig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_multisig_proto_msgTypes[24]
+		mi := &file_multisig_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1725,7 +1928,7 @@ func (x *ReceiptMultiSig) String() string {
 func (*ReceiptMultiSig) ProtoMessage() {}
 
 func (x *ReceiptMultiSig) ProtoReflect() protoreflect.Message {
-	mi := &file_multisig_proto_msgTypes[24]
+	mi := &file_multisig_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1738,7 +1941,7 @@ func (x *ReceiptMultiSig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReceiptMultiSig.ProtoReflect.Descriptor instead.
 func (*ReceiptMultiSig) Descriptor() ([]byte, []int) {
-	return file_multisig_proto_rawDescGZIP(), []int{24}
+	return file_multisig_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *ReceiptMultiSig) GetMultiSigAddr() string {
@@ -1761,7 +1964,7 @@ type ReceiptOwnerAddOrDel struct {
 func (x *ReceiptOwnerAddOrDel) Reset() {
 	*x = ReceiptOwnerAddOrDel{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_multisig_proto_msgTypes[25]
+		mi := &file_multisig_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1774,7 +1977,7 @@ func (x *ReceiptOwnerAddOrDel) String() string {
 func (*ReceiptOwnerAddOrDel) ProtoMessage() {}
 
 func (x *ReceiptOwnerAddOrDel) ProtoReflect() protoreflect.Message {
-	mi := &file_multisig_proto_msgTypes[25]
+	mi := &file_multisig_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1787,7 +1990,7 @@ func (x *ReceiptOwnerAddOrDel) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReceiptOwnerAddOrDel.ProtoReflect.Descriptor instead.
 func (*ReceiptOwnerAddOrDel) Descriptor() ([]byte, []int) {
-	return file_multisig_proto_rawDescGZIP(), []int{25}
+	return file_multisig_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *ReceiptOwnerAddOrDel) GetMultiSigAddr() string {
@@ -1827,7 +2030,7 @@ type ReceiptOwnerModOrRep struct {
 func (x *ReceiptOwnerModOrRep) Reset() {
 	*x = ReceiptOwnerModOrRep{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_multisig_proto_msgTypes[26]
+		mi := &file_multisig_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1840,7 +2043,7 @@ func (x *ReceiptOwnerModOrRep) String() string {
 func (*ReceiptOwnerModOrRep) ProtoMessage() {}
 
 func (x *ReceiptOwnerModOrRep) ProtoReflect() protoreflect.Message {
-	mi := &file_multisig_proto_msgTypes[26]
+	mi := &file_multisig_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1853,7 +2056,7 @@ func (x *ReceiptOwnerModOrRep) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReceiptOwnerModOrRep.ProtoReflect.Descriptor instead.
 func (*ReceiptOwnerModOrRep) Descriptor() ([]byte, []int) {
-	return file_multisig_proto_rawDescGZIP(), []int{26}
+	return file_multisig_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *ReceiptOwnerModOrRep) GetMultiSigAddr() string {
@@ -1899,7 +2102,7 @@ type ReceiptWeightModify struct {
 func (x *ReceiptWeightModify) Reset() {
 	*x = ReceiptWeightModify{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_multisig_proto_msgTypes[27]
+		mi := &file_multisig_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1912,7 +2115,7 @@ func (x *ReceiptWeightModify) String() string {
 func (*ReceiptWeightModify) ProtoMessage() {}
 
 func (x *ReceiptWeightModify) ProtoReflect() protoreflect.Message {
-	mi := &file_multisig_proto_msgTypes[27]
+	mi := &file_multisig_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1925,7 +2128,7 @@ func (x *ReceiptWeightModify) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReceiptWeightModify.ProtoReflect.Descriptor instead.
 func (*ReceiptWeightModify) Descriptor() ([]byte, []int) {
-	return file_multisig_proto_rawDescGZIP(), []int{27}
+	return file_multisig_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *ReceiptWeightModify) GetMultiSigAddr() string {
@@ -1965,7 +2168,7 @@ type ReceiptDailyLimitOperate struct {
 func (x *ReceiptDailyLimitOperate) Reset() {
 	*x = ReceiptDailyLimitOperate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_multisig_proto_msgTypes[28]
+		mi := &file_multisig_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1978,7 +2181,7 @@ func (x *ReceiptDailyLimitOperate) String() string {
 func (*ReceiptDailyLimitOperate) ProtoMessage() {}
 
 func (x *ReceiptDailyLimitOperate) ProtoReflect() protoreflect.Message {
-	mi := &file_multisig_proto_msgTypes[28]
+	mi := &file_multisig_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1991,7 +2194,7 @@ func (x *ReceiptDailyLimitOperate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReceiptDailyLimitOperate.ProtoReflect.Descriptor instead.
 func (*ReceiptDailyLimitOperate) Descriptor() ([]byte, []int) {
-	return file_multisig_proto_rawDescGZIP(), []int{28}
+	return file_multisig_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *ReceiptDailyLimitOperate) GetMultiSigAddr() string {
@@ -2037,7 +2240,7 @@ type ReceiptConfirmTx struct {
 func (x *ReceiptConfirmTx) Reset() {
 	*x = ReceiptConfirmTx{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_multisig_proto_msgTypes[29]
+		mi := &file_multisig_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2050,7 +2253,7 @@ func (x *ReceiptConfirmTx) String() string {
 func (*ReceiptConfirmTx) ProtoMessage() {}
 
 func (x *ReceiptConfirmTx) ProtoReflect() protoreflect.Message {
-	mi := &file_multisig_proto_msgTypes[29]
+	mi := &file_multisig_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2063,7 +2266,7 @@ func (x *ReceiptConfirmTx) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReceiptConfirmTx.ProtoReflect.Descriptor instead.
 func (*ReceiptConfirmTx) Descriptor() ([]byte, []int) {
-	return file_multisig_proto_rawDescGZIP(), []int{29}
+	return file_multisig_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *ReceiptConfirmTx) GetMultiSigTxOwner() *MultiSigTxOwner {
@@ -2094,7 +2297,7 @@ type ReceiptAccDailyLimitUpdate struct {
 func (x *ReceiptAccDailyLimitUpdate) Reset() {
 	*x = ReceiptAccDailyLimitUpdate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_multisig_proto_msgTypes[30]
+		mi := &file_multisig_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2107,7 +2310,7 @@ func (x *ReceiptAccDailyLimitUpdate) String() string {
 func (*ReceiptAccDailyLimitUpdate) ProtoMessage() {}
 
 func (x *ReceiptAccDailyLimitUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_multisig_proto_msgTypes[30]
+	mi := &file_multisig_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2120,7 +2323,7 @@ func (x *ReceiptAccDailyLimitUpdate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReceiptAccDailyLimitUpdate.ProtoReflect.Descriptor instead.
 func (*ReceiptAccDailyLimitUpdate) Descriptor() ([]byte, []int) {
-	return file_multisig_proto_rawDescGZIP(), []int{30}
+	return file_multisig_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *ReceiptAccDailyLimitUpdate) GetMultiSigAddr() string {
@@ -2145,6 +2348,7 @@ func (x *ReceiptAccDailyLimitUpdate) GetCurDailyLimit() *DailyLimit {
 }
 
 //执行MultiSigAcc相关的交易可能会修改tx的执行状态和增加确认owner
+//executeOnly:已经确认的owner在时间锁到期后触发执行，不增加确认owner
 type ReceiptMultiSigTx struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2156,12 +2360,15 @@ type ReceiptMultiSigTx struct {
 	SubmitOrConfirm bool             `protobuf:"varint,4,opt,name=submitOrConfirm,proto3" json:"submitOrConfirm,omitempty"`
 	TxHash          string           `protobuf:"bytes,5,opt,name=txHash,proto3" json:"txHash,omitempty"`
 	TxType          uint64           `protobuf:"varint,6,opt,name=txType,proto3" json:"txType,omitempty"`
+	ExecuteAfter    int64            `protobuf:"varint,7,opt,name=executeAfter,proto3" json:"executeAfter,omitempty"`
+	ExpireAt        int64            `protobuf:"varint,8,opt,name=expireAt,proto3" json:"expireAt,omitempty"`
+	ExecuteOnly     bool             `protobuf:"varint,9,opt,name=executeOnly,proto3" json:"executeOnly,omitempty"`
 }
 
 func (x *ReceiptMultiSigTx) Reset() {
 	*x = ReceiptMultiSigTx{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_multisig_proto_msgTypes[31]
+		mi := &file_multisig_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2174,7 +2381,7 @@ func (x *ReceiptMultiSigTx) String() string {
 func (*ReceiptMultiSigTx) ProtoMessage() {}
 
 func (x *ReceiptMultiSigTx) ProtoReflect() protoreflect.Message {
-	mi := &file_multisig_proto_msgTypes[31]
+	mi := &file_multisig_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2187,7 +2394,7 @@ func (x *ReceiptMultiSigTx) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReceiptMultiSigTx.ProtoReflect.Descriptor instead.
 func (*ReceiptMultiSigTx) Descriptor() ([]byte, []int) {
-	return file_multisig_proto_rawDescGZIP(), []int{31}
+	return file_multisig_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *ReceiptMultiSigTx) GetMultiSigTxOwner() *MultiSigTxOwner {
@@ -2232,6 +2439,99 @@ func (x *ReceiptMultiSigTx) GetTxType() uint64 {
 	return 0
 }
 
+func (x *ReceiptMultiSigTx) GetExecuteAfter() int64 {
+	if x != nil {
+		return x.ExecuteAfter
+	}
+	return 0
+}
+
+func (x *ReceiptMultiSigTx) GetExpireAt() int64 {
+	if x != nil {
+		return x.ExpireAt
+	}
+	return 0
+}
+
+func (x *ReceiptMultiSigTx) GetExecuteOnly() bool {
+	if x != nil {
+		return x.ExecuteOnly
+	}
+	return false
+}
+
+// TyLogMultiSigExecTx = 10013 //输出以多重签名账户为发送者执行的交易
+type ReceiptExecTx struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MultiSigAddr string `protobuf:"bytes,1,opt,name=multiSigAddr,proto3" json:"multiSigAddr,omitempty"`
+	Txid         uint64 `protobuf:"varint,2,opt,name=txid,proto3" json:"txid,omitempty"`
+	Execer       string `protobuf:"bytes,3,opt,name=execer,proto3" json:"execer,omitempty"`
+	TxHash       string `protobuf:"bytes,4,opt,name=txHash,proto3" json:"txHash,omitempty"`
+}
+
+func (x *ReceiptExecTx) Reset() {
+	*x = ReceiptExecTx{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_multisig_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReceiptExecTx) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiptExecTx) ProtoMessage() {}
+
+func (x *ReceiptExecTx) ProtoReflect() protoreflect.Message {
+	mi := &file_multisig_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiptExecTx.ProtoReflect.Descriptor instead.
+func (*ReceiptExecTx) Descriptor() ([]byte, []int) {
+	return file_multisig_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ReceiptExecTx) GetMultiSigAddr() string {
+	if x != nil {
+		return x.MultiSigAddr
+	}
+	return ""
+}
+
+func (x *ReceiptExecTx) GetTxid() uint64 {
+	if x != nil {
+		return x.Txid
+	}
+	return 0
+}
+
+func (x *ReceiptExecTx) GetExecer() string {
+	if x != nil {
+		return x.Execer
+	}
+	return ""
+}
+
+func (x *ReceiptExecTx) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
 type ReceiptTxCountUpdate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2244,7 +2544,7 @@ type ReceiptTxCountUpdate struct {
 func (x *ReceiptTxCountUpdate) Reset() {
 	*x = ReceiptTxCountUpdate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_multisig_proto_msgTypes[32]
+		mi := &file_multisig_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2257,7 +2557,7 @@ func (x *ReceiptTxCountUpdate) String() string {
 func (*ReceiptTxCountUpdate) ProtoMessage() {}
 
 func (x *ReceiptTxCountUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_multisig_proto_msgTypes[32]
+	mi := &file_multisig_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2270,7 +2570,7 @@ func (x *ReceiptTxCountUpdate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReceiptTxCountUpdate.ProtoReflect.Descriptor instead.
 func (*ReceiptTxCountUpdate) Descriptor() ([]byte, []int) {
-	return file_multisig_proto_rawDescGZIP(), []int{32}
+	return file_multisig_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *ReceiptTxCountUpdate) GetMultiSigAddr() string {
@@ -2300,7 +2600,7 @@ type MultiSigTxOwner struct {
 func (x *MultiSigTxOwner) Reset() {
 	*x = MultiSigTxOwner{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_multisig_proto_msgTypes[33]
+		mi := &file_multisig_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2313,7 +2613,7 @@ func (x *MultiSigTxOwner) String() string {
 func (*MultiSigTxOwner) ProtoMessage() {}
 
 func (x *MultiSigTxOwner) ProtoReflect() protoreflect.Message {
-	mi := &file_multisig_proto_msgTypes[33]
+	mi := &file_multisig_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2326,7 +2626,7 @@ func (x *MultiSigTxOwner) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MultiSigTxOwner.ProtoReflect.Descriptor instead.
 func (*MultiSigTxOwner) Descriptor() ([]byte, []int) {
-	return file_multisig_proto_rawDescGZIP(), []int{33}
+	return file_multisig_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *MultiSigTxOwner) GetMultiSigAddr() string {
@@ -2361,7 +2661,7 @@ type Uint64 struct {
 func (x *Uint64) Reset() {
 	*x = Uint64{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_multisig_proto_msgTypes[34]
+		mi := &file_multisig_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2374,7 +2674,7 @@ func (x *Uint64) String() string {
 func (*Uint64) ProtoMessage() {}
 
 func (x *Uint64) ProtoReflect() protoreflect.Message {
-	mi := &file_multisig_proto_msgTypes[34]
+	mi := &file_multisig_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2387,7 +2687,7 @@ func (x *Uint64) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Uint64.ProtoReflect.Descriptor instead.
 func (*Uint64) Descriptor() ([]byte, []int) {
-	return file_multisig_proto_rawDescGZIP(), []int{34}
+	return file_multisig_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *Uint64) GetData() uint64 {
@@ -2411,7 +2711,7 @@ type AccountAssets struct {
 func (x *AccountAssets) Reset() {
 	*x = AccountAssets{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_multisig_proto_msgTypes[35]
+		mi := &file_multisig_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2424,7 +2724,7 @@ func (x *AccountAssets) String() string {
 func (*AccountAssets) ProtoMessage() {}
 
 func (x *AccountAssets) ProtoReflect() protoreflect.Message {
-	mi := &file_multisig_proto_msgTypes[35]
+	mi := &file_multisig_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2437,7 +2737,7 @@ func (x *AccountAssets) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AccountAssets.ProtoReflect.Descriptor instead.
 func (*AccountAssets) Descriptor() ([]byte, []int) {
-	return file_multisig_proto_rawDescGZIP(), []int{35}
+	return file_multisig_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *AccountAssets) GetMultiSigAddr() string {
@@ -2475,7 +2775,7 @@ type ReqAccAssets struct {
 func (x *ReqAccAssets) Reset() {
 	*x = ReqAccAssets{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_multisig_proto_msgTypes[36]
+		mi := &file_multisig_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2488,7 +2788,7 @@ func (x *ReqAccAssets) String() string {
 func (*ReqAccAssets) ProtoMessage() {}
 
 func (x *ReqAccAssets) ProtoReflect() protoreflect.Message {
-	mi := &file_multisig_proto_msgTypes[36]
+	mi := &file_multisig_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2501,7 +2801,7 @@ func (x *ReqAccAssets) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReqAccAssets.ProtoReflect.Descriptor instead.
 func (*ReqAccAssets) Descriptor() ([]byte, []int) {
-	return file_multisig_proto_rawDescGZIP(), []int{36}
+	return file_multisig_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *ReqAccAssets) GetMultiSigAddr() string {
@@ -2536,7 +2836,7 @@ type ReplyAccAssets struct {
 func (x *ReplyAccAssets) Reset() {
 	*x = ReplyAccAssets{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_multisig_proto_msgTypes[37]
+		mi := &file_multisig_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2549,7 +2849,7 @@ func (x *ReplyAccAssets) String() string {
 func (*ReplyAccAssets) ProtoMessage() {}
 
 func (x *ReplyAccAssets) ProtoReflect() protoreflect.Message {
-	mi := &file_multisig_proto_msgTypes[37]
+	mi := &file_multisig_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2562,7 +2862,7 @@ func (x *ReplyAccAssets) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReplyAccAssets.ProtoReflect.Descriptor instead.
 func (*ReplyAccAssets) Descriptor() ([]byte, []int) {
-	return file_multisig_proto_rawDescGZIP(), []int{37}
+	return file_multisig_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *ReplyAccAssets) GetAccAssets() []*AccAssets {
@@ -2586,7 +2886,7 @@ type AccAssets struct {
 func (x *AccAssets) Reset() {
 	*x = AccAssets{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_multisig_proto_msgTypes[38]
+		mi := &file_multisig_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2599,7 +2899,7 @@ func (x *AccAssets) String() string {
 func (*AccAssets) ProtoMessage() {}
 
 func (x *AccAssets) ProtoReflect() protoreflect.Message {
-	mi := &file_multisig_proto_msgTypes[38]
+	mi := &file_multisig_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2612,7 +2912,7 @@ func (x *AccAssets) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AccAssets.ProtoReflect.Descriptor instead.
 func (*AccAssets) Descriptor() ([]byte, []int) {
-	return file_multisig_proto_rawDescGZIP(), []int{38}
+	return file_multisig_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *AccAssets) GetAssets() *Assets {
@@ -2648,7 +2948,7 @@ type Assets struct {
 func (x *Assets) Reset() {
 	*x = Assets{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_multisig_proto_msgTypes[39]
+		mi := &file_multisig_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2661,7 +2961,7 @@ func (x *Assets) String() string {
 func (*Assets) ProtoMessage() {}
 
 func (x *Assets) ProtoReflect() protoreflect.Message {
-	mi := &file_multisig_proto_msgTypes[39]
+	mi := &file_multisig_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2674,7 +2974,7 @@ func (x *Assets) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Assets.ProtoReflect.Descriptor instead.
 func (*Assets) Descriptor() ([]byte, []int) {
-	return file_multisig_proto_rawDescGZIP(), []int{39}
+	return file_multisig_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *Assets) GetExecer() string {
@@ -2703,7 +3003,7 @@ type AccAddress struct {
 func (x *AccAddress) Reset() {
 	*x = AccAddress{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_multisig_proto_msgTypes[40]
+		mi := &file_multisig_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2716,7 +3016,7 @@ func (x *AccAddress) String() string {
 func (*AccAddress) ProtoMessage() {}
 
 func (x *AccAddress) ProtoReflect() protoreflect.Message {
-	mi := &file_multisig_proto_msgTypes[40]
+	mi := &file_multisig_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2729,7 +3029,7 @@ func (x *AccAddress) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AccAddress.ProtoReflect.Descriptor instead.
 func (*AccAddress) Descriptor() ([]byte, []int) {
-	return file_multisig_proto_rawDescGZIP(), []int{40}
+	return file_multisig_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *AccAddress) GetAddress() []string {
@@ -2753,7 +3053,7 @@ type OwnerAttr struct {
 func (x *OwnerAttr) Reset() {
 	*x = OwnerAttr{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_multisig_proto_msgTypes[41]
+		mi := &file_multisig_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2766,7 +3066,7 @@ func (x *OwnerAttr) String() string {
 func (*OwnerAttr) ProtoMessage() {}
 
 func (x *OwnerAttr) ProtoReflect() protoreflect.Message {
-	mi := &file_multisig_proto_msgTypes[41]
+	mi := &file_multisig_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2779,7 +3079,7 @@ func (x *OwnerAttr) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OwnerAttr.ProtoReflect.Descriptor instead.
 func (*OwnerAttr) Descriptor() ([]byte, []int) {
-	return file_multisig_proto_rawDescGZIP(), []int{41}
+	return file_multisig_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *OwnerAttr) GetMultiSigAddr() string {
@@ -2814,7 +3114,7 @@ type OwnerAttrs struct {
 func (x *OwnerAttrs) Reset() {
 	*x = OwnerAttrs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_multisig_proto_msgTypes[42]
+		mi := &file_multisig_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2827,7 +3127,7 @@ func (x *OwnerAttrs) String() string {
 func (*OwnerAttrs) ProtoMessage() {}
 
 func (x *OwnerAttrs) ProtoReflect() protoreflect.Message {
-	mi := &file_multisig_proto_msgTypes[42]
+	mi := &file_multisig_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2840,7 +3140,7 @@ func (x *OwnerAttrs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OwnerAttrs.ProtoReflect.Descriptor instead.
 func (*OwnerAttrs) Descriptor() ([]byte, []int) {
-	return file_multisig_proto_rawDescGZIP(), []int{42}
+	return file_multisig_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *OwnerAttrs) GetItems() []*OwnerAttr {
@@ -2855,7 +3155,7 @@ var File_multisig_proto protoreflect.FileDescriptor
 var file_multisig_proto_rawDesc = []byte{
 	0x0a, 0x0e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x12, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x1a, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xeb, 0x01, 0x0a, 0x08, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8f, 0x02, 0x0a, 0x08, 0x4d, 0x75, 0x6c, 0x74, 0x69,
 	0x53, 0x69, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x64, 0x64,
 	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
 	0x64, 0x64, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41,
@@ -2870,130 +3170,168 @@ var file_multisig_proto_rawDesc = []byte{
 	0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0e,
 	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06,
 	0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x57, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x22, 0x46, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65,
-	0x64, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72,
-	0x6d, 0x65, 0x64, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x0e, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x22, 0xc6, 0x01, 0x0a,
-	0x0a, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x54, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x74,
-	0x78, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x12,
-	0x16, 0x0a, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x65, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x78, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x06, 0x74, 0x78, 0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x6d,
-	0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x12,
-	0x34, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x4f, 0x77, 0x6e, 0x65,
-	0x72, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64,
-	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x22, 0x3d, 0x0a, 0x05, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1c,
-	0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06,
-	0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x77, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x22, 0x96, 0x01, 0x0a, 0x0a, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x4c, 0x69,
-	0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x65,
-	0x78, 0x65, 0x63, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x65,
-	0x63, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x4c, 0x69, 0x6d, 0x69,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x4c, 0x69,
-	0x6d, 0x69, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x64, 0x61,
-	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x54, 0x6f,
-	0x64, 0x61, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x44, 0x61, 0x79, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x44, 0x61, 0x79, 0x22, 0x62, 0x0a,
-	0x10, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x4c, 0x69, 0x6d, 0x69,
-	0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x65,
-	0x63, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x65, 0x63, 0x65,
-	0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x4c, 0x69, 0x6d, 0x69,
-	0x74, 0x22, 0x95, 0x04, 0x0a, 0x0e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x48, 0x0a, 0x11, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67,
-	0x41, 0x63, 0x63, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67,
-	0x41, 0x63, 0x63, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x11, 0x6d, 0x75, 0x6c,
-	0x74, 0x69, 0x53, 0x69, 0x67, 0x41, 0x63, 0x63, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x51,
-	0x0a, 0x14, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x4f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x4f, 0x77, 0x6e,
-	0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x14, 0x6d, 0x75, 0x6c,
-	0x74, 0x69, 0x53, 0x69, 0x67, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x65, 0x12, 0x4b, 0x0a, 0x12, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41, 0x63, 0x63,
-	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41, 0x63,
-	0x63, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x12, 0x6d, 0x75, 0x6c, 0x74,
-	0x69, 0x53, 0x69, 0x67, 0x41, 0x63, 0x63, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x48,
-	0x0a, 0x11, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72,
-	0x6d, 0x54, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72,
-	0x6d, 0x54, 0x78, 0x48, 0x00, 0x52, 0x11, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x54, 0x78, 0x12, 0x57, 0x0a, 0x16, 0x6d, 0x75, 0x6c, 0x74,
-	0x69, 0x53, 0x69, 0x67, 0x45, 0x78, 0x65, 0x63, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
-	0x54, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x45, 0x78, 0x65, 0x63, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x6f, 0x48, 0x00, 0x52, 0x16, 0x6d, 0x75, 0x6c, 0x74, 0x69,
-	0x53, 0x69, 0x67, 0x45, 0x78, 0x65, 0x63, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54,
-	0x6f, 0x12, 0x5d, 0x0a, 0x18, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x45, 0x78, 0x65,
-	0x63, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x75, 0x6c, 0x74,
-	0x69, 0x53, 0x69, 0x67, 0x45, 0x78, 0x65, 0x63, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
-	0x46, 0x72, 0x6f, 0x6d, 0x48, 0x00, 0x52, 0x18, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67,
-	0x45, 0x78, 0x65, 0x63, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x46, 0x72, 0x6f, 0x6d,
-	0x12, 0x0e, 0x0a, 0x02, 0x54, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x54, 0x79,
-	0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x9a, 0x01, 0x0a, 0x11, 0x4d, 0x75,
-	0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41, 0x63, 0x63, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12,
-	0x24, 0x0a, 0x06, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x06, 0x6f,
-	0x77, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
-	0x64, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x72,
-	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x37, 0x0a,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x78,
+	0x48, 0x61, 0x73, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x22, 0x46, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x72, 0x6d, 0x65, 0x64, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x0e, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4f, 0x77, 0x6e, 0x65, 0x72,
+	0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x4f, 0x77, 0x6e, 0x65, 0x72,
+	0x22, 0x9e, 0x02, 0x0a, 0x0a, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x54, 0x78, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x74,
+	0x78, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x78, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x74, 0x78, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x22, 0x0a, 0x0c, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41,
+	0x64, 0x64, 0x72, 0x12, 0x34, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64,
+	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x72, 0x6d, 0x65, 0x64, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0c, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a,
+	0x08, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0x3d, 0x0a, 0x05, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x22, 0x96, 0x01, 0x0a, 0x0a, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x65, 0x63, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x65, 0x63, 0x65, 0x72, 0x12,
+	0x1e, 0x0a, 0x0a, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x1e, 0x0a, 0x0a, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x64, 0x61, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x64, 0x61, 0x79, 0x12,
+	0x18, 0x0a, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x44, 0x61, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x44, 0x61, 0x79, 0x22, 0x62, 0x0a, 0x10, 0x53, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x65, 0x63, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x65, 0x63, 0x65, 0x72, 0x12, 0x1e, 0x0a,
 	0x0a, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x17, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
-	0x44, 0x61, 0x69, 0x6c, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x0a, 0x64, 0x61, 0x69, 0x6c,
-	0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xb8, 0x01, 0x0a, 0x14, 0x4d, 0x75, 0x6c, 0x74, 0x69,
-	0x53, 0x69, 0x67, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12,
-	0x28, 0x0a, 0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41, 0x63, 0x63, 0x41, 0x64,
-	0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53,
-	0x69, 0x67, 0x41, 0x63, 0x63, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x6c, 0x64,
-	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x6c, 0x64,
-	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x65, 0x77, 0x4f, 0x77, 0x6e, 0x65,
-	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x4f, 0x77, 0x6e, 0x65,
-	0x72, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6e, 0x65, 0x77, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
-	0x20, 0x0a, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x46, 0x6c, 0x61,
-	0x67, 0x22, 0xc7, 0x01, 0x0a, 0x12, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41, 0x63,
-	0x63, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x6d, 0x75, 0x6c, 0x74,
-	0x69, 0x53, 0x69, 0x67, 0x41, 0x63, 0x63, 0x41, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41, 0x63, 0x63, 0x41, 0x64,
-	0x64, 0x72, 0x12, 0x37, 0x0a, 0x0a, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53,
-	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52,
-	0x0a, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x2c, 0x0a, 0x11, 0x6e,
-	0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6e, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x69,
-	0x72, 0x65, 0x64, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
-	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x22, 0x9e, 0x01, 0x0a, 0x18,
-	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x45, 0x78, 0x65, 0x63, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x66, 0x65, 0x72, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62,
-	0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
-	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08,
-	0x65, 0x78, 0x65, 0x63, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x65, 0x78, 0x65, 0x63, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x22, 0x88, 0x01, 0x0a,
-	0x16, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x45, 0x78, 0x65, 0x63, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f,
-	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12,
-	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65,
-	0x78, 0x65, 0x63, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65,
-	0x78, 0x65, 0x63, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x22, 0x7b, 0x0a, 0x11, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x04, 0x52, 0x0a, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xd6, 0x04,
+	0x0a, 0x0e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x48, 0x0a, 0x11, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41, 0x63, 0x63, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41, 0x63, 0x63, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x11, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69,
+	0x67, 0x41, 0x63, 0x63, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x51, 0x0a, 0x14, 0x6d, 0x75,
+	0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x14, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69,
+	0x67, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x4b, 0x0a,
+	0x12, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41, 0x63, 0x63, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41, 0x63, 0x63, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x12, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67,
+	0x41, 0x63, 0x63, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x48, 0x0a, 0x11, 0x6d, 0x75,
+	0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x54, 0x78, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x75,
+	0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x54, 0x78, 0x48,
+	0x00, 0x52, 0x11, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x72, 0x6d, 0x54, 0x78, 0x12, 0x57, 0x0a, 0x16, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67,
+	0x45, 0x78, 0x65, 0x63, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x6f, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x53, 0x69, 0x67, 0x45, 0x78, 0x65, 0x63, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x54, 0x6f, 0x48, 0x00, 0x52, 0x16, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x45,
+	0x78, 0x65, 0x63, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x6f, 0x12, 0x5d, 0x0a,
+	0x18, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x45, 0x78, 0x65, 0x63, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67,
+	0x45, 0x78, 0x65, 0x63, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x46, 0x72, 0x6f, 0x6d,
+	0x48, 0x00, 0x52, 0x18, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x45, 0x78, 0x65, 0x63,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x3f, 0x0a, 0x0e,
+	0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x45, 0x78, 0x65, 0x63, 0x54, 0x78, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x53, 0x69, 0x67, 0x45, 0x78, 0x65, 0x63, 0x54, 0x78, 0x48, 0x00, 0x52, 0x0e, 0x6d,
+	0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x45, 0x78, 0x65, 0x63, 0x54, 0x78, 0x12, 0x0e, 0x0a,
+	0x02, 0x54, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x54, 0x79, 0x42, 0x07, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x9a, 0x01, 0x0a, 0x11, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x53, 0x69, 0x67, 0x41, 0x63, 0x63, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x06,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x06, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x57, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x37, 0x0a, 0x0a, 0x64, 0x61,
+	0x69, 0x6c, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x44, 0x61, 0x69,
+	0x6c, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x0a, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x22, 0xf8, 0x01, 0x0a, 0x14, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67,
+	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x0f,
+	0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41, 0x63, 0x63, 0x41, 0x64, 0x64, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41,
+	0x63, 0x63, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x6c, 0x64, 0x4f, 0x77, 0x6e,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x6c, 0x64, 0x4f, 0x77, 0x6e,
+	0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x65, 0x77, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1c,
+	0x0a, 0x09, 0x6e, 0x65, 0x77, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x6e, 0x65, 0x77, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x20, 0x0a, 0x0b,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x22,
+	0x0a, 0x0c, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x41, 0x66, 0x74,
+	0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x41, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x41, 0x74, 0x22, 0x87,
+	0x02, 0x0a, 0x12, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41, 0x63, 0x63, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69,
+	0x67, 0x41, 0x63, 0x63, 0x41, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41, 0x63, 0x63, 0x41, 0x64, 0x64, 0x72, 0x12,
+	0x37, 0x0a, 0x0a, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x0a, 0x64, 0x61,
+	0x69, 0x6c, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x2c, 0x0a, 0x11, 0x6e, 0x65, 0x77, 0x52,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x11, 0x6e, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
+	0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x46, 0x6c, 0x61, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x41, 0x74, 0x22, 0xde, 0x01, 0x0a, 0x18, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x53, 0x69, 0x67, 0x45, 0x78, 0x65, 0x63, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x65,
+	0x63, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x78, 0x65,
+	0x63, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0c, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a,
+	0x08, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x41, 0x74, 0x22, 0x88, 0x01, 0x0a, 0x16, 0x4d, 0x75,
+	0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x45, 0x78, 0x65, 0x63, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x54, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x65, 0x63,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x78, 0x65, 0x63,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x74, 0x6f, 0x22, 0xe0, 0x01, 0x0a, 0x0e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69,
+	0x67, 0x45, 0x78, 0x65, 0x63, 0x54, 0x78, 0x12, 0x28, 0x0a, 0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69,
+	0x53, 0x69, 0x67, 0x41, 0x63, 0x63, 0x41, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41, 0x63, 0x63, 0x41, 0x64, 0x64,
+	0x72, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x65, 0x63, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x65, 0x78, 0x65, 0x63, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x74, 0x6f, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x78, 0x48,
+	0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x41, 0x74, 0x22, 0x7b, 0x0a, 0x11, 0x4d, 0x75, 0x6c, 0x74, 0x69,
 	0x53, 0x69, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x54, 0x78, 0x12, 0x28, 0x0a, 0x0f,
 	0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41, 0x63, 0x63, 0x41, 0x64, 0x64, 0x72, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41,
@@ -3027,7 +3365,7 @@ var file_multisig_proto_rawDesc = []byte{
 	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74,
 	0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x57, 0x65, 0x69, 0x67,
 	0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
-	0x65, 0x64, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xa0, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x71,
+	0x65, 0x64, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xba, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x71,
 	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x54, 0x78, 0x69, 0x64, 0x73, 0x12, 0x22, 0x0a,
 	0x0c, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41, 0x64, 0x64,
@@ -3037,178 +3375,193 @@ var file_multisig_proto_rawDesc = []byte{
 	0x6f, 0x54, 0x78, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
 	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12,
 	0x1a, 0x0a, 0x08, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x08, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x22, 0x4e, 0x0a, 0x12, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x54, 0x78, 0x69, 0x64,
-	0x73, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41, 0x64, 0x64,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69,
-	0x67, 0x41, 0x64, 0x64, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x78, 0x69, 0x64, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x04, 0x52, 0x05, 0x74, 0x78, 0x69, 0x64, 0x73, 0x22, 0x4b, 0x0a, 0x11, 0x52,
-	0x65, 0x71, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x54, 0x78, 0x49, 0x6e, 0x66, 0x6f,
+	0x08, 0x52, 0x08, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x64, 0x22, 0x4e, 0x0a, 0x12, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x4d, 0x75,
+	0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x54, 0x78, 0x69, 0x64, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x6d,
+	0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x78, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x05,
+	0x74, 0x78, 0x69, 0x64, 0x73, 0x22, 0x4b, 0x0a, 0x11, 0x52, 0x65, 0x71, 0x4d, 0x75, 0x6c, 0x74,
+	0x69, 0x53, 0x69, 0x67, 0x54, 0x78, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x75,
+	0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x78, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x74, 0x78,
+	0x49, 0x64, 0x22, 0x50, 0x0a, 0x13, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x53, 0x69, 0x67, 0x54, 0x78, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x39, 0x0a, 0x0e, 0x6d, 0x75, 0x6c,
+	0x74, 0x69, 0x53, 0x69, 0x67, 0x54, 0x78, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53,
+	0x69, 0x67, 0x54, 0x78, 0x52, 0x0e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x54, 0x78,
+	0x49, 0x6e, 0x66, 0x6f, 0x22, 0x70, 0x0a, 0x1a, 0x52, 0x65, 0x71, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x53, 0x69, 0x67, 0x41, 0x63, 0x63, 0x55, 0x6e, 0x53, 0x70, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x64,
+	0x61, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41, 0x64,
+	0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53,
+	0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x65, 0x63, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x65, 0x63, 0x65, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x22, 0x50, 0x0a, 0x12, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x55,
+	0x6e, 0x53, 0x70, 0x65, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x3a, 0x0a, 0x0d,
+	0x75, 0x6e, 0x53, 0x70, 0x65, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55, 0x6e, 0x53, 0x70,
+	0x65, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x52, 0x0d, 0x75, 0x6e, 0x53, 0x70, 0x65,
+	0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x22, 0x4e, 0x0a, 0x0d, 0x55, 0x6e, 0x53, 0x70,
+	0x65, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x06, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x52, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x35, 0x0a, 0x0f, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x70, 0x74, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x12, 0x22, 0x0a, 0x0c, 0x6d,
+	0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x22,
+	0x7a, 0x0a, 0x14, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x41,
+	0x64, 0x64, 0x4f, 0x72, 0x44, 0x65, 0x6c, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x75, 0x6c, 0x74, 0x69,
+	0x53, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d,
+	0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x12, 0x22, 0x0a, 0x05, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12,
+	0x1a, 0x0a, 0x08, 0x61, 0x64, 0x64, 0x4f, 0x72, 0x44, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x61, 0x64, 0x64, 0x4f, 0x72, 0x44, 0x65, 0x6c, 0x22, 0xb4, 0x01, 0x0a, 0x14,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x4f,
+	0x72, 0x52, 0x65, 0x70, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67,
+	0x41, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x75, 0x6c, 0x74,
+	0x69, 0x53, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x12, 0x2a, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x76,
+	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x09, 0x70, 0x72, 0x65, 0x76, 0x4f,
+	0x77, 0x6e, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4f,
+	0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x4f, 0x72, 0x52,
+	0x65, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6d, 0x6f, 0x64, 0x4f, 0x72, 0x52,
+	0x65, 0x70, 0x22, 0x7f, 0x0a, 0x13, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x57, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x75, 0x6c,
+	0x74, 0x69, 0x53, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1e, 0x0a,
+	0x0a, 0x70, 0x72, 0x65, 0x76, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x76, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x24, 0x0a,
+	0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x57, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x22, 0xd4, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x44,
+	0x61, 0x69, 0x6c, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65,
 	0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67,
-	0x41, 0x64, 0x64, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x22, 0x50, 0x0a, 0x13, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x54, 0x78, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x39, 0x0a, 0x0e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x54, 0x78, 0x49, 0x6e, 0x66,
-	0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x54, 0x78, 0x52, 0x0e, 0x6d, 0x75, 0x6c, 0x74,
-	0x69, 0x53, 0x69, 0x67, 0x54, 0x78, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x70, 0x0a, 0x1a, 0x52, 0x65,
-	0x71, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41, 0x63, 0x63, 0x55, 0x6e, 0x53, 0x70,
-	0x65, 0x6e, 0x74, 0x54, 0x6f, 0x64, 0x61, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x75, 0x6c, 0x74,
-	0x69, 0x53, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06,
-	0x65, 0x78, 0x65, 0x63, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x78,
-	0x65, 0x63, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x22, 0x50, 0x0a, 0x12,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x55, 0x6e, 0x53, 0x70, 0x65, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65,
-	0x74, 0x73, 0x12, 0x3a, 0x0a, 0x0d, 0x75, 0x6e, 0x53, 0x70, 0x65, 0x6e, 0x74, 0x41, 0x73, 0x73,
-	0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x55, 0x6e, 0x53, 0x70, 0x65, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x52,
-	0x0d, 0x75, 0x6e, 0x53, 0x70, 0x65, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x22, 0x4e,
-	0x0a, 0x0d, 0x55, 0x6e, 0x53, 0x70, 0x65, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12,
-	0x25, 0x0a, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x52, 0x06,
-	0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x35,
-	0x0a, 0x0f, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69,
-	0x67, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41, 0x64, 0x64,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69,
-	0x67, 0x41, 0x64, 0x64, 0x72, 0x22, 0x7a, 0x0a, 0x14, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
-	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x4f, 0x72, 0x44, 0x65, 0x6c, 0x12, 0x22, 0x0a,
-	0x0c, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41, 0x64, 0x64,
-	0x72, 0x12, 0x22, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x05,
-	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x64, 0x64, 0x4f, 0x72, 0x44, 0x65,
-	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x64, 0x64, 0x4f, 0x72, 0x44, 0x65,
-	0x6c, 0x22, 0xb4, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x4f, 0x77, 0x6e,
-	0x65, 0x72, 0x4d, 0x6f, 0x64, 0x4f, 0x72, 0x52, 0x65, 0x70, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x75,
-	0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x12, 0x2a,
-	0x0a, 0x09, 0x70, 0x72, 0x65, 0x76, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52,
-	0x09, 0x70, 0x72, 0x65, 0x76, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x0c, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x0c,
-	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08,
-	0x6d, 0x6f, 0x64, 0x4f, 0x72, 0x52, 0x65, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
-	0x6d, 0x6f, 0x64, 0x4f, 0x72, 0x52, 0x65, 0x70, 0x22, 0x7f, 0x0a, 0x13, 0x52, 0x65, 0x63, 0x65,
-	0x69, 0x70, 0x74, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x12,
+	0x41, 0x64, 0x64, 0x72, 0x12, 0x39, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x44, 0x61, 0x69, 0x6c,
+	0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52,
+	0x0e, 0x70, 0x72, 0x65, 0x76, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x37, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44,
+	0x61, 0x69, 0x6c, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x0d, 0x63, 0x75, 0x72, 0x44, 0x61,
+	0x69, 0x6c, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x64, 0x64, 0x4f,
+	0x72, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x61,
+	0x64, 0x64, 0x4f, 0x72, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x22, 0x80, 0x01, 0x0a, 0x10, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x54, 0x78, 0x12,
+	0x40, 0x0a, 0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x54, 0x78, 0x4f, 0x77, 0x6e,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x54, 0x78, 0x4f, 0x77, 0x6e, 0x65, 0x72,
+	0x52, 0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x54, 0x78, 0x4f, 0x77, 0x6e, 0x65,
+	0x72, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x4f, 0x72, 0x52,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x72, 0x6d, 0x65, 0x4f, 0x72, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x22, 0xb4, 0x01,
+	0x0a, 0x1a, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x41, 0x63, 0x63, 0x44, 0x61, 0x69, 0x6c,
+	0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0c,
+	0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72,
+	0x12, 0x39, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x0e, 0x70, 0x72, 0x65,
+	0x76, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x37, 0x0a, 0x0d, 0x63,
+	0x75, 0x72, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x61, 0x69, 0x6c, 0x79,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x0d, 0x63, 0x75, 0x72, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x22, 0xd7, 0x02, 0x0a, 0x11, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x54, 0x78, 0x12, 0x40, 0x0a, 0x0f, 0x6d, 0x75,
+	0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x54, 0x78, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x75, 0x6c, 0x74,
+	0x69, 0x53, 0x69, 0x67, 0x54, 0x78, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x0f, 0x6d, 0x75, 0x6c,
+	0x74, 0x69, 0x53, 0x69, 0x67, 0x54, 0x78, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c,
+	0x70, 0x72, 0x65, 0x76, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x76, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64,
+	0x12, 0x20, 0x0a, 0x0b, 0x63, 0x75, 0x72, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x65, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x73, 0x75, 0x62,
+	0x6d, 0x69, 0x74, 0x4f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x12, 0x16, 0x0a, 0x06,
+	0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78,
+	0x48, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x78, 0x54, 0x79, 0x70, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x74, 0x78, 0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0c,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72,
+	0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x41, 0x74, 0x12, 0x20, 0x0a, 0x0b,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0b, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x77,
+	0x0a, 0x0d, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x45, 0x78, 0x65, 0x63, 0x54, 0x78, 0x12,
+	0x22, 0x0a, 0x0c, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41,
+	0x64, 0x64, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x65, 0x63, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x65, 0x63, 0x65, 0x72, 0x12,
+	0x16, 0x0a, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x22, 0x5a, 0x0a, 0x14, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x70, 0x74, 0x54, 0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12,
 	0x22, 0x0a, 0x0c, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41,
-	0x64, 0x64, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x76, 0x57, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x76, 0x57, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x57, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x63, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x74, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xd4, 0x01, 0x0a, 0x18, 0x52, 0x65,
-	0x63, 0x65, 0x69, 0x70, 0x74, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53,
+	0x64, 0x64, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x75, 0x72, 0x54, 0x78, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x63, 0x75, 0x72, 0x54, 0x78, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x7f, 0x0a, 0x0f, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x54,
+	0x78, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53,
 	0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x75,
-	0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x12, 0x39, 0x0a, 0x0e, 0x70, 0x72,
-	0x65, 0x76, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x61, 0x69, 0x6c, 0x79,
-	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x44, 0x61, 0x69, 0x6c, 0x79,
-	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x37, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x44, 0x61, 0x69, 0x6c,
-	0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52,
-	0x0d, 0x63, 0x75, 0x72, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x20,
-	0x0a, 0x0b, 0x61, 0x64, 0x64, 0x4f, 0x72, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0b, 0x61, 0x64, 0x64, 0x4f, 0x72, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79,
-	0x22, 0x80, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x72, 0x6d, 0x54, 0x78, 0x12, 0x40, 0x0a, 0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69,
-	0x67, 0x54, 0x78, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x54,
-	0x78, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67,
-	0x54, 0x78, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x72, 0x6d, 0x65, 0x4f, 0x72, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x4f, 0x72, 0x52, 0x65, 0x76,
-	0x6f, 0x6b, 0x65, 0x22, 0xb4, 0x01, 0x0a, 0x1a, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x41,
-	0x63, 0x63, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41, 0x64,
-	0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53,
-	0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x12, 0x39, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x44, 0x61,
-	0x69, 0x6c, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x4c, 0x69, 0x6d, 0x69,
-	0x74, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x4c, 0x69, 0x6d, 0x69,
-	0x74, 0x12, 0x37, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x4c, 0x69, 0x6d,
-	0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x0d, 0x63, 0x75, 0x72,
-	0x44, 0x61, 0x69, 0x6c, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xf5, 0x01, 0x0a, 0x11, 0x52,
-	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x54, 0x78,
-	0x12, 0x40, 0x0a, 0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x54, 0x78, 0x4f, 0x77,
-	0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x54, 0x78, 0x4f, 0x77, 0x6e, 0x65,
-	0x72, 0x52, 0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x54, 0x78, 0x4f, 0x77, 0x6e,
-	0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x72, 0x65, 0x76, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
-	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x76, 0x45, 0x78,
-	0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x75, 0x72, 0x45, 0x78, 0x65,
-	0x63, 0x75, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x63, 0x75, 0x72,
-	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x6d,
-	0x69, 0x74, 0x4f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x72, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x78,
-	0x54, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x74, 0x78, 0x54, 0x79,
-	0x70, 0x65, 0x22, 0x5a, 0x0a, 0x14, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x54, 0x78, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x75,
-	0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1e,
-	0x0a, 0x0a, 0x63, 0x75, 0x72, 0x54, 0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0a, 0x63, 0x75, 0x72, 0x54, 0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x7f,
-	0x0a, 0x0f, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x54, 0x78, 0x4f, 0x77, 0x6e, 0x65,
-	0x72, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41, 0x64, 0x64,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69,
-	0x67, 0x41, 0x64, 0x64, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x0e, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52,
-	0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x22,
-	0x1c, 0x0a, 0x06, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x72, 0x0a,
-	0x0d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x22,
-	0x0a, 0x0c, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41, 0x64,
-	0x64, 0x72, 0x12, 0x25, 0x0a, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74,
-	0x73, 0x52, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x22, 0x6f, 0x0a, 0x0c, 0x52, 0x65, 0x71, 0x41, 0x63, 0x63, 0x41, 0x73, 0x73, 0x65, 0x74,
-	0x73, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41, 0x64, 0x64,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69,
-	0x67, 0x41, 0x64, 0x64, 0x72, 0x12, 0x25, 0x0a, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x73,
-	0x73, 0x65, 0x74, 0x73, 0x52, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05,
-	0x69, 0x73, 0x41, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x69, 0x73, 0x41,
-	0x6c, 0x6c, 0x22, 0x40, 0x0a, 0x0e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x41, 0x63, 0x63, 0x41, 0x73,
-	0x73, 0x65, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x09, 0x61, 0x63, 0x63, 0x41, 0x73, 0x73, 0x65, 0x74,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x41, 0x63, 0x63, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x52, 0x09, 0x61, 0x63, 0x63, 0x41, 0x73,
-	0x73, 0x65, 0x74, 0x73, 0x22, 0x7c, 0x0a, 0x09, 0x41, 0x63, 0x63, 0x41, 0x73, 0x73, 0x65, 0x74,
-	0x73, 0x12, 0x25, 0x0a, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73,
-	0x52, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x76,
-	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x65,
-	0x63, 0x76, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x22, 0x38, 0x0a, 0x06, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06,
-	0x65, 0x78, 0x65, 0x63, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x78,
-	0x65, 0x63, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x22, 0x26, 0x0a, 0x0a,
-	0x41, 0x63, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x22, 0x65, 0x0a, 0x09, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x41, 0x74, 0x74,
-	0x72, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41, 0x64, 0x64,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69,
-	0x67, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x41, 0x64,
-	0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x41,
-	0x64, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x34, 0x0a, 0x0a, 0x4f,
-	0x77, 0x6e, 0x65, 0x72, 0x41, 0x74, 0x74, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x05, 0x69, 0x74, 0x65,
-	0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x41, 0x74, 0x74, 0x72, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d,
-	0x73, 0x42, 0x0a, 0x5a, 0x08, 0x2e, 0x2e, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x12, 0x34,
+	0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x4f, 0x77, 0x6e, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4f,
+	0x77, 0x6e, 0x65, 0x72, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x4f,
+	0x77, 0x6e, 0x65, 0x72, 0x22, 0x1c, 0x0a, 0x06, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x12,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x22, 0x72, 0x0a, 0x0d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41,
+	0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x75, 0x6c, 0x74, 0x69,
+	0x53, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x12, 0x25, 0x0a, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x52, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x6f, 0x0a, 0x0c, 0x52, 0x65, 0x71, 0x41, 0x63, 0x63,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53,
+	0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x75,
+	0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x12, 0x25, 0x0a, 0x06, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x52, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x73, 0x41, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x69, 0x73, 0x41, 0x6c, 0x6c, 0x22, 0x40, 0x0a, 0x0e, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x41, 0x63, 0x63, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x09, 0x61, 0x63, 0x63,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x52, 0x09,
+	0x61, 0x63, 0x63, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x22, 0x7c, 0x0a, 0x09, 0x41, 0x63, 0x63,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x73, 0x52, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x72, 0x65, 0x63, 0x76, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x76, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a,
+	0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x38, 0x0a, 0x06, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x65, 0x63, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x65, 0x78, 0x65, 0x63, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f,
+	0x6c, 0x22, 0x26, 0x0a, 0x0a, 0x41, 0x63, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x65, 0x0a, 0x09, 0x4f, 0x77, 0x6e,
+	0x65, 0x72, 0x41, 0x74, 0x74, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53,
+	0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x75,
+	0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x22, 0x34, 0x0a, 0x0a, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x41, 0x74, 0x74, 0x72, 0x73, 0x12, 0x26,
+	0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x41, 0x74, 0x74, 0x72, 0x52,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x42, 0x0a, 0x5a, 0x08, 0x2e, 0x2e, 0x2f, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3223,7 +3576,7 @@ func file_multisig_proto_rawDescGZIP() []byte {
 	return file_multisig_proto_rawDescData
 }
 
-var file_multisig_proto_msgTypes = make([]protoimpl.MessageInfo, 43)
+var file_multisig_proto_msgTypes = make([]protoimpl.MessageInfo, 45)
 var file_multisig_proto_goTypes = []interface{}{
 	(*MultiSig)(nil),                   // 0: types.MultiSig
 	(*ConfirmedOwner)(nil),             // 1: types.ConfirmedOwner
@@ -3237,38 +3590,40 @@ var file_multisig_proto_goTypes = []interface{}{
 	(*MultiSigAccOperate)(nil),         // 9: types.MultiSigAccOperate
 	(*MultiSigExecTransferFrom)(nil),   // 10: types.MultiSigExecTransferFrom
 	(*MultiSigExecTransferTo)(nil),     // 11: types.MultiSigExecTransferTo
-	(*MultiSigConfirmTx)(nil),          // 12: types.MultiSigConfirmTx
-	(*ReqMultiSigAccs)(nil),            // 13: types.ReqMultiSigAccs
-	(*ReplyMultiSigAccs)(nil),          // 14: types.ReplyMultiSigAccs
-	(*ReqMultiSigAccInfo)(nil),         // 15: types.ReqMultiSigAccInfo
-	(*ReplyMultiSigAccInfo)(nil),       // 16: types.ReplyMultiSigAccInfo
-	(*ReqMultiSigTxids)(nil),           // 17: types.ReqMultiSigTxids
-	(*ReplyMultiSigTxids)(nil),         // 18: types.ReplyMultiSigTxids
-	(*ReqMultiSigTxInfo)(nil),          // 19: types.ReqMultiSigTxInfo
-	(*ReplyMultiSigTxInfo)(nil),        // 20: types.ReplyMultiSigTxInfo
-	(*ReqMultiSigAccUnSpentToday)(nil), // 21: types.ReqMultiSigAccUnSpentToday
-	(*ReplyUnSpentAssets)(nil),         // 22: types.ReplyUnSpentAssets
-	(*UnSpentAssets)(nil),              // 23: types.UnSpentAssets
-	(*ReceiptMultiSig)(nil),            // 24: types.ReceiptMultiSig
-	(*ReceiptOwnerAddOrDel)(nil),       // 25: types.ReceiptOwnerAddOrDel
-	(*ReceiptOwnerModOrRep)(nil),       // 26: types.ReceiptOwnerModOrRep
-	(*ReceiptWeightModify)(nil),        // 27: types.ReceiptWeightModify
-	(*ReceiptDailyLimitOperate)(nil),   // 28: types.ReceiptDailyLimitOperate
-	(*ReceiptConfirmTx)(nil),           // 29: types.ReceiptConfirmTx
-	(*ReceiptAccDailyLimitUpdate)(nil), // 30: types.ReceiptAccDailyLimitUpdate
-	(*ReceiptMultiSigTx)(nil),          // 31: types.ReceiptMultiSigTx
-	(*ReceiptTxCountUpdate)(nil),       // 32: types.ReceiptTxCountUpdate
-	(*MultiSigTxOwner)(nil),            // 33: types.MultiSigTxOwner
-	(*Uint64)(nil),                     // 34: types.Uint64
-	(*AccountAssets)(nil),              // 35: types.AccountAssets
-	(*ReqAccAssets)(nil),               // 36: types.ReqAccAssets
-	(*ReplyAccAssets)(nil),             // 37: types.ReplyAccAssets
-	(*AccAssets)(nil),                  // 38: types.AccAssets
-	(*Assets)(nil),                     // 39: types.Assets
-	(*AccAddress)(nil),                 // 40: types.AccAddress
-	(*OwnerAttr)(nil),                  // 41: types.OwnerAttr
-	(*OwnerAttrs)(nil),                 // 42: types.OwnerAttrs
-	(*types.Account)(nil),              // 43: types.Account
+	(*MultiSigExecTx)(nil),             // 12: types.MultiSigExecTx
+	(*MultiSigConfirmTx)(nil),          // 13: types.MultiSigConfirmTx
+	(*ReqMultiSigAccs)(nil),            // 14: types.ReqMultiSigAccs
+	(*ReplyMultiSigAccs)(nil),          // 15: types.ReplyMultiSigAccs
+	(*ReqMultiSigAccInfo)(nil),         // 16: types.ReqMultiSigAccInfo
+	(*ReplyMultiSigAccInfo)(nil),       // 17: types.ReplyMultiSigAccInfo
+	(*ReqMultiSigTxids)(nil),           // 18: types.ReqMultiSigTxids
+	(*ReplyMultiSigTxids)(nil),         // 19: types.ReplyMultiSigTxids
+	(*ReqMultiSigTxInfo)(nil),          // 20: types.ReqMultiSigTxInfo
+	(*ReplyMultiSigTxInfo)(nil),        // 21: types.ReplyMultiSigTxInfo
+	(*ReqMultiSigAccUnSpentToday)(nil), // 22: types.ReqMultiSigAccUnSpentToday
+	(*ReplyUnSpentAssets)(nil),         // 23: types.ReplyUnSpentAssets
+	(*UnSpentAssets)(nil),              // 24: types.UnSpentAssets
+	(*ReceiptMultiSig)(nil),            // 25: types.ReceiptMultiSig
+	(*ReceiptOwnerAddOrDel)(nil),       // 26: types.ReceiptOwnerAddOrDel
+	(*ReceiptOwnerModOrRep)(nil),       // 27: types.ReceiptOwnerModOrRep
+	(*ReceiptWeightModify)(nil),        // 28: types.ReceiptWeightModify
+	(*ReceiptDailyLimitOperate)(nil),   // 29: types.ReceiptDailyLimitOperate
+	(*ReceiptConfirmTx)(nil),           // 30: types.ReceiptConfirmTx
+	(*ReceiptAccDailyLimitUpdate)(nil), // 31: types.ReceiptAccDailyLimitUpdate
+	(*ReceiptMultiSigTx)(nil),          // 32: types.ReceiptMultiSigTx
+	(*ReceiptExecTx)(nil),              // 33: types.ReceiptExecTx
+	(*ReceiptTxCountUpdate)(nil),       // 34: types.ReceiptTxCountUpdate
+	(*MultiSigTxOwner)(nil),            // 35: types.MultiSigTxOwner
+	(*Uint64)(nil),                     // 36: types.Uint64
+	(*AccountAssets)(nil),              // 37: types.AccountAssets
+	(*ReqAccAssets)(nil),               // 38: types.ReqAccAssets
+	(*ReplyAccAssets)(nil),             // 39: types.ReplyAccAssets
+	(*AccAssets)(nil),                  // 40: types.AccAssets
+	(*Assets)(nil),                     // 41: types.Assets
+	(*AccAddress)(nil),                 // 42: types.AccAddress
+	(*OwnerAttr)(nil),                  // 43: types.OwnerAttr
+	(*OwnerAttrs)(nil),                 // 44: types.OwnerAttrs
+	(*types.Account)(nil),              // 45: types.Account
 }
 var file_multisig_proto_depIdxs = []int32{
 	3,  // 0: types.MultiSig.owners:type_name -> types.Owner
@@ -3278,38 +3633,39 @@ var file_multisig_proto_depIdxs = []int32{
 	7,  // 4: types.MultiSigAction.multiSigAccCreate:type_name -> types.MultiSigAccCreate
 	8,  // 5: types.MultiSigAction.multiSigOwnerOperate:type_name -> types.MultiSigOwnerOperate
 	9,  // 6: types.MultiSigAction.multiSigAccOperate:type_name -> types.MultiSigAccOperate
-	12, // 7: types.MultiSigAction.multiSigConfirmTx:type_name -> types.MultiSigConfirmTx
+	13, // 7: types.MultiSigAction.multiSigConfirmTx:type_name -> types.MultiSigConfirmTx
 	11, // 8: types.MultiSigAction.multiSigExecTransferTo:type_name -> types.MultiSigExecTransferTo
 	10, // 9: types.MultiSigAction.multiSigExecTransferFrom:type_name -> types.MultiSigExecTransferFrom
-	3,  // 10: types.MultiSigAccCreate.owners:type_name -> types.Owner
-	5,  // 11: types.MultiSigAccCreate.dailyLimit:type_name -> types.SymbolDailyLimit
-	5,  // 12: types.MultiSigAccOperate.dailyLimit:type_name -> types.SymbolDailyLimit
-	3,  // 13: types.ReplyMultiSigAccInfo.owners:type_name -> types.Owner
-	4,  // 14: types.ReplyMultiSigAccInfo.dailyLimits:type_name -> types.DailyLimit
-	2,  // 15: types.ReplyMultiSigTxInfo.multiSigTxInfo:type_name -> types.MultiSigTx
-	23, // 16: types.ReplyUnSpentAssets.unSpentAssets:type_name -> types.UnSpentAssets
-	39, // 17: types.UnSpentAssets.assets:type_name -> types.Assets
-	3,  // 18: types.ReceiptOwnerAddOrDel.owner:type_name -> types.Owner
-	3,  // 19: types.ReceiptOwnerModOrRep.prevOwner:type_name -> types.Owner
-	3,  // 20: types.ReceiptOwnerModOrRep.currentOwner:type_name -> types.Owner
-	4,  // 21: types.ReceiptDailyLimitOperate.prevDailyLimit:type_name -> types.DailyLimit
-	4,  // 22: types.ReceiptDailyLimitOperate.curDailyLimit:type_name -> types.DailyLimit
-	33, // 23: types.ReceiptConfirmTx.multiSigTxOwner:type_name -> types.MultiSigTxOwner
-	4,  // 24: types.ReceiptAccDailyLimitUpdate.prevDailyLimit:type_name -> types.DailyLimit
-	4,  // 25: types.ReceiptAccDailyLimitUpdate.curDailyLimit:type_name -> types.DailyLimit
-	33, // 26: types.ReceiptMultiSigTx.multiSigTxOwner:type_name -> types.MultiSigTxOwner
-	3,  // 27: types.MultiSigTxOwner.confirmedOwner:type_name -> types.Owner
-	39, // 28: types.AccountAssets.assets:type_name -> types.Assets
-	39, // 29: types.ReqAccAssets.assets:type_name -> types.Assets
-	38, // 30: types.ReplyAccAssets.accAssets:type_name -> types.AccAssets
-	39, // 31: types.AccAssets.assets:type_name -> types.Assets
-	43, // 32: types.AccAssets.account:type_name -> types.Account
-	41, // 33: types.OwnerAttrs.items:type_name -> types.OwnerAttr
-	34, // [34:34] is the sub-list for method output_type
-	34, // [34:34] is the sub-list for method input_type
-	34, // [34:34] is the sub-list for extension type_name
-	34, // [34:34] is the sub-list for extension extendee
-	0,  // [0:34] is the sub-list for field type_name
+	12, // 10: types.MultiSigAction.multiSigExecTx:type_name -> types.MultiSigExecTx
+	3,  // 11: types.MultiSigAccCreate.owners:type_name -> types.Owner
+	5,  // 12: types.MultiSigAccCreate.dailyLimit:type_name -> types.SymbolDailyLimit
+	5,  // 13: types.MultiSigAccOperate.dailyLimit:type_name -> types.SymbolDailyLimit
+	3,  // 14: types.ReplyMultiSigAccInfo.owners:type_name -> types.Owner
+	4,  // 15: types.ReplyMultiSigAccInfo.dailyLimits:type_name -> types.DailyLimit
+	2,  // 16: types.ReplyMultiSigTxInfo.multiSigTxInfo:type_name -> types.MultiSigTx
+	24, // 17: types.ReplyUnSpentAssets.unSpentAssets:type_name -> types.UnSpentAssets
+	41, // 18: types.UnSpentAssets.assets:type_name -> types.Assets
+	3,  // 19: types.ReceiptOwnerAddOrDel.owner:type_name -> types.Owner
+	3,  // 20: types.ReceiptOwnerModOrRep.prevOwner:type_name -> types.Owner
+	3,  // 21: types.ReceiptOwnerModOrRep.currentOwner:type_name -> types.Owner
+	4,  // 22: types.ReceiptDailyLimitOperate.prevDailyLimit:type_name -> types.DailyLimit
+	4,  // 23: types.ReceiptDailyLimitOperate.curDailyLimit:type_name -> types.DailyLimit
+	35, // 24: types.ReceiptConfirmTx.multiSigTxOwner:type_name -> types.MultiSigTxOwner
+	4,  // 25: types.ReceiptAccDailyLimitUpdate.prevDailyLimit:type_name -> types.DailyLimit
+	4,  // 26: types.ReceiptAccDailyLimitUpdate.curDailyLimit:type_name -> types.DailyLimit
+	35, // 27: types.ReceiptMultiSigTx.multiSigTxOwner:type_name -> types.MultiSigTxOwner
+	3,  // 28: types.MultiSigTxOwner.confirmedOwner:type_name -> types.Owner
+	41, // 29: types.AccountAssets.assets:type_name -> types.Assets
+	41, // 30: types.ReqAccAssets.assets:type_name -> types.Assets
+	40, // 31: types.ReplyAccAssets.accAssets:type_name -> types.AccAssets
+	41, // 32: types.AccAssets.assets:type_name -> types.Assets
+	45, // 33: types.AccAssets.account:type_name -> types.Account
+	43, // 34: types.OwnerAttrs.items:type_name -> types.OwnerAttr
+	35, // [35:35] is the sub-list for method output_type
+	35, // [35:35] is the sub-list for method input_type
+	35, // [35:35] is the sub-list for extension type_name
+	35, // [35:35] is the sub-list for extension extendee
+	0,  // [0:35] is the sub-list for field type_name
 }
 
 func init() { file_multisig_proto_init() }
@@ -3463,7 +3819,7 @@ func file_multisig_proto_init() {
 			}
 		}
 		file_multisig_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MultiSigConfirmTx); i {
+			switch v := v.(*MultiSigExecTx); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3475,7 +3831,7 @@ func file_multisig_proto_init() {
 			}
 		}
 		file_multisig_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqMultiSigAccs); i {
+			switch v := v.(*MultiSigConfirmTx); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3487,7 +3843,7 @@ func file_multisig_proto_init() {
 			}
 		}
 		file_multisig_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReplyMultiSigAccs); i {
+			switch v := v.(*ReqMultiSigAccs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3499,7 +3855,7 @@ func file_multisig_proto_init() {
 			}
 		}
 		file_multisig_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqMultiSigAccInfo); i {
+			switch v := v.(*ReplyMultiSigAccs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3511,7 +3867,7 @@ func file_multisig_proto_init() {
 			}
 		}
 		file_multisig_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReplyMultiSigAccInfo); i {
+			switch v := v.(*ReqMultiSigAccInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3523,7 +3879,7 @@ func file_multisig_proto_init() {
 			}
 		}
 		file_multisig_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqMultiSigTxids); i {
+			switch v := v.(*ReplyMultiSigAccInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3535,7 +3891,7 @@ func file_multisig_proto_init() {
 			}
 		}
 		file_multisig_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReplyMultiSigTxids); i {
+			switch v := v.(*ReqMultiSigTxids); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3547,7 +3903,7 @@ func file_multisig_proto_init() {
 			}
 		}
 		file_multisig_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqMultiSigTxInfo); i {
+			switch v := v.(*ReplyMultiSigTxids); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3559,7 +3915,7 @@ func file_multisig_proto_init() {
 			}
 		}
 		file_multisig_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReplyMultiSigTxInfo); i {
+			switch v := v.(*ReqMultiSigTxInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3571,7 +3927,7 @@ func file_multisig_proto_init() {
 			}
 		}
 		file_multisig_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqMultiSigAccUnSpentToday); i {
+			switch v := v.(*ReplyMultiSigTxInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3583,7 +3939,7 @@ func file_multisig_proto_init() {
 			}
 		}
 		file_multisig_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReplyUnSpentAssets); i {
+			switch v := v.(*ReqMultiSigAccUnSpentToday); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3595,7 +3951,7 @@ func file_multisig_proto_init() {
 			}
 		}
 		file_multisig_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnSpentAssets); i {
+			switch v := v.(*ReplyUnSpentAssets); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3607,7 +3963,7 @@ func file_multisig_proto_init() {
 			}
 		}
 		file_multisig_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReceiptMultiSig); i {
+			switch v := v.(*UnSpentAssets); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3619,7 +3975,7 @@ func file_multisig_proto_init() {
 			}
 		}
 		file_multisig_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReceiptOwnerAddOrDel); i {
+			switch v := v.(*ReceiptMultiSig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3631,7 +3987,7 @@ func file_multisig_proto_init() {
 			}
 		}
 		file_multisig_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReceiptOwnerModOrRep); i {
+			switch v := v.(*ReceiptOwnerAddOrDel); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3643,7 +3999,7 @@ func file_multisig_proto_init() {
 			}
 		}
 		file_multisig_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReceiptWeightModify); i {
+			switch v := v.(*ReceiptOwnerModOrRep); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3655,7 +4011,7 @@ func file_multisig_proto_init() {
 			}
 		}
 		file_multisig_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReceiptDailyLimitOperate); i {
+			switch v := v.(*ReceiptWeightModify); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3667,7 +4023,7 @@ func file_multisig_proto_init() {
 			}
 		}
 		file_multisig_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReceiptConfirmTx); i {
+			switch v := v.(*ReceiptDailyLimitOperate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3679,7 +4035,7 @@ func file_multisig_proto_init() {
 			}
 		}
 		file_multisig_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReceiptAccDailyLimitUpdate); i {
+			switch v := v.(*ReceiptConfirmTx); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3691,7 +4047,7 @@ func file_multisig_proto_init() {
 			}
 		}
 		file_multisig_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReceiptMultiSigTx); i {
+			switch v := v.(*ReceiptAccDailyLimitUpdate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3703,7 +4059,7 @@ func file_multisig_proto_init() {
 			}
 		}
 		file_multisig_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReceiptTxCountUpdate); i {
+			switch v := v.(*ReceiptMultiSigTx); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3715,7 +4071,7 @@ func file_multisig_proto_init() {
 			}
 		}
 		file_multisig_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MultiSigTxOwner); i {
+			switch v := v.(*ReceiptExecTx); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3727,7 +4083,7 @@ func file_multisig_proto_init() {
 			}
 		}
 		file_multisig_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Uint64); i {
+			switch v := v.(*ReceiptTxCountUpdate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3739,7 +4095,7 @@ func file_multisig_proto_init() {
 			}
 		}
 		file_multisig_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccountAssets); i {
+			switch v := v.(*MultiSigTxOwner); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3751,7 +4107,7 @@ func file_multisig_proto_init() {
 			}
 		}
 		file_multisig_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqAccAssets); i {
+			switch v := v.(*Uint64); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3763,7 +4119,7 @@ func file_multisig_proto_init() {
 			}
 		}
 		file_multisig_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReplyAccAssets); i {
+			switch v := v.(*AccountAssets); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3775,7 +4131,7 @@ func file_multisig_proto_init() {
 			}
 		}
 		file_multisig_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccAssets); i {
+			switch v := v.(*ReqAccAssets); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3787,7 +4143,7 @@ func file_multisig_proto_init() {
 			}
 		}
 		file_multisig_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Assets); i {
+			switch v := v.(*ReplyAccAssets); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3799,7 +4155,7 @@ func file_multisig_proto_init() {
 			}
 		}
 		file_multisig_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccAddress); i {
+			switch v := v.(*AccAssets); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3811,7 +4167,7 @@ func file_multisig_proto_init() {
 			}
 		}
 		file_multisig_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OwnerAttr); i {
+			switch v := v.(*Assets); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3823,6 +4179,30 @@ func file_multisig_proto_init() {
 			}
 		}
 		file_multisig_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccAddress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_multisig_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OwnerAttr); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_multisig_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OwnerAttrs); i {
 			case 0:
 				return &v.state
@@ -3842,6 +4222,7 @@ func file_multisig_proto_init() {
 		(*MultiSigAction_MultiSigConfirmTx)(nil),
 		(*MultiSigAction_MultiSigExecTransferTo)(nil),
 		(*MultiSigAction_MultiSigExecTransferFrom)(nil),
+		(*MultiSigAction_MultiSigExecTx)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -3849,7 +4230,7 @@ func file_multisig_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_multisig_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   43,
+			NumMessages:   45,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
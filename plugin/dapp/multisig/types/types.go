@@ -19,6 +19,8 @@ func init() {
 //InitFork ...
 func InitFork(cfg *types.Chain33Config) {
 	cfg.RegisterDappFork(MultiSigX, "Enable", 0)
+	cfg.RegisterDappFork(MultiSigX, ForkMultiSigExecTxX, 0)
+	cfg.RegisterDappFork(MultiSigX, ForkMultiSigTimeLockX, 0)
 }
 
 //InitExecutor ...
@@ -58,6 +60,7 @@ func (m *MultiSigType) GetTypeMap() map[string]int32 {
 		"MultiSigConfirmTx":        ActionMultiSigConfirmTx,
 		"MultiSigExecTransferTo":   ActionMultiSigExecTransferTo,
 		"MultiSigExecTransferFrom": ActionMultiSigExecTransferFrom,
+		"MultiSigExecTx":           ActionMultiSigExecTx,
 	}
 }
 
@@ -81,6 +84,7 @@ func (m *MultiSigType) GetLogMap() map[int64]*types.LogInfo {
 		TyLogDailyLimitUpdate: {Ty: reflect.TypeOf(ReceiptAccDailyLimitUpdate{}), Name: "LogAccDailyLimitUpdate"},
 		TyLogMultiSigTx:       {Ty: reflect.TypeOf(ReceiptMultiSigTx{}), Name: "LogMultiSigAccTx"},
 		TyLogTxCountUpdate:    {Ty: reflect.TypeOf(ReceiptTxCountUpdate{}), Name: "LogTxCountUpdate"},
+		TyLogMultiSigExecTx:   {Ty: reflect.TypeOf(ReceiptExecTx{}), Name: "LogMultiSigExecTx"},
 	}
 }
 
@@ -113,6 +117,52 @@ func (m MultiSigType) ActionName(tx *types.Transaction) string {
 		return "MultiSigExecTransfer"
 	} else if g.Ty == ActionMultiSigExecTransferFrom && g.GetMultiSigExecTransferFrom() != nil {
 		return "MultiSigAccExecTransfer"
+	} else if g.Ty == ActionMultiSigExecTx && g.GetMultiSigExecTx() != nil {
+		return "MultiSigExecTx"
 	}
 	return "unknown"
 }
+
+//execTxAllowActions 允许通过MultiSigExecTx执行的合约及其action，这些合约在IsFriend中调用IsExecTxFriend允许multisig写入自己的数据
+//框架只允许multisig交易的ExecLocal写入LODB-multisig-前缀的本地数据，被执行交易自身的ExecLocal无法执行，
+//所以只允许ExecLocal不写本地数据的action：evm合约的storage和token的本地索引都依赖ExecLocal，不允许执行；
+//coins、manage等系统合约没有提供IsFriend扩展，multisig交易无法修改它们的数据，也不允许执行
+var execTxAllowActions = map[string][]string{
+	"paracross": {"Transfer", "Withdraw", "TransferToExec"},
+}
+
+//IsExecTxAllowed 交易是否允许通过MultiSigExecTx执行，user.p.xxx.paracross等平行链的执行器按真实的执行器判断
+func IsExecTxAllowed(execer string, payload []byte) bool {
+	realExec := string(types.GetRealExecName([]byte(execer)))
+	actions, ok := execTxAllowActions[realExec]
+	if !ok {
+		return false
+	}
+	ety := types.LoadExecutorType(realExec)
+	if ety == nil {
+		return false
+	}
+	name, _, err := ety.DecodePayloadValue(&types.Transaction{Execer: []byte(execer), Payload: payload})
+	if err != nil {
+		return false
+	}
+	for _, action := range actions {
+		if name == action {
+			return true
+		}
+	}
+	return false
+}
+
+//IsExecTxFriend 交易是否是multisig合约中会执行其他合约交易的action(提交或者确认)
+//被执行的合约可以在IsFriend中调用此函数允许multisig合约写入自己的数据
+func IsExecTxFriend(tx *types.Transaction) bool {
+	if string(types.GetRealExecName(tx.Execer)) != MultiSigX {
+		return false
+	}
+	var action MultiSigAction
+	if err := types.Decode(tx.Payload, &action); err != nil {
+		return false
+	}
+	return action.Ty == ActionMultiSigExecTx || action.Ty == ActionMultiSigConfirmTx
+}
@@ -33,6 +33,9 @@ func OracleCmd() *cobra.Command {
 		OraclePrePublishResultRawTxCmd(),
 		OracleAbortPrePubResultRawTxCmd(),
 		OraclePublishResultRawTxCmd(),
+		OracleReportResultRawTxCmd(),
+		OracleDisputeResultRawTxCmd(),
+		OracleFinalizeResultRawTxCmd(),
 		OracleQueryRawTxCmd(),
 	)
 
@@ -85,6 +88,11 @@ func addPublishEventFlags(cmd *cobra.Command) {
 		fmt.Printf("MarkFlagRequired introduction Error: %v", err)
 		return
 	}
+
+	cmd.Flags().StringP("reporters", "r", "", "reporter addresses, use comma between many addrs, empty for single publisher")
+	cmd.Flags().Int32P("quorum", "q", 0, "min reports needed to aggregate result, only for reporters")
+	cmd.Flags().Int32P("result_type", "y", 0, "result type, 0:categorical(majority), 1:numeric(median), only for reporters")
+	cmd.Flags().Int64P("dispute_window", "w", 0, "dispute window in seconds after result aggregated, only for reporters")
 }
 
 func publishEvent(cmd *cobra.Command, args []string) {
@@ -119,6 +127,10 @@ func publishEvent(cmd *cobra.Command, args []string) {
 		fmt.Printf("publishEvent get content Error: %v", err)
 		return
 	}
+	reporters, _ := cmd.Flags().GetString("reporters")
+	quorum, _ := cmd.Flags().GetInt32("quorum")
+	resultType, _ := cmd.Flags().GetInt32("result_type")
+	disputeWindow, _ := cmd.Flags().GetInt64("dispute_window")
 
 	layout := "2006-01-02 15:04:05"
 	t, err := time.Parse(layout, timeString)
@@ -127,10 +139,17 @@ func publishEvent(cmd *cobra.Command, args []string) {
 		return
 	}
 
+	payload := fmt.Sprintf("{\"type\":\"%s\",\"subType\":\"%s\",\"time\":%d, \"content\":\"%s\", \"introduction\":\"%s\"", ty, subType, t.Unix(), content, introduction)
+	if reporters != "" {
+		addrs := strings.Split(reporters, ",")
+		payload += fmt.Sprintf(", \"reporters\":[\"%s\"], \"quorum\":%d, \"resultType\":%d, \"disputeWindow\":%d", strings.Join(addrs, "\",\""), quorum, resultType, disputeWindow)
+	}
+	payload += "}"
+
 	params := &rpctypes.CreateTxIn{
 		Execer:     types.GetExecName(oraclety.OracleX, paraName),
 		ActionName: oraclety.CreateEventPublishTx,
-		Payload:    []byte(fmt.Sprintf("{\"type\":\"%s\",\"subType\":\"%s\",\"time\":%d, \"content\":\"%s\", \"introduction\":\"%s\"}", ty, subType, t.Unix(), content, introduction)),
+		Payload:    []byte(payload),
 	}
 	var res string
 	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.CreateTransaction", params, &res)
@@ -357,6 +376,162 @@ func publishResult(cmd *cobra.Command, args []string) {
 	ctx.RunWithoutMarshal()
 }
 
+// OracleReportResultRawTxCmd 上报者提交事件结果
+func OracleReportResultRawTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "report_result",
+		Short: "report result of a multi-reporter event",
+		Run:   reportResult,
+	}
+	addReportResultFlags(cmd)
+	return cmd
+}
+
+func addReportResultFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("eventID", "e", "", "eventID")
+	err := cmd.MarkFlagRequired("eventID")
+	if err != nil {
+		fmt.Printf("addReportResultFlags eventID Error: %v", err)
+		return
+	}
+
+	cmd.Flags().StringP("source", "s", "", "source where result from")
+	err = cmd.MarkFlagRequired("source")
+	if err != nil {
+		fmt.Printf("addReportResultFlags source Error: %v", err)
+		return
+	}
+
+	cmd.Flags().StringP("result", "r", "", "result string, must be a number for numeric event")
+	err = cmd.MarkFlagRequired("result")
+	if err != nil {
+		fmt.Printf("addReportResultFlags result Error: %v", err)
+		return
+	}
+}
+
+func reportResult(cmd *cobra.Command, args []string) {
+	paraName, _ := cmd.Flags().GetString("paraName")
+	rpcLaddr, err := cmd.Flags().GetString("rpc_laddr")
+	if err != nil {
+		fmt.Printf("reportResult rpc_laddr Error: %v", err)
+		return
+	}
+	eventID, err := cmd.Flags().GetString("eventID")
+	if err != nil {
+		fmt.Printf("reportResult eventID Error: %v", err)
+		return
+	}
+	source, err := cmd.Flags().GetString("source")
+	if err != nil {
+		fmt.Printf("reportResult source Error: %v", err)
+		return
+	}
+	result, err := cmd.Flags().GetString("result")
+	if err != nil {
+		fmt.Printf("reportResult result Error: %v", err)
+		return
+	}
+
+	params := &rpctypes.CreateTxIn{
+		Execer:     types.GetExecName(oraclety.OracleX, paraName),
+		ActionName: oraclety.CreateResultReportTx,
+		Payload:    []byte(fmt.Sprintf("{\"eventID\":\"%s\", \"source\":\"%s\", \"result\":\"%s\"}", eventID, source, result)),
+	}
+	var res string
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.CreateTransaction", params, &res)
+	ctx.RunWithoutMarshal()
+}
+
+// OracleDisputeResultRawTxCmd 争议期内对聚合结果发起争议
+func OracleDisputeResultRawTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dispute_result",
+		Short: "dispute aggregated result in dispute window",
+		Run:   disputeResult,
+	}
+	addDisputeResultFlags(cmd)
+	return cmd
+}
+
+func addDisputeResultFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("eventID", "e", "", "eventID")
+	err := cmd.MarkFlagRequired("eventID")
+	if err != nil {
+		fmt.Printf("addDisputeResultFlags eventID Error: %v", err)
+		return
+	}
+
+	cmd.Flags().StringP("reason", "r", "", "dispute reason")
+}
+
+func disputeResult(cmd *cobra.Command, args []string) {
+	paraName, _ := cmd.Flags().GetString("paraName")
+	rpcLaddr, err := cmd.Flags().GetString("rpc_laddr")
+	if err != nil {
+		fmt.Printf("disputeResult rpc_laddr Error: %v", err)
+		return
+	}
+	eventID, err := cmd.Flags().GetString("eventID")
+	if err != nil {
+		fmt.Printf("disputeResult eventID Error: %v", err)
+		return
+	}
+	reason, _ := cmd.Flags().GetString("reason")
+
+	params := &rpctypes.CreateTxIn{
+		Execer:     types.GetExecName(oraclety.OracleX, paraName),
+		ActionName: oraclety.CreateResultDisputeTx,
+		Payload:    []byte(fmt.Sprintf("{\"eventID\":\"%s\", \"reason\":\"%s\"}", eventID, reason)),
+	}
+	var res string
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.CreateTransaction", params, &res)
+	ctx.RunWithoutMarshal()
+}
+
+// OracleFinalizeResultRawTxCmd 争议期结束后确认聚合结果
+func OracleFinalizeResultRawTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finalize_result",
+		Short: "finalize aggregated result after dispute window",
+		Run:   finalizeResult,
+	}
+	addFinalizeResultFlags(cmd)
+	return cmd
+}
+
+func addFinalizeResultFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("eventID", "e", "", "eventID")
+	err := cmd.MarkFlagRequired("eventID")
+	if err != nil {
+		fmt.Printf("addFinalizeResultFlags eventID Error: %v", err)
+		return
+	}
+}
+
+func finalizeResult(cmd *cobra.Command, args []string) {
+	paraName, _ := cmd.Flags().GetString("paraName")
+	rpcLaddr, err := cmd.Flags().GetString("rpc_laddr")
+	if err != nil {
+		fmt.Printf("finalizeResult rpc_laddr Error: %v", err)
+		return
+	}
+	eventID, err := cmd.Flags().GetString("eventID")
+	if err != nil {
+		fmt.Printf("finalizeResult eventID Error: %v", err)
+		return
+	}
+
+	params := &rpctypes.CreateTxIn{
+		Execer:     types.GetExecName(oraclety.OracleX, paraName),
+		ActionName: oraclety.CreateResultFinalizeTx,
+		Payload:    []byte(fmt.Sprintf("{\"eventID\":\"%s\"}", eventID)),
+	}
+	var res string
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.CreateTransaction", params, &res)
+	ctx.RunWithoutMarshal()
+}
+
 // OracleQueryRawTxCmd 查询事件
 func OracleQueryRawTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -383,7 +558,7 @@ func addOracleQueryFlags(cmd *cobra.Command) {
 		return
 	}
 
-	cmd.Flags().StringP("status", "s", "", "status, number 1-5")
+	cmd.Flags().StringP("status", "s", "", "status, number 1-7")
 	err = cmd.MarkFlagRequired("status")
 	if err != nil {
 		fmt.Printf("MarkFlagRequired status Error: %v", err)
@@ -462,8 +637,8 @@ func oracleQuery(cmd *cobra.Command, args []string) {
 		ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
 		ctx.Run()
 	} else if statusStr != "" {
-		if status < 0 || status > 5 {
-			fmt.Println("Error: status must be 1-5")
+		if status < 0 || status > 7 {
+			fmt.Println("Error: status must be 1-6")
 			cmd.Help()
 			return
 		} else if addr != "" {
@@ -12,6 +12,13 @@ import (
 )
 
 func (o *oracle) Exec_EventPublish(payload *oty.EventPublish, tx *types.Transaction, index int) (*types.Receipt, error) {
+	//分叉之前不识别多上报者参数, 按单一发布者事件处理
+	if !o.isReporterForkActive() {
+		payload.Reporters = nil
+		payload.Quorum = 0
+		payload.ResultType = 0
+		payload.DisputeWindow = 0
+	}
 	action := newOracleAction(o, tx, index)
 	return action.eventPublish(payload)
 }
@@ -35,3 +42,31 @@ func (o *oracle) Exec_ResultPublish(payload *oty.ResultPublish, tx *types.Transa
 	action := newOracleAction(o, tx, index)
 	return action.resultPublish(payload)
 }
+
+func (o *oracle) Exec_ResultReport(payload *oty.ResultReport, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if !o.isReporterForkActive() {
+		return nil, types.ErrActionNotSupport
+	}
+	action := newOracleAction(o, tx, index)
+	return action.resultReport(payload)
+}
+
+func (o *oracle) Exec_ResultDispute(payload *oty.ResultDispute, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if !o.isReporterForkActive() {
+		return nil, types.ErrActionNotSupport
+	}
+	action := newOracleAction(o, tx, index)
+	return action.resultDispute(payload)
+}
+
+func (o *oracle) Exec_ResultFinalize(payload *oty.ResultFinalize, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if !o.isReporterForkActive() {
+		return nil, types.ErrActionNotSupport
+	}
+	action := newOracleAction(o, tx, index)
+	return action.resultFinalize(payload)
+}
+
+func (o *oracle) isReporterForkActive() bool {
+	return o.GetAPI().GetConfig().IsDappFork(o.GetHeight(), oty.OracleX, oty.ForkOracleReporter)
+}
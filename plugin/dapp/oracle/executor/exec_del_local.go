@@ -64,3 +64,15 @@ func (o *oracle) ExecDelLocal_ResultAbort(payload *oty.ResultAbort, tx *types.Tr
 func (o *oracle) ExecDelLocal_ResultPublish(payload *oty.ResultPublish, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
 	return o.execDelLocal(receiptData)
 }
+
+func (o *oracle) ExecDelLocal_ResultReport(payload *oty.ResultReport, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return o.execDelLocal(receiptData)
+}
+
+func (o *oracle) ExecDelLocal_ResultDispute(payload *oty.ResultDispute, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return o.execDelLocal(receiptData)
+}
+
+func (o *oracle) ExecDelLocal_ResultFinalize(payload *oty.ResultFinalize, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return o.execDelLocal(receiptData)
+}
@@ -18,7 +18,7 @@ func (o *oracle) execLocal(receipt *types.ReceiptData) (*types.LocalDBSet, error
 	}
 	table := oty.NewTable(o.GetLocalDB())
 	for _, item := range receipt.Logs {
-		if item.Ty >= oty.TyLogEventPublish && item.Ty <= oty.TyLogResultPublish {
+		if item.Ty >= oty.TyLogEventPublish && item.Ty <= oty.TyLogResultFinalize {
 			var oraclelog oty.ReceiptOracle
 			err := types.Decode(item.Log, &oraclelog)
 			if err != nil {
@@ -57,3 +57,15 @@ func (o *oracle) ExecLocal_ResultAbort(payload *oty.ResultAbort, tx *types.Trans
 func (o *oracle) ExecLocal_ResultPublish(payload *oty.ResultPublish, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
 	return o.execLocal(receiptData)
 }
+
+func (o *oracle) ExecLocal_ResultReport(payload *oty.ResultReport, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return o.execLocal(receiptData)
+}
+
+func (o *oracle) ExecLocal_ResultDispute(payload *oty.ResultDispute, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return o.execLocal(receiptData)
+}
+
+func (o *oracle) ExecLocal_ResultFinalize(payload *oty.ResultFinalize, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return o.execLocal(receiptData)
+}
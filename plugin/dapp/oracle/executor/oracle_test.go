@@ -476,6 +476,235 @@ func TestOrace(t *testing.T) {
 
 }
 
+type reporterTestEnv struct {
+	t         *testing.T
+	cfg       *types.Chain33Config
+	exec      *oracle
+	stateDB   dbm.DB
+	kvdb      dbm.KVDB
+	blockTime int64
+	privA     crypto.PrivKey
+	reporters []string
+	privs     []crypto.PrivKey
+}
+
+func newReporterTestEnv(t *testing.T, cfg *types.Chain33Config) *reporterTestEnv {
+	InitExecType()
+	stateDB, _ := dbm.NewGoMemDB("1", "2", 1000)
+	_, _, kvdb := util.CreateTestDB()
+
+	item := &types.ConfigItem{
+		Key: "mavl-manage-oracle-publish-event",
+		Value: &types.ConfigItem_Arr{
+			Arr: &types.ArrayConfig{Value: []string{"1KSBd17H7ZK8iT37aJztFB22XGwsPTdwE4"}},
+		},
+	}
+	stateDB.Set([]byte(item.Key), types.Encode(item))
+
+	exec := newOracle().(*oracle)
+	q := queue.New("channel")
+	q.SetConfig(cfg)
+	api, _ := client.New(q.Client(), nil)
+	exec.SetAPI(api)
+	exec.SetStateDB(stateDB)
+	exec.SetLocalDB(kvdb)
+	blockTime := int64(1539918074)
+	exec.SetEnv(1, blockTime, 1)
+
+	env := &reporterTestEnv{t: t, cfg: cfg, exec: exec, stateDB: stateDB, kvdb: kvdb, blockTime: blockTime, privA: util.HexToPrivkey(PrivKeyA)}
+	for i := 0; i < 3; i++ {
+		addr, priv := util.Genaddress()
+		env.reporters = append(env.reporters, addr)
+		env.privs = append(env.privs, priv)
+	}
+	return env
+}
+
+// run 执行交易并保存状态, 返回回执中的事件ID
+func (env *reporterTestEnv) run(priv crypto.PrivKey, action string, param types.Message) (string, error) {
+	ety := types.LoadExecutorType(oty.OracleX)
+	tx, err := ety.Create(action, param)
+	assert.Nil(env.t, err)
+	tx, err = types.FormatTx(env.cfg, oty.OracleX, tx)
+	assert.Nil(env.t, err)
+	tx.Sign(types.SECP256K1, priv)
+	receipt, err := env.exec.Exec(tx, 1)
+	if err != nil {
+		return "", err
+	}
+	for _, kv := range receipt.KV {
+		env.stateDB.Set(kv.Key, kv.Value)
+	}
+	set, err := env.exec.ExecLocal(tx, &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}, 1)
+	assert.Nil(env.t, err)
+	for _, kv := range set.KV {
+		env.kvdb.Set(kv.Key, kv.Value)
+	}
+	var log oty.ReceiptOracle
+	assert.Nil(env.t, types.Decode(receipt.Logs[0].Log, &log))
+	return log.EventID, nil
+}
+
+func (env *reporterTestEnv) report(i int, eventID, result string) error {
+	_, err := env.run(env.privs[i], "ResultReport", &oty.ResultReport{EventID: eventID, Source: "exchange", Result: result})
+	return err
+}
+
+func (env *reporterTestEnv) status(eventID string) *oty.OracleStatus {
+	msg, err := env.exec.Query(oty.FuncNameQueryOracleListByIDs, types.Encode(&oty.QueryOracleInfos{EventID: []string{eventID}}))
+	assert.Nil(env.t, err)
+	return msg.(*oty.ReplyOracleStatusList).Status[0]
+}
+
+func TestOracleMultiReporter(t *testing.T) {
+	cfg := types.NewChain33Config(strings.Replace(types.GetDefaultCfgstring(), "Title=\"local\"", "Title=\"chain33\"", 1))
+	env := newReporterTestEnv(t, cfg)
+	blockTime, privA, privs := env.blockTime, env.privA, env.privs
+
+	event := &oty.EventPublish{Type: "price", SubType: "BTY/USDT", Time: blockTime + 3600, Content: "price", Introduction: "BTY price",
+		Reporters: env.reporters, Quorum: 4, ResultType: oty.ResultTypeNumeric, DisputeWindow: 100}
+	_, err := env.run(privA, "EventPublish", event)
+	assert.Equal(t, oty.ErrQuorumInvalid, err)
+	event.Quorum = 3
+	event.DisputeWindow = 0
+	_, err = env.run(privA, "EventPublish", event)
+	assert.Equal(t, oty.ErrDisputeWindowInvalid, err)
+	event.DisputeWindow = 100
+	eventID, err := env.run(privA, "EventPublish", event)
+	assert.Nil(t, err)
+
+	report := func(i int, result string) error {
+		return env.report(i, eventID, result)
+	}
+	_, err = env.run(privA, "ResultReport", &oty.ResultReport{EventID: eventID, Result: "100"})
+	assert.Equal(t, oty.ErrNotReporter, err)
+	assert.Equal(t, oty.ErrResultNotNumeric, report(0, "abc"))
+	assert.Nil(t, report(0, "100"))
+	assert.Equal(t, oty.ErrReporterRepeat, report(0, "101"))
+	assert.Equal(t, int32(oty.ResultReporting), env.status(eventID).Status.Status)
+	//单一发布者流程对多上报者事件不可用
+	_, err = env.run(privA, "ResultPublish", &oty.ResultPublish{EventID: eventID, Result: "1"})
+	assert.Equal(t, oty.ErrResultPublishNotAllowed, err)
+	assert.Nil(t, report(1, "300"))
+	assert.Nil(t, report(2, "110"))
+	st := env.status(eventID)
+	assert.Equal(t, int32(oty.ResultPrePublished), st.Status.Status)
+	assert.Equal(t, "110", st.Result)
+	assert.Equal(t, blockTime+100, st.DisputeEnd)
+
+	//争议期内不能确认, 争议后重新上报
+	_, err = env.run(privA, "ResultFinalize", &oty.ResultFinalize{EventID: eventID})
+	assert.Equal(t, oty.ErrDisputeWindowNotEnd, err)
+	_, err = env.run(privA, "ResultDispute", &oty.ResultDispute{EventID: eventID})
+	assert.Equal(t, oty.ErrNotReporter, err)
+	_, err = env.run(privs[1], "ResultDispute", &oty.ResultDispute{EventID: eventID, Reason: "wrong price"})
+	assert.Nil(t, err)
+	st = env.status(eventID)
+	assert.Equal(t, int32(oty.ResultReporting), st.Status.Status)
+	assert.Equal(t, "", st.Result)
+	assert.Equal(t, 0, len(st.Reports))
+
+	assert.Nil(t, report(0, "105"))
+	assert.Nil(t, report(1, "120"))
+	assert.Nil(t, report(2, "400"))
+	assert.Equal(t, "120", env.status(eventID).Result)
+	_, err = env.run(privs[1], "ResultDispute", &oty.ResultDispute{EventID: eventID})
+	assert.Equal(t, oty.ErrDisputeRepeat, err)
+
+	env.exec.SetEnv(2, blockTime+100, 1)
+	_, err = env.run(privs[0], "ResultDispute", &oty.ResultDispute{EventID: eventID})
+	assert.Equal(t, oty.ErrResultDisputeNotAllowed, err)
+	_, err = env.run(privA, "ResultFinalize", &oty.ResultFinalize{EventID: eventID})
+	assert.Nil(t, err)
+	st = env.status(eventID)
+	assert.Equal(t, int32(oty.ResultPublished), st.Status.Status)
+	assert.Equal(t, "120", st.Result)
+
+	msg, err := env.exec.Query(oty.FuncNameQueryEventIDByStatus, types.Encode(&oty.QueryEventID{Status: oty.ResultPublished}))
+	assert.Nil(t, err)
+	assert.Equal(t, eventID, msg.(*oty.ReplyEventIDs).EventID[0])
+}
+
+func TestOracleNoQuorum(t *testing.T) {
+	cfg := types.NewChain33Config(strings.Replace(types.GetDefaultCfgstring(), "Title=\"local\"", "Title=\"chain33\"", 1))
+	env := newReporterTestEnv(t, cfg)
+
+	event := &oty.EventPublish{Type: "football", SubType: "Premier League", Time: env.blockTime + 3600, Content: "match", Introduction: "match result",
+		Reporters: env.reporters, Quorum: 2, ResultType: oty.ResultTypeCategorical, DisputeWindow: 100}
+	eventID, err := env.run(env.privA, "EventPublish", event)
+	assert.Nil(t, err)
+
+	//达到法定数量但没有多数结果时继续等待其他上报者
+	assert.Nil(t, env.report(0, eventID, "2:1"))
+	assert.Nil(t, env.report(1, eventID, "1:1"))
+	assert.Equal(t, int32(oty.ResultReporting), env.status(eventID).Status.Status)
+
+	//全部上报后仍没有多数结果, 事件失败
+	assert.Nil(t, env.report(2, eventID, "0:0"))
+	st := env.status(eventID)
+	assert.Equal(t, int32(oty.ResultFailed), st.Status.Status)
+	assert.Equal(t, "", st.Result)
+	msg, err := env.exec.Query(oty.FuncNameQueryEventIDByStatus, types.Encode(&oty.QueryEventID{Status: oty.ResultFailed}))
+	assert.Nil(t, err)
+	assert.Equal(t, eventID, msg.(*oty.ReplyEventIDs).EventID[0])
+
+	//失败的事件不能继续上报或确认, 只能由发布者撤销
+	_, err = env.run(env.privA, "ResultFinalize", &oty.ResultFinalize{EventID: eventID})
+	assert.Equal(t, oty.ErrResultFinalizeNotAllowed, err)
+	_, err = env.run(env.privs[0], "ResultDispute", &oty.ResultDispute{EventID: eventID})
+	assert.Equal(t, oty.ErrResultDisputeNotAllowed, err)
+	_, err = env.run(env.privA, "EventAbort", &oty.EventAbort{EventID: eventID})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(oty.EventAborted), env.status(eventID).Status.Status)
+}
+
+func TestOracleReporterFork(t *testing.T) {
+	cfg := types.NewChain33Config(strings.Replace(types.GetDefaultCfgstring(), "Title=\"local\"", "Title=\"chain33\"", 1))
+	cfg.SetDappFork(oty.OracleX, oty.ForkOracleReporter, 10)
+	env := newReporterTestEnv(t, cfg)
+
+	//分叉之前忽略多上报者参数, 按单一发布者事件处理
+	event := &oty.EventPublish{Type: "price", SubType: "BTY/USDT", Time: env.blockTime + 3600, Content: "price", Introduction: "BTY price",
+		Reporters: env.reporters, Quorum: 2, ResultType: oty.ResultTypeNumeric, DisputeWindow: 100}
+	eventID, err := env.run(env.privA, "EventPublish", event)
+	assert.Nil(t, err)
+	st := env.status(eventID)
+	assert.Equal(t, 0, len(st.Reporters))
+	assert.Equal(t, int32(0), st.Quorum)
+	_, err = env.run(env.privA, "ResultPrePublish", &oty.ResultPrePublish{EventID: eventID, Source: "exchange", Result: "100"})
+	assert.Nil(t, err)
+
+	assert.Equal(t, types.ErrActionNotSupport, env.report(0, eventID, "100"))
+	_, err = env.run(env.privs[0], "ResultDispute", &oty.ResultDispute{EventID: eventID})
+	assert.Equal(t, types.ErrActionNotSupport, err)
+	_, err = env.run(env.privA, "ResultFinalize", &oty.ResultFinalize{EventID: eventID})
+	assert.Equal(t, types.ErrActionNotSupport, err)
+
+	env.exec.SetEnv(10, env.blockTime, 1)
+	eventID, err = env.run(env.privA, "EventPublish", event)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(env.status(eventID).Reporters))
+	assert.Nil(t, env.report(0, eventID, "100"))
+}
+
+func TestAggregateResult(t *testing.T) {
+	reports := func(results ...string) []*oty.ReporterResult {
+		var list []*oty.ReporterResult
+		for _, r := range results {
+			list = append(list, &oty.ReporterResult{Result: r})
+		}
+		return list
+	}
+	result, ok := aggregateResult(oty.ResultTypeNumeric, reports("3", "1.5", "2", "10"))
+	assert.True(t, ok)
+	assert.Equal(t, "2", result)
+	result, ok = aggregateResult(oty.ResultTypeCategorical, reports("2:1", "1:1", "2:1"))
+	assert.True(t, ok)
+	assert.Equal(t, "2:1", result)
+	_, ok = aggregateResult(oty.ResultTypeCategorical, reports("2:1", "1:1", "0:0", "2:1"))
+	assert.False(t, ok)
+}
+
 func signTx(tx *types.Transaction, hexPrivKey string) (*types.Transaction, error) {
 	signType := types.SECP256K1
 	c, err := crypto.Load(types.GetSignName(oty.OracleX, signType), -1)
@@ -8,10 +8,13 @@ package executor
 
 import (
 	"fmt"
+	"math/big"
+	"sort"
 
 	"github.com/33cn/chain33/common/db/table"
 
 	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
 	dbm "github.com/33cn/chain33/common/db"
 	"github.com/33cn/chain33/types"
 	oty "github.com/33cn/plugin/plugin/dapp/oracle/types"
@@ -44,6 +47,30 @@ func NewOracleDB(eventID, addr, ty, subTy, content, introduction string, time in
 	return oracle
 }
 
+// newOracleDBByStatus 按字段构造OracleDB, 避免复制protobuf消息的内部状态
+func newOracleDBByStatus(status *oty.OracleStatus) *OracleDB {
+	oracle := &OracleDB{}
+	oracle.EventID = status.EventID
+	oracle.Addr = status.Addr
+	oracle.Type = status.Type
+	oracle.SubType = status.SubType
+	oracle.Time = status.Time
+	oracle.Content = status.Content
+	oracle.Introduction = status.Introduction
+	oracle.Status = status.Status
+	oracle.Source = status.Source
+	oracle.Result = status.Result
+	oracle.PreStatus = status.PreStatus
+	oracle.Reporters = status.Reporters
+	oracle.Quorum = status.Quorum
+	oracle.ResultType = status.ResultType
+	oracle.DisputeWindow = status.DisputeWindow
+	oracle.Reports = status.Reports
+	oracle.Disputers = status.Disputers
+	oracle.DisputeEnd = status.DisputeEnd
+	return oracle
+}
+
 // GetKVSet for OracleDB
 func (o *OracleDB) GetKVSet() (kvset []*types.KeyValue) {
 	value := types.Encode(&o.OracleStatus)
@@ -102,6 +129,10 @@ func (action *oracleAction) eventPublish(event *oty.EventPublish) (*types.Receip
 	if !isEventPublisher(action.fromaddr, action.db, false) {
 		return nil, oty.ErrNoPrivilege
 	}
+	// 多上报者事件参数检查
+	if err := action.checkReporterParam(event); err != nil {
+		return nil, err
+	}
 
 	_, err := findOracleStatus(action.db, eventID)
 	if err != types.ErrNotFound {
@@ -110,6 +141,10 @@ func (action *oracleAction) eventPublish(event *oty.EventPublish) (*types.Receip
 	}
 
 	eventStatus := NewOracleDB(eventID, action.fromaddr, event.Type, event.SubType, event.Content, event.Introduction, event.Time, action.GetIndex())
+	eventStatus.Reporters = event.Reporters
+	eventStatus.Quorum = event.Quorum
+	eventStatus.ResultType = event.ResultType
+	eventStatus.DisputeWindow = event.DisputeWindow
 	olog.Debug("eventPublish", "PublisherAddr", eventStatus.Addr, "EventID", eventStatus.EventID, "Event", eventStatus.Content)
 
 	if err := eventStatus.save(action.db); err != nil {
@@ -142,7 +177,7 @@ func (action *oracleAction) eventAbort(event *oty.EventAbort) (*types.Receipt, e
 
 	ora := &OracleDB{*oracleStatus}
 
-	if ora.Status.Status != oty.EventPublished && ora.Status.Status != oty.ResultAborted {
+	if ora.Status.Status != oty.EventPublished && ora.Status.Status != oty.ResultAborted && ora.Status.Status != oty.ResultReporting && ora.Status.Status != oty.ResultFailed {
 		olog.Error("EventAbort", "EventAbort can not abort for status", ora.Status.Status)
 		return nil, oty.ErrEventAbortNotAllowed
 	}
@@ -179,7 +214,7 @@ func (action *oracleAction) resultPrePublish(event *oty.ResultPrePublish) (*type
 
 	ora := &OracleDB{*oracleStatus}
 
-	if ora.Status.Status != oty.EventPublished && ora.Status.Status != oty.ResultAborted {
+	if isMultiReporter(ora) || (ora.Status.Status != oty.EventPublished && ora.Status.Status != oty.ResultAborted) {
 		olog.Error("ResultPrePublish", "ResultPrePublish can not pre-publish", ora.Status.Status)
 		return nil, oty.ErrResultPrePublishNotAllowed
 	}
@@ -218,7 +253,7 @@ func (action *oracleAction) resultAbort(event *oty.ResultAbort) (*types.Receipt,
 
 	ora := &OracleDB{*oracleStatus}
 
-	if ora.Status.Status != oty.ResultPrePublished {
+	if isMultiReporter(ora) || ora.Status.Status != oty.ResultPrePublished {
 		olog.Error("ResultAbort", "ResultAbort can not abort", ora.Status.Status)
 		return nil, oty.ErrPrePublishAbortNotAllowed
 	}
@@ -257,7 +292,7 @@ func (action *oracleAction) resultPublish(event *oty.ResultPublish) (*types.Rece
 
 	ora := &OracleDB{*oracleStatus}
 
-	if ora.Status.Status != oty.ResultPrePublished {
+	if isMultiReporter(ora) || ora.Status.Status != oty.ResultPrePublished {
 		olog.Error("ResultPublish", "ResultPublish can not abort", ora.Status.Status)
 		return nil, oty.ErrResultPublishNotAllowed
 	}
@@ -278,6 +313,179 @@ func (action *oracleAction) resultPublish(event *oty.ResultPublish) (*types.Rece
 	return receipt, nil
 }
 
+func (action *oracleAction) resultReport(event *oty.ResultReport) (*types.Receipt, error) {
+	var logs []*types.ReceiptLog
+	var kv []*types.KeyValue
+	var receipt *types.Receipt
+
+	oracleStatus, err := findOracleStatus(action.db, event.EventID)
+	if err == types.ErrNotFound {
+		olog.Error("ResultReport", "ResultReport not found eventID", event.EventID)
+		return nil, oty.ErrEventIDNotFound
+	}
+
+	ora := newOracleDBByStatus(oracleStatus)
+
+	if !isMultiReporter(ora) || (ora.Status.Status != oty.EventPublished && ora.Status.Status != oty.ResultReporting) {
+		olog.Error("ResultReport", "ResultReport can not report", ora.Status.Status)
+		return nil, oty.ErrResultReportNotAllowed
+	}
+	//只有事件指定的上报者能上报结果,且每轮只能上报一次
+	if !isReporter(ora, action.fromaddr) {
+		return nil, oty.ErrNotReporter
+	}
+	for _, report := range ora.Reports {
+		if report.Addr == action.fromaddr {
+			return nil, oty.ErrReporterRepeat
+		}
+	}
+	if ora.ResultType == oty.ResultTypeNumeric {
+		if _, ok := new(big.Rat).SetString(event.Result); !ok {
+			return nil, oty.ErrResultNotNumeric
+		}
+	}
+
+	ora.Reports = append(ora.Reports, &oty.ReporterResult{Addr: action.fromaddr, Source: event.Source, Result: event.Result})
+	status := int32(oty.ResultReporting)
+	//达到法定数量后聚合结果,进入争议期
+	if len(ora.Reports) >= int(ora.Quorum) {
+		if result, ok := aggregateResult(ora.ResultType, ora.Reports); ok {
+			status = oty.ResultPrePublished
+			ora.Result = result
+			ora.DisputeEnd = action.blocktime + ora.DisputeWindow
+		}
+	}
+	//全部上报者都已上报仍没有多数结果时事件失败, 只能由发布者撤销
+	if status == oty.ResultReporting && len(ora.Reports) == len(ora.Reporters) {
+		status = oty.ResultFailed
+	}
+	updateStatus(ora, action.GetIndex(), action.fromaddr, status)
+
+	if err := ora.save(action.db); err != nil {
+		return nil, err
+	}
+	kv = append(kv, ora.GetKVSet()...)
+
+	receiptLog := action.getOracleCommonRecipt(&ora.OracleStatus, oty.TyLogResultReport)
+	logs = append(logs, receiptLog)
+
+	receipt = &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}
+	return receipt, nil
+}
+
+func (action *oracleAction) resultDispute(event *oty.ResultDispute) (*types.Receipt, error) {
+	var logs []*types.ReceiptLog
+	var kv []*types.KeyValue
+	var receipt *types.Receipt
+
+	oracleStatus, err := findOracleStatus(action.db, event.EventID)
+	if err == types.ErrNotFound {
+		olog.Error("ResultDispute", "ResultDispute not found eventID", event.EventID)
+		return nil, oty.ErrEventIDNotFound
+	}
+
+	ora := newOracleDBByStatus(oracleStatus)
+
+	if !isMultiReporter(ora) || ora.Status.Status != oty.ResultPrePublished || action.blocktime >= ora.DisputeEnd {
+		olog.Error("ResultDispute", "ResultDispute can not dispute", ora.Status.Status, "disputeEnd", ora.DisputeEnd)
+		return nil, oty.ErrResultDisputeNotAllowed
+	}
+	//每个上报者只能发起一次争议,避免单个上报者无限期阻止结果确认
+	if !isReporter(ora, action.fromaddr) {
+		return nil, oty.ErrNotReporter
+	}
+	for _, addr := range ora.Disputers {
+		if addr == action.fromaddr {
+			return nil, oty.ErrDisputeRepeat
+		}
+	}
+	olog.Info("ResultDispute", "eventID", event.EventID, "disputer", action.fromaddr, "reason", event.Reason)
+
+	//争议成立后清空本轮结果,重新收集上报
+	ora.Disputers = append(ora.Disputers, action.fromaddr)
+	ora.Reports = nil
+	ora.Result = ""
+	ora.DisputeEnd = 0
+	updateStatus(ora, action.GetIndex(), action.fromaddr, oty.ResultReporting)
+
+	if err := ora.save(action.db); err != nil {
+		return nil, err
+	}
+	kv = append(kv, ora.GetKVSet()...)
+
+	receiptLog := action.getOracleCommonRecipt(&ora.OracleStatus, oty.TyLogResultDispute)
+	logs = append(logs, receiptLog)
+
+	receipt = &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}
+	return receipt, nil
+}
+
+func (action *oracleAction) resultFinalize(event *oty.ResultFinalize) (*types.Receipt, error) {
+	var logs []*types.ReceiptLog
+	var kv []*types.KeyValue
+	var receipt *types.Receipt
+
+	oracleStatus, err := findOracleStatus(action.db, event.EventID)
+	if err == types.ErrNotFound {
+		olog.Error("ResultFinalize", "ResultFinalize not found eventID", event.EventID)
+		return nil, oty.ErrEventIDNotFound
+	}
+
+	ora := newOracleDBByStatus(oracleStatus)
+
+	if !isMultiReporter(ora) || ora.Status.Status != oty.ResultPrePublished {
+		olog.Error("ResultFinalize", "ResultFinalize can not finalize", ora.Status.Status)
+		return nil, oty.ErrResultFinalizeNotAllowed
+	}
+	//结果已由上报者聚合,争议期结束后任何人都可以确认
+	if action.blocktime < ora.DisputeEnd {
+		return nil, oty.ErrDisputeWindowNotEnd
+	}
+
+	updateStatus(ora, action.GetIndex(), action.fromaddr, oty.ResultPublished)
+
+	if err := ora.save(action.db); err != nil {
+		return nil, err
+	}
+	kv = append(kv, ora.GetKVSet()...)
+
+	receiptLog := action.getOracleCommonRecipt(&ora.OracleStatus, oty.TyLogResultFinalize)
+	logs = append(logs, receiptLog)
+
+	receipt = &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}
+	return receipt, nil
+}
+
+func (action *oracleAction) checkReporterParam(event *oty.EventPublish) error {
+	if len(event.Reporters) == 0 {
+		if event.Quorum != 0 || event.ResultType != 0 || event.DisputeWindow != 0 {
+			return oty.ErrReporterParamInvalid
+		}
+		return nil
+	}
+	reporters := make(map[string]bool)
+	for _, addr := range event.Reporters {
+		if err := address.CheckAddress(addr, action.height); err != nil {
+			olog.Error("EventPublish", "invalid reporter", addr, "err", err)
+			return oty.ErrReporterParamInvalid
+		}
+		if reporters[addr] {
+			return oty.ErrReporterParamInvalid
+		}
+		reporters[addr] = true
+	}
+	if event.Quorum <= 0 || int(event.Quorum) > len(event.Reporters) {
+		return oty.ErrQuorumInvalid
+	}
+	if event.ResultType != oty.ResultTypeCategorical && event.ResultType != oty.ResultTypeNumeric {
+		return oty.ErrResultTypeInvalid
+	}
+	if event.DisputeWindow <= 0 {
+		return oty.ErrDisputeWindowInvalid
+	}
+	return nil
+}
+
 // GetIndex returns index in block
 func (action *oracleAction) GetIndex() int64 {
 	return action.height*types.MaxTxsPerBlock + int64(action.index)
@@ -359,6 +567,55 @@ func updateStatus(ora *OracleDB, curIndex int64, addr string, status int32) {
 	ora.Status.Status = status
 }
 
+func isMultiReporter(ora *OracleDB) bool {
+	return len(ora.Reporters) > 0
+}
+
+func isReporter(ora *OracleDB, addr string) bool {
+	for _, reporter := range ora.Reporters {
+		if reporter == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// aggregateResult 聚合上报结果, 数值结果取中位数(偶数个时取较小的中间值), 分类结果取过半数的结果
+func aggregateResult(resultType int32, reports []*oty.ReporterResult) (string, bool) {
+	if len(reports) == 0 {
+		return "", false
+	}
+	if resultType == oty.ResultTypeNumeric {
+		values := make([]*big.Rat, len(reports))
+		for i, report := range reports {
+			v, ok := new(big.Rat).SetString(report.Result)
+			if !ok {
+				return "", false
+			}
+			values[i] = v
+		}
+		index := make([]int, len(reports))
+		for i := range index {
+			index[i] = i
+		}
+		sort.SliceStable(index, func(i, j int) bool {
+			return values[index[i]].Cmp(values[index[j]]) < 0
+		})
+		return reports[index[(len(index)-1)/2]].Result, true
+	}
+
+	count := make(map[string]int)
+	for _, report := range reports {
+		count[report.Result]++
+	}
+	for _, report := range reports {
+		if count[report.Result]*2 > len(reports) {
+			return report.Result, true
+		}
+	}
+	return "", false
+}
+
 // getOracleLisByIDs 获取eventinfo
 func getOracleLisByIDs(db dbm.KV, infos *oty.QueryOracleInfos) (types.Message, error) {
 	if len(infos.EventID) == 0 {
@@ -377,7 +634,7 @@ func getOracleLisByIDs(db dbm.KV, infos *oty.QueryOracleInfos) (types.Message, e
 }
 
 func getEventIDListByStatus(db dbm.KVDB, status int32, eventID string) (types.Message, error) {
-	if status <= oty.NoEvent || status > oty.ResultPublished {
+	if status <= oty.NoEvent || status > oty.ResultFailed {
 		return nil, oty.ErrParamStatusInvalid
 	}
 	data := &oty.ReceiptOracle{
@@ -388,7 +645,7 @@ func getEventIDListByStatus(db dbm.KVDB, status int32, eventID string) (types.Me
 }
 
 func getEventIDListByAddrAndStatus(db dbm.KVDB, addr string, status int32, eventID string) (types.Message, error) {
-	if status <= oty.NoEvent || status > oty.ResultPublished {
+	if status <= oty.NoEvent || status > oty.ResultFailed {
 		return nil, oty.ErrParamStatusInvalid
 	}
 	if len(addr) == 0 {
@@ -404,7 +661,7 @@ func getEventIDListByAddrAndStatus(db dbm.KVDB, addr string, status int32, event
 }
 
 func getEventIDListByTypeAndStatus(db dbm.KVDB, ty string, status int32, eventID string) (types.Message, error) {
-	if status <= oty.NoEvent || status > oty.ResultPublished {
+	if status <= oty.NoEvent || status > oty.ResultFailed {
 		return nil, oty.ErrParamStatusInvalid
 	}
 	if len(ty) == 0 {
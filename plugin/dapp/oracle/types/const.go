@@ -20,6 +20,9 @@ const (
 	ActionResultPublish
 	ActionEventAbort
 	ActionResultAbort
+	ActionResultReport
+	ActionResultDispute
+	ActionResultFinalize
 )
 
 // oracle status
@@ -30,6 +33,19 @@ const (
 	ResultPrePublished
 	ResultAborted
 	ResultPublished
+	ResultReporting
+	ResultFailed
+)
+
+// ForkOracleReporter 支持多上报者事件, 按法定数量聚合结果, 设置争议期, 全部上报仍无多数结果时事件失败
+const ForkOracleReporter = "ForkOracleReporter"
+
+// oracle result type
+const (
+	// ResultTypeCategorical 分类结果,按多数聚合
+	ResultTypeCategorical = iota
+	// ResultTypeNumeric 数值结果,按中位数聚合
+	ResultTypeNumeric
 )
 
 // log type define
@@ -39,6 +55,9 @@ const (
 	TyLogResultPrePublish = 812
 	TyLogResultAbort      = 813
 	TyLogResultPublish    = 814
+	TyLogResultReport     = 815
+	TyLogResultDispute    = 816
+	TyLogResultFinalize   = 817
 )
 
 // executor action and function define
@@ -61,6 +80,12 @@ const (
 	CreateAbortResultPrePublishTx = "ResultAbort"
 	// CreateResultPublishTx 创建预发布事件结果交易
 	CreateResultPublishTx = "ResultPublish"
+	// CreateResultReportTx 创建上报者提交结果交易
+	CreateResultReportTx = "ResultReport"
+	// CreateResultDisputeTx 创建对聚合结果发起争议交易
+	CreateResultDisputeTx = "ResultDispute"
+	// CreateResultFinalizeTx 创建争议期后确认聚合结果交易
+	CreateResultFinalizeTx = "ResultFinalize"
 )
 
 // query param define
@@ -85,4 +110,16 @@ var (
 	ErrParamStatusInvalid         = errors.New("ErrParamStatusInvalid")
 	ErrParamAddressMustnotEmpty   = errors.New("ErrParamAddressMustnotEmpty")
 	ErrParamTypeMustNotEmpty      = errors.New("ErrParamTypeMustNotEmpty")
+	ErrReporterParamInvalid       = errors.New("ErrReporterParamInvalid")
+	ErrQuorumInvalid              = errors.New("ErrQuorumInvalid")
+	ErrResultTypeInvalid          = errors.New("ErrResultTypeInvalid")
+	ErrDisputeWindowInvalid       = errors.New("ErrDisputeWindowInvalid")
+	ErrNotReporter                = errors.New("ErrNotReporter")
+	ErrReporterRepeat             = errors.New("ErrReporterRepeat")
+	ErrResultNotNumeric           = errors.New("ErrResultNotNumeric")
+	ErrResultReportNotAllowed     = errors.New("ErrResultReportNotAllowed")
+	ErrResultDisputeNotAllowed    = errors.New("ErrResultDisputeNotAllowed")
+	ErrDisputeRepeat              = errors.New("ErrDisputeRepeat")
+	ErrResultFinalizeNotAllowed   = errors.New("ErrResultFinalizeNotAllowed")
+	ErrDisputeWindowNotEnd        = errors.New("ErrDisputeWindowNotEnd")
 )
@@ -27,17 +27,24 @@ type OracleStatus struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	EventID      string       `protobuf:"bytes,1,opt,name=eventID,proto3" json:"eventID,omitempty"`           //事件ID
-	Addr         string       `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`                 //发布者地址
-	Type         string       `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`                 //游戏类别
-	SubType      string       `protobuf:"bytes,4,opt,name=subType,proto3" json:"subType,omitempty"`           //游戏子类别
-	Time         int64        `protobuf:"varint,5,opt,name=time,proto3" json:"time,omitempty"`                //结果公布参考时间
-	Content      string       `protobuf:"bytes,6,opt,name=content,proto3" json:"content,omitempty"`           //事件内容
-	Introduction string       `protobuf:"bytes,7,opt,name=introduction,proto3" json:"introduction,omitempty"` //事件描述
-	Status       *EventStatus `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`             //操作状态
-	Source       string       `protobuf:"bytes,9,opt,name=source,proto3" json:"source,omitempty"`             //数据来源
-	Result       string       `protobuf:"bytes,10,opt,name=result,proto3" json:"result,omitempty"`            //事件结果
-	PreStatus    *EventStatus `protobuf:"bytes,11,opt,name=preStatus,proto3" json:"preStatus,omitempty"`      //上次操作后状态及操作者地址
+	EventID       string            `protobuf:"bytes,1,opt,name=eventID,proto3" json:"eventID,omitempty"`               //事件ID
+	Addr          string            `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`                     //发布者地址
+	Type          string            `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`                     //游戏类别
+	SubType       string            `protobuf:"bytes,4,opt,name=subType,proto3" json:"subType,omitempty"`               //游戏子类别
+	Time          int64             `protobuf:"varint,5,opt,name=time,proto3" json:"time,omitempty"`                    //结果公布参考时间
+	Content       string            `protobuf:"bytes,6,opt,name=content,proto3" json:"content,omitempty"`               //事件内容
+	Introduction  string            `protobuf:"bytes,7,opt,name=introduction,proto3" json:"introduction,omitempty"`     //事件描述
+	Status        *EventStatus      `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`                 //操作状态
+	Source        string            `protobuf:"bytes,9,opt,name=source,proto3" json:"source,omitempty"`                 //数据来源
+	Result        string            `protobuf:"bytes,10,opt,name=result,proto3" json:"result,omitempty"`                //事件结果
+	PreStatus     *EventStatus      `protobuf:"bytes,11,opt,name=preStatus,proto3" json:"preStatus,omitempty"`          //上次操作后状态及操作者地址
+	Reporters     []string          `protobuf:"bytes,12,rep,name=reporters,proto3" json:"reporters,omitempty"`          //结果上报者集合,为空时沿用单一发布者流程
+	Quorum        int32             `protobuf:"varint,13,opt,name=quorum,proto3" json:"quorum,omitempty"`               //聚合结果所需的最少上报数
+	ResultType    int32             `protobuf:"varint,14,opt,name=resultType,proto3" json:"resultType,omitempty"`       //结果类型, 0:分类结果(多数), 1:数值结果(中位数)
+	DisputeWindow int64             `protobuf:"varint,15,opt,name=disputeWindow,proto3" json:"disputeWindow,omitempty"` //聚合结果后的争议期(秒)
+	Reports       []*ReporterResult `protobuf:"bytes,16,rep,name=reports,proto3" json:"reports,omitempty"`              //本轮各上报者提交的结果
+	Disputers     []string          `protobuf:"bytes,17,rep,name=disputers,proto3" json:"disputers,omitempty"`          //已发起过争议的上报者
+	DisputeEnd    int64             `protobuf:"varint,18,opt,name=disputeEnd,proto3" json:"disputeEnd,omitempty"`       //争议期截止时间
 }
 
 func (x *OracleStatus) Reset() {
@@ -149,6 +156,119 @@ func (x *OracleStatus) GetPreStatus() *EventStatus {
 	return nil
 }
 
+func (x *OracleStatus) GetReporters() []string {
+	if x != nil {
+		return x.Reporters
+	}
+	return nil
+}
+
+func (x *OracleStatus) GetQuorum() int32 {
+	if x != nil {
+		return x.Quorum
+	}
+	return 0
+}
+
+func (x *OracleStatus) GetResultType() int32 {
+	if x != nil {
+		return x.ResultType
+	}
+	return 0
+}
+
+func (x *OracleStatus) GetDisputeWindow() int64 {
+	if x != nil {
+		return x.DisputeWindow
+	}
+	return 0
+}
+
+func (x *OracleStatus) GetReports() []*ReporterResult {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
+}
+
+func (x *OracleStatus) GetDisputers() []string {
+	if x != nil {
+		return x.Disputers
+	}
+	return nil
+}
+
+func (x *OracleStatus) GetDisputeEnd() int64 {
+	if x != nil {
+		return x.DisputeEnd
+	}
+	return 0
+}
+
+// 上报者提交的结果
+type ReporterResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addr   string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`     //上报者地址
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"` //数据来源
+	Result string `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"` //上报数据
+}
+
+func (x *ReporterResult) Reset() {
+	*x = ReporterResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReporterResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReporterResult) ProtoMessage() {}
+
+func (x *ReporterResult) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReporterResult.ProtoReflect.Descriptor instead.
+func (*ReporterResult) Descriptor() ([]byte, []int) {
+	return file_oracle_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReporterResult) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *ReporterResult) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ReporterResult) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
 // action
 type OracleAction struct {
 	state         protoimpl.MessageState
@@ -161,6 +281,9 @@ type OracleAction struct {
 	//	*OracleAction_ResultPrePublish
 	//	*OracleAction_ResultPublish
 	//	*OracleAction_ResultAbort
+	//	*OracleAction_ResultReport
+	//	*OracleAction_ResultDispute
+	//	*OracleAction_ResultFinalize
 	Value isOracleAction_Value `protobuf_oneof:"value"`
 	Ty    int32                `protobuf:"varint,7,opt,name=Ty,proto3" json:"Ty,omitempty"`
 }
@@ -168,7 +291,7 @@ type OracleAction struct {
 func (x *OracleAction) Reset() {
 	*x = OracleAction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_proto_msgTypes[1]
+		mi := &file_oracle_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -181,7 +304,7 @@ func (x *OracleAction) String() string {
 func (*OracleAction) ProtoMessage() {}
 
 func (x *OracleAction) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_proto_msgTypes[1]
+	mi := &file_oracle_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -194,7 +317,7 @@ func (x *OracleAction) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OracleAction.ProtoReflect.Descriptor instead.
 func (*OracleAction) Descriptor() ([]byte, []int) {
-	return file_oracle_proto_rawDescGZIP(), []int{1}
+	return file_oracle_proto_rawDescGZIP(), []int{2}
 }
 
 func (m *OracleAction) GetValue() isOracleAction_Value {
@@ -239,6 +362,27 @@ func (x *OracleAction) GetResultAbort() *ResultAbort {
 	return nil
 }
 
+func (x *OracleAction) GetResultReport() *ResultReport {
+	if x, ok := x.GetValue().(*OracleAction_ResultReport); ok {
+		return x.ResultReport
+	}
+	return nil
+}
+
+func (x *OracleAction) GetResultDispute() *ResultDispute {
+	if x, ok := x.GetValue().(*OracleAction_ResultDispute); ok {
+		return x.ResultDispute
+	}
+	return nil
+}
+
+func (x *OracleAction) GetResultFinalize() *ResultFinalize {
+	if x, ok := x.GetValue().(*OracleAction_ResultFinalize); ok {
+		return x.ResultFinalize
+	}
+	return nil
+}
+
 func (x *OracleAction) GetTy() int32 {
 	if x != nil {
 		return x.Ty
@@ -270,6 +414,18 @@ type OracleAction_ResultAbort struct {
 	ResultAbort *ResultAbort `protobuf:"bytes,5,opt,name=resultAbort,proto3,oneof"`
 }
 
+type OracleAction_ResultReport struct {
+	ResultReport *ResultReport `protobuf:"bytes,8,opt,name=resultReport,proto3,oneof"`
+}
+
+type OracleAction_ResultDispute struct {
+	ResultDispute *ResultDispute `protobuf:"bytes,9,opt,name=resultDispute,proto3,oneof"`
+}
+
+type OracleAction_ResultFinalize struct {
+	ResultFinalize *ResultFinalize `protobuf:"bytes,10,opt,name=resultFinalize,proto3,oneof"`
+}
+
 func (*OracleAction_EventPublish) isOracleAction_Value() {}
 
 func (*OracleAction_EventAbort) isOracleAction_Value() {}
@@ -280,6 +436,12 @@ func (*OracleAction_ResultPublish) isOracleAction_Value() {}
 
 func (*OracleAction_ResultAbort) isOracleAction_Value() {}
 
+func (*OracleAction_ResultReport) isOracleAction_Value() {}
+
+func (*OracleAction_ResultDispute) isOracleAction_Value() {}
+
+func (*OracleAction_ResultFinalize) isOracleAction_Value() {}
+
 type EventStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -292,7 +454,7 @@ type EventStatus struct {
 func (x *EventStatus) Reset() {
 	*x = EventStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_proto_msgTypes[2]
+		mi := &file_oracle_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -305,7 +467,7 @@ func (x *EventStatus) String() string {
 func (*EventStatus) ProtoMessage() {}
 
 func (x *EventStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_proto_msgTypes[2]
+	mi := &file_oracle_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -318,7 +480,7 @@ func (x *EventStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EventStatus.ProtoReflect.Descriptor instead.
 func (*EventStatus) Descriptor() ([]byte, []int) {
-	return file_oracle_proto_rawDescGZIP(), []int{2}
+	return file_oracle_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *EventStatus) GetOpAddr() string {
@@ -340,17 +502,21 @@ type EventPublish struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type         string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`                 //游戏类别
-	SubType      string `protobuf:"bytes,3,opt,name=subType,proto3" json:"subType,omitempty"`           //游戏子类别
-	Time         int64  `protobuf:"varint,4,opt,name=time,proto3" json:"time,omitempty"`                //结果公布参考时间
-	Content      string `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`           //事件内容
-	Introduction string `protobuf:"bytes,6,opt,name=introduction,proto3" json:"introduction,omitempty"` //事件描述
+	Type          string   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`                     //游戏类别
+	SubType       string   `protobuf:"bytes,3,opt,name=subType,proto3" json:"subType,omitempty"`               //游戏子类别
+	Time          int64    `protobuf:"varint,4,opt,name=time,proto3" json:"time,omitempty"`                    //结果公布参考时间
+	Content       string   `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`               //事件内容
+	Introduction  string   `protobuf:"bytes,6,opt,name=introduction,proto3" json:"introduction,omitempty"`     //事件描述
+	Reporters     []string `protobuf:"bytes,7,rep,name=reporters,proto3" json:"reporters,omitempty"`           //结果上报者集合
+	Quorum        int32    `protobuf:"varint,8,opt,name=quorum,proto3" json:"quorum,omitempty"`                //聚合结果所需的最少上报数
+	ResultType    int32    `protobuf:"varint,9,opt,name=resultType,proto3" json:"resultType,omitempty"`        //结果类型, 0:分类结果, 1:数值结果
+	DisputeWindow int64    `protobuf:"varint,10,opt,name=disputeWindow,proto3" json:"disputeWindow,omitempty"` //争议期(秒)
 }
 
 func (x *EventPublish) Reset() {
 	*x = EventPublish{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_proto_msgTypes[3]
+		mi := &file_oracle_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -363,7 +529,7 @@ func (x *EventPublish) String() string {
 func (*EventPublish) ProtoMessage() {}
 
 func (x *EventPublish) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_proto_msgTypes[3]
+	mi := &file_oracle_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -376,7 +542,7 @@ func (x *EventPublish) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EventPublish.ProtoReflect.Descriptor instead.
 func (*EventPublish) Descriptor() ([]byte, []int) {
-	return file_oracle_proto_rawDescGZIP(), []int{3}
+	return file_oracle_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *EventPublish) GetType() string {
@@ -414,6 +580,34 @@ func (x *EventPublish) GetIntroduction() string {
 	return ""
 }
 
+func (x *EventPublish) GetReporters() []string {
+	if x != nil {
+		return x.Reporters
+	}
+	return nil
+}
+
+func (x *EventPublish) GetQuorum() int32 {
+	if x != nil {
+		return x.Quorum
+	}
+	return 0
+}
+
+func (x *EventPublish) GetResultType() int32 {
+	if x != nil {
+		return x.ResultType
+	}
+	return 0
+}
+
+func (x *EventPublish) GetDisputeWindow() int64 {
+	if x != nil {
+		return x.DisputeWindow
+	}
+	return 0
+}
+
 type EventAbort struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -425,7 +619,7 @@ type EventAbort struct {
 func (x *EventAbort) Reset() {
 	*x = EventAbort{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_proto_msgTypes[4]
+		mi := &file_oracle_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -438,7 +632,7 @@ func (x *EventAbort) String() string {
 func (*EventAbort) ProtoMessage() {}
 
 func (x *EventAbort) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_proto_msgTypes[4]
+	mi := &file_oracle_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -451,7 +645,7 @@ func (x *EventAbort) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EventAbort.ProtoReflect.Descriptor instead.
 func (*EventAbort) Descriptor() ([]byte, []int) {
-	return file_oracle_proto_rawDescGZIP(), []int{4}
+	return file_oracle_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *EventAbort) GetEventID() string {
@@ -474,7 +668,7 @@ type ResultPrePublish struct {
 func (x *ResultPrePublish) Reset() {
 	*x = ResultPrePublish{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_proto_msgTypes[5]
+		mi := &file_oracle_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -487,7 +681,7 @@ func (x *ResultPrePublish) String() string {
 func (*ResultPrePublish) ProtoMessage() {}
 
 func (x *ResultPrePublish) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_proto_msgTypes[5]
+	mi := &file_oracle_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -500,7 +694,7 @@ func (x *ResultPrePublish) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResultPrePublish.ProtoReflect.Descriptor instead.
 func (*ResultPrePublish) Descriptor() ([]byte, []int) {
-	return file_oracle_proto_rawDescGZIP(), []int{5}
+	return file_oracle_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ResultPrePublish) GetEventID() string {
@@ -537,7 +731,7 @@ type ResultPublish struct {
 func (x *ResultPublish) Reset() {
 	*x = ResultPublish{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_proto_msgTypes[6]
+		mi := &file_oracle_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -550,7 +744,7 @@ func (x *ResultPublish) String() string {
 func (*ResultPublish) ProtoMessage() {}
 
 func (x *ResultPublish) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_proto_msgTypes[6]
+	mi := &file_oracle_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -563,7 +757,7 @@ func (x *ResultPublish) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResultPublish.ProtoReflect.Descriptor instead.
 func (*ResultPublish) Descriptor() ([]byte, []int) {
-	return file_oracle_proto_rawDescGZIP(), []int{6}
+	return file_oracle_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ResultPublish) GetEventID() string {
@@ -598,7 +792,7 @@ type ResultAbort struct {
 func (x *ResultAbort) Reset() {
 	*x = ResultAbort{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_proto_msgTypes[7]
+		mi := &file_oracle_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -611,7 +805,7 @@ func (x *ResultAbort) String() string {
 func (*ResultAbort) ProtoMessage() {}
 
 func (x *ResultAbort) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_proto_msgTypes[7]
+	mi := &file_oracle_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -624,7 +818,7 @@ func (x *ResultAbort) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResultAbort.ProtoReflect.Descriptor instead.
 func (*ResultAbort) Descriptor() ([]byte, []int) {
-	return file_oracle_proto_rawDescGZIP(), []int{7}
+	return file_oracle_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ResultAbort) GetEventID() string {
@@ -634,6 +828,171 @@ func (x *ResultAbort) GetEventID() string {
 	return ""
 }
 
+type ResultReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventID string `protobuf:"bytes,2,opt,name=eventID,proto3" json:"eventID,omitempty"` //发布事件的ID
+	Source  string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`   //数据来源
+	Result  string `protobuf:"bytes,4,opt,name=result,proto3" json:"result,omitempty"`   //上报数据
+}
+
+func (x *ResultReport) Reset() {
+	*x = ResultReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResultReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResultReport) ProtoMessage() {}
+
+func (x *ResultReport) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResultReport.ProtoReflect.Descriptor instead.
+func (*ResultReport) Descriptor() ([]byte, []int) {
+	return file_oracle_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ResultReport) GetEventID() string {
+	if x != nil {
+		return x.EventID
+	}
+	return ""
+}
+
+func (x *ResultReport) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ResultReport) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+type ResultDispute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventID string `protobuf:"bytes,2,opt,name=eventID,proto3" json:"eventID,omitempty"` //发布事件的ID
+	Reason  string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`   //争议原因
+}
+
+func (x *ResultDispute) Reset() {
+	*x = ResultDispute{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResultDispute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResultDispute) ProtoMessage() {}
+
+func (x *ResultDispute) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResultDispute.ProtoReflect.Descriptor instead.
+func (*ResultDispute) Descriptor() ([]byte, []int) {
+	return file_oracle_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ResultDispute) GetEventID() string {
+	if x != nil {
+		return x.EventID
+	}
+	return ""
+}
+
+func (x *ResultDispute) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ResultFinalize struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventID string `protobuf:"bytes,2,opt,name=eventID,proto3" json:"eventID,omitempty"` //发布事件的ID
+}
+
+func (x *ResultFinalize) Reset() {
+	*x = ResultFinalize{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResultFinalize) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResultFinalize) ProtoMessage() {}
+
+func (x *ResultFinalize) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResultFinalize.ProtoReflect.Descriptor instead.
+func (*ResultFinalize) Descriptor() ([]byte, []int) {
+	return file_oracle_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ResultFinalize) GetEventID() string {
+	if x != nil {
+		return x.EventID
+	}
+	return ""
+}
+
 // localDB
 type EventRecord struct {
 	state         protoimpl.MessageState
@@ -646,7 +1005,7 @@ type EventRecord struct {
 func (x *EventRecord) Reset() {
 	*x = EventRecord{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_proto_msgTypes[8]
+		mi := &file_oracle_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -659,7 +1018,7 @@ func (x *EventRecord) String() string {
 func (*EventRecord) ProtoMessage() {}
 
 func (x *EventRecord) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_proto_msgTypes[8]
+	mi := &file_oracle_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -672,7 +1031,7 @@ func (x *EventRecord) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EventRecord.ProtoReflect.Descriptor instead.
 func (*EventRecord) Descriptor() ([]byte, []int) {
-	return file_oracle_proto_rawDescGZIP(), []int{8}
+	return file_oracle_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *EventRecord) GetEventID() string {
@@ -693,7 +1052,7 @@ type QueryOracleInfos struct {
 func (x *QueryOracleInfos) Reset() {
 	*x = QueryOracleInfos{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_proto_msgTypes[9]
+		mi := &file_oracle_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -706,7 +1065,7 @@ func (x *QueryOracleInfos) String() string {
 func (*QueryOracleInfos) ProtoMessage() {}
 
 func (x *QueryOracleInfos) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_proto_msgTypes[9]
+	mi := &file_oracle_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -719,7 +1078,7 @@ func (x *QueryOracleInfos) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryOracleInfos.ProtoReflect.Descriptor instead.
 func (*QueryOracleInfos) Descriptor() ([]byte, []int) {
-	return file_oracle_proto_rawDescGZIP(), []int{9}
+	return file_oracle_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *QueryOracleInfos) GetEventID() []string {
@@ -740,7 +1099,7 @@ type ReplyEventIDs struct {
 func (x *ReplyEventIDs) Reset() {
 	*x = ReplyEventIDs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_proto_msgTypes[10]
+		mi := &file_oracle_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -753,7 +1112,7 @@ func (x *ReplyEventIDs) String() string {
 func (*ReplyEventIDs) ProtoMessage() {}
 
 func (x *ReplyEventIDs) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_proto_msgTypes[10]
+	mi := &file_oracle_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -766,7 +1125,7 @@ func (x *ReplyEventIDs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReplyEventIDs.ProtoReflect.Descriptor instead.
 func (*ReplyEventIDs) Descriptor() ([]byte, []int) {
-	return file_oracle_proto_rawDescGZIP(), []int{10}
+	return file_oracle_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ReplyEventIDs) GetEventID() []string {
@@ -790,7 +1149,7 @@ type QueryEventID struct {
 func (x *QueryEventID) Reset() {
 	*x = QueryEventID{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_proto_msgTypes[11]
+		mi := &file_oracle_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -803,7 +1162,7 @@ func (x *QueryEventID) String() string {
 func (*QueryEventID) ProtoMessage() {}
 
 func (x *QueryEventID) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_proto_msgTypes[11]
+	mi := &file_oracle_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -816,7 +1175,7 @@ func (x *QueryEventID) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryEventID.ProtoReflect.Descriptor instead.
 func (*QueryEventID) Descriptor() ([]byte, []int) {
-	return file_oracle_proto_rawDescGZIP(), []int{11}
+	return file_oracle_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *QueryEventID) GetStatus() int32 {
@@ -862,7 +1221,7 @@ type ReceiptOracle struct {
 func (x *ReceiptOracle) Reset() {
 	*x = ReceiptOracle{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_proto_msgTypes[12]
+		mi := &file_oracle_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -875,7 +1234,7 @@ func (x *ReceiptOracle) String() string {
 func (*ReceiptOracle) ProtoMessage() {}
 
 func (x *ReceiptOracle) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_proto_msgTypes[12]
+	mi := &file_oracle_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -888,7 +1247,7 @@ func (x *ReceiptOracle) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReceiptOracle.ProtoReflect.Descriptor instead.
 func (*ReceiptOracle) Descriptor() ([]byte, []int) {
-	return file_oracle_proto_rawDescGZIP(), []int{12}
+	return file_oracle_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ReceiptOracle) GetEventID() string {
@@ -937,7 +1296,7 @@ type ReplyOracleStatusList struct {
 func (x *ReplyOracleStatusList) Reset() {
 	*x = ReplyOracleStatusList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_proto_msgTypes[13]
+		mi := &file_oracle_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -950,7 +1309,7 @@ func (x *ReplyOracleStatusList) String() string {
 func (*ReplyOracleStatusList) ProtoMessage() {}
 
 func (x *ReplyOracleStatusList) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_proto_msgTypes[13]
+	mi := &file_oracle_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -963,7 +1322,7 @@ func (x *ReplyOracleStatusList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReplyOracleStatusList.ProtoReflect.Descriptor instead.
 func (*ReplyOracleStatusList) Descriptor() ([]byte, []int) {
-	return file_oracle_proto_rawDescGZIP(), []int{13}
+	return file_oracle_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ReplyOracleStatusList) GetStatus() []*OracleStatus {
@@ -977,7 +1336,7 @@ var File_oracle_proto protoreflect.FileDescriptor
 
 var file_oracle_proto_rawDesc = []byte{
 	0x0a, 0x0c, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x22, 0xca, 0x02, 0x0a, 0x0c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x22, 0xb5, 0x04, 0x0a, 0x0c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65,
 	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49,
 	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x44,
 	0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
@@ -998,56 +1357,108 @@ var file_oracle_proto_rawDesc = []byte{
 	0x12, 0x30, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0b, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x76, 0x65, 0x6e,
 	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x09, 0x70, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x22, 0xd4, 0x02, 0x0a, 0x0c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x41, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0c, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x75, 0x62, 0x6c,
-	0x69, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x48, 0x00,
-	0x52, 0x0c, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x12, 0x33,
-	0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x41, 0x62, 0x6f, 0x72, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x41, 0x62,
-	0x6f, 0x72, 0x74, 0x12, 0x45, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x50, 0x72, 0x65,
-	0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x50, 0x72, 0x65, 0x50,
-	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x48, 0x00, 0x52, 0x10, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x50, 0x72, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x12, 0x3c, 0x0a, 0x0d, 0x72, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x48, 0x00, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x12, 0x36, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x41, 0x62, 0x6f, 0x72,
-	0x74, 0x48, 0x00, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x41, 0x62, 0x6f, 0x72, 0x74,
-	0x12, 0x0e, 0x0a, 0x02, 0x54, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x54, 0x79,
-	0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3d, 0x0a, 0x0b, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x70, 0x41, 0x64,
-	0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x70, 0x41, 0x64, 0x64, 0x72,
-	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x8e, 0x01, 0x0a, 0x0c, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x73, 0x75, 0x62, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x73, 0x75, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x74,
-	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x26, 0x0a, 0x0a, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x73, 0x18,
+	0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x70,
+	0x75, 0x74, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0d, 0x64, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x2f,
+	0x0a, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x72, 0x73, 0x18, 0x11, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x09, 0x64, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x64, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x45, 0x6e, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x45, 0x6e, 0x64, 0x22, 0x54, 0x0a,
+	0x0e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61,
+	0x64, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x22, 0x8e, 0x04, 0x0a, 0x0c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0c, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x48,
+	0x00, 0x52, 0x0c, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x12,
+	0x33, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x41,
+	0x62, 0x6f, 0x72, 0x74, 0x12, 0x45, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x50, 0x72,
+	0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x50, 0x72, 0x65,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x48, 0x00, 0x52, 0x10, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x50, 0x72, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x12, 0x3c, 0x0a, 0x0d, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x48, 0x00, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x12, 0x36, 0x0a, 0x0b, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x41, 0x62, 0x6f,
+	0x72, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x41, 0x62, 0x6f, 0x72,
+	0x74, 0x12, 0x39, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x48, 0x00, 0x52, 0x0c,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x3c, 0x0a, 0x0d,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x44, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x44, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x44, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x12, 0x3f, 0x0a, 0x0e, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x48, 0x00, 0x52, 0x0e, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x54,
+	0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x54, 0x79, 0x42, 0x07, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x22, 0x3d, 0x0a, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x70, 0x41, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x70, 0x41, 0x64, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0x8a, 0x02, 0x0a, 0x0c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x69,
+	0x73, 0x70, 0x75, 0x74, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x22, 0x26, 0x0a, 0x0a, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x22, 0x5c, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x50, 0x72, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x59, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74,
 	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49,
-	0x44, 0x22, 0x5c, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x50, 0x72, 0x65, 0x50, 0x75,
-	0x62, 0x6c, 0x69, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x44,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12,
-	0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22,
-	0x59, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
+	0x44, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x22, 0x27, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x41, 0x62, 0x6f, 0x72, 0x74,
 	0x12, 0x18, 0x0a, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x27, 0x0a, 0x0b, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x76, 0x65,
+	0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x22, 0x58, 0x0a, 0x0c, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x22, 0x41, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x44, 0x69,
+	0x73, 0x70, 0x75, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x2a, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x76, 0x65,
 	0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e,
 	0x74, 0x49, 0x44, 0x22, 0x27, 0x0a, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x6f,
 	0x72, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20,
@@ -1093,37 +1504,45 @@ func file_oracle_proto_rawDescGZIP() []byte {
 	return file_oracle_proto_rawDescData
 }
 
-var file_oracle_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_oracle_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_oracle_proto_goTypes = []interface{}{
 	(*OracleStatus)(nil),          // 0: types.OracleStatus
-	(*OracleAction)(nil),          // 1: types.OracleAction
-	(*EventStatus)(nil),           // 2: types.EventStatus
-	(*EventPublish)(nil),          // 3: types.EventPublish
-	(*EventAbort)(nil),            // 4: types.EventAbort
-	(*ResultPrePublish)(nil),      // 5: types.ResultPrePublish
-	(*ResultPublish)(nil),         // 6: types.ResultPublish
-	(*ResultAbort)(nil),           // 7: types.ResultAbort
-	(*EventRecord)(nil),           // 8: types.EventRecord
-	(*QueryOracleInfos)(nil),      // 9: types.QueryOracleInfos
-	(*ReplyEventIDs)(nil),         // 10: types.ReplyEventIDs
-	(*QueryEventID)(nil),          // 11: types.QueryEventID
-	(*ReceiptOracle)(nil),         // 12: types.ReceiptOracle
-	(*ReplyOracleStatusList)(nil), // 13: types.ReplyOracleStatusList
+	(*ReporterResult)(nil),        // 1: types.ReporterResult
+	(*OracleAction)(nil),          // 2: types.OracleAction
+	(*EventStatus)(nil),           // 3: types.EventStatus
+	(*EventPublish)(nil),          // 4: types.EventPublish
+	(*EventAbort)(nil),            // 5: types.EventAbort
+	(*ResultPrePublish)(nil),      // 6: types.ResultPrePublish
+	(*ResultPublish)(nil),         // 7: types.ResultPublish
+	(*ResultAbort)(nil),           // 8: types.ResultAbort
+	(*ResultReport)(nil),          // 9: types.ResultReport
+	(*ResultDispute)(nil),         // 10: types.ResultDispute
+	(*ResultFinalize)(nil),        // 11: types.ResultFinalize
+	(*EventRecord)(nil),           // 12: types.EventRecord
+	(*QueryOracleInfos)(nil),      // 13: types.QueryOracleInfos
+	(*ReplyEventIDs)(nil),         // 14: types.ReplyEventIDs
+	(*QueryEventID)(nil),          // 15: types.QueryEventID
+	(*ReceiptOracle)(nil),         // 16: types.ReceiptOracle
+	(*ReplyOracleStatusList)(nil), // 17: types.ReplyOracleStatusList
 }
 var file_oracle_proto_depIdxs = []int32{
-	2, // 0: types.OracleStatus.status:type_name -> types.EventStatus
-	2, // 1: types.OracleStatus.preStatus:type_name -> types.EventStatus
-	3, // 2: types.OracleAction.eventPublish:type_name -> types.EventPublish
-	4, // 3: types.OracleAction.eventAbort:type_name -> types.EventAbort
-	5, // 4: types.OracleAction.resultPrePublish:type_name -> types.ResultPrePublish
-	6, // 5: types.OracleAction.resultPublish:type_name -> types.ResultPublish
-	7, // 6: types.OracleAction.resultAbort:type_name -> types.ResultAbort
-	0, // 7: types.ReplyOracleStatusList.status:type_name -> types.OracleStatus
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	3,  // 0: types.OracleStatus.status:type_name -> types.EventStatus
+	3,  // 1: types.OracleStatus.preStatus:type_name -> types.EventStatus
+	1,  // 2: types.OracleStatus.reports:type_name -> types.ReporterResult
+	4,  // 3: types.OracleAction.eventPublish:type_name -> types.EventPublish
+	5,  // 4: types.OracleAction.eventAbort:type_name -> types.EventAbort
+	6,  // 5: types.OracleAction.resultPrePublish:type_name -> types.ResultPrePublish
+	7,  // 6: types.OracleAction.resultPublish:type_name -> types.ResultPublish
+	8,  // 7: types.OracleAction.resultAbort:type_name -> types.ResultAbort
+	9,  // 8: types.OracleAction.resultReport:type_name -> types.ResultReport
+	10, // 9: types.OracleAction.resultDispute:type_name -> types.ResultDispute
+	11, // 10: types.OracleAction.resultFinalize:type_name -> types.ResultFinalize
+	0,  // 11: types.ReplyOracleStatusList.status:type_name -> types.OracleStatus
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_oracle_proto_init() }
@@ -1145,7 +1564,7 @@ func file_oracle_proto_init() {
 			}
 		}
 		file_oracle_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OracleAction); i {
+			switch v := v.(*ReporterResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1157,7 +1576,7 @@ func file_oracle_proto_init() {
 			}
 		}
 		file_oracle_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventStatus); i {
+			switch v := v.(*OracleAction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1169,7 +1588,7 @@ func file_oracle_proto_init() {
 			}
 		}
 		file_oracle_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventPublish); i {
+			switch v := v.(*EventStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1181,7 +1600,7 @@ func file_oracle_proto_init() {
 			}
 		}
 		file_oracle_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventAbort); i {
+			switch v := v.(*EventPublish); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1193,7 +1612,7 @@ func file_oracle_proto_init() {
 			}
 		}
 		file_oracle_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResultPrePublish); i {
+			switch v := v.(*EventAbort); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1205,7 +1624,7 @@ func file_oracle_proto_init() {
 			}
 		}
 		file_oracle_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResultPublish); i {
+			switch v := v.(*ResultPrePublish); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1217,7 +1636,7 @@ func file_oracle_proto_init() {
 			}
 		}
 		file_oracle_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResultAbort); i {
+			switch v := v.(*ResultPublish); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1229,7 +1648,7 @@ func file_oracle_proto_init() {
 			}
 		}
 		file_oracle_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventRecord); i {
+			switch v := v.(*ResultAbort); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1241,7 +1660,7 @@ func file_oracle_proto_init() {
 			}
 		}
 		file_oracle_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryOracleInfos); i {
+			switch v := v.(*ResultReport); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1253,7 +1672,7 @@ func file_oracle_proto_init() {
 			}
 		}
 		file_oracle_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReplyEventIDs); i {
+			switch v := v.(*ResultDispute); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1265,7 +1684,7 @@ func file_oracle_proto_init() {
 			}
 		}
 		file_oracle_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryEventID); i {
+			switch v := v.(*ResultFinalize); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1277,7 +1696,7 @@ func file_oracle_proto_init() {
 			}
 		}
 		file_oracle_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReceiptOracle); i {
+			switch v := v.(*EventRecord); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1289,6 +1708,54 @@ func file_oracle_proto_init() {
 			}
 		}
 		file_oracle_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryOracleInfos); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplyEventIDs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryEventID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReceiptOracle); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReplyOracleStatusList); i {
 			case 0:
 				return &v.state
@@ -1301,12 +1768,15 @@ func file_oracle_proto_init() {
 			}
 		}
 	}
-	file_oracle_proto_msgTypes[1].OneofWrappers = []interface{}{
+	file_oracle_proto_msgTypes[2].OneofWrappers = []interface{}{
 		(*OracleAction_EventPublish)(nil),
 		(*OracleAction_EventAbort)(nil),
 		(*OracleAction_ResultPrePublish)(nil),
 		(*OracleAction_ResultPublish)(nil),
 		(*OracleAction_ResultAbort)(nil),
+		(*OracleAction_ResultReport)(nil),
+		(*OracleAction_ResultDispute)(nil),
+		(*OracleAction_ResultFinalize)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1314,7 +1784,7 @@ func file_oracle_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_oracle_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
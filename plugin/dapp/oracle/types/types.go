@@ -22,6 +22,7 @@ func init() {
 //InitFork ...
 func InitFork(cfg *types.Chain33Config) {
 	cfg.RegisterDappFork(OracleX, "Enable", 0)
+	cfg.RegisterDappFork(OracleX, ForkOracleReporter, 0)
 }
 
 //InitExecutor ...
@@ -60,6 +61,9 @@ func (o *OracleType) GetTypeMap() map[string]int32 {
 		"ResultPrePublish": ActionResultPrePublish,
 		"ResultAbort":      ActionResultAbort,
 		"ResultPublish":    ActionResultPublish,
+		"ResultReport":     ActionResultReport,
+		"ResultDispute":    ActionResultDispute,
+		"ResultFinalize":   ActionResultFinalize,
 	}
 }
 
@@ -71,5 +75,8 @@ func (o *OracleType) GetLogMap() map[int64]*types.LogInfo {
 		TyLogResultPrePublish: {Ty: reflect.TypeOf(ReceiptOracle{}), Name: "LogResultPrePublish"},
 		TyLogResultAbort:      {Ty: reflect.TypeOf(ReceiptOracle{}), Name: "LogResultAbort"},
 		TyLogResultPublish:    {Ty: reflect.TypeOf(ReceiptOracle{}), Name: "LogResultPublish"},
+		TyLogResultReport:     {Ty: reflect.TypeOf(ReceiptOracle{}), Name: "LogResultReport"},
+		TyLogResultDispute:    {Ty: reflect.TypeOf(ReceiptOracle{}), Name: "LogResultDispute"},
+		TyLogResultFinalize:   {Ty: reflect.TypeOf(ReceiptOracle{}), Name: "LogResultFinalize"},
 	}
 }
@@ -14,6 +14,7 @@ import (
 	drivers "github.com/33cn/chain33/system/dapp"
 	"github.com/33cn/chain33/types"
 	"github.com/33cn/chain33/util"
+	multisigty "github.com/33cn/plugin/plugin/dapp/multisig/types"
 	pt "github.com/33cn/plugin/plugin/dapp/paracross/types"
 )
 
@@ -311,15 +312,19 @@ func (c *Paracross) updateLocalAssetTransfer(tx *types.Transaction, paraHeight i
 
 //IsFriend call exec is same seariase exec
 func (c *Paracross) IsFriend(myexec, writekey []byte, tx *types.Transaction) bool {
+	//friend 调用必须是自己在调用
+	if string(myexec) != c.GetDriverName() {
+		return false
+	}
+	//多重签名账户通过multisig合约执行的paracross交易
+	if multisigty.IsExecTxFriend(tx) {
+		return true
+	}
 	//不允许平行链
 	cfg := c.GetAPI().GetConfig()
 	if cfg.IsPara() {
 		return false
 	}
-	//friend 调用必须是自己在调用
-	if string(myexec) != c.GetDriverName() {
-		return false
-	}
 	//只允许同系列的执行器（tx 也必须是 paracross）
 	if string(types.GetRealExecName(tx.Execer)) != c.GetDriverName() {
 		return false
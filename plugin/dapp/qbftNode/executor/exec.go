@@ -5,10 +5,15 @@
 package executor
 
 import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 
+	"github.com/33cn/chain33/common/crypto"
 	dbm "github.com/33cn/chain33/common/db"
 	"github.com/33cn/chain33/types"
+	ttypes "github.com/33cn/plugin/plugin/consensus/qbft/types"
 	pty "github.com/33cn/plugin/plugin/dapp/qbftNode/types"
 )
 
@@ -32,9 +37,112 @@ func (val *QbftNode) Exec_Node(node *pty.QbftNode, tx *types.Transaction, index
 // Exec_BlockInfo method
 func (val *QbftNode) Exec_BlockInfo(blockInfo *pty.QbftBlockInfo, tx *types.Transaction, index int) (*types.Receipt, error) {
 	receipt := &types.Receipt{Ty: types.ExecOk, KV: nil, Logs: nil}
+	// 执行时重新验证证据的签名, 处罚记录写入状态数据库, 共识模块根据回执日志移除双签的验证者
+	chainID := blockInfo.GetBlock().GetHeader().GetChainID()
+	slashed := &pty.QbftEvidenceList{}
+	slashHeight := make(map[string]int64)
+	for _, evidence := range blockInfo.GetBlock().GetEvidence() {
+		if err := val.checkEvidence(chainID, evidence); err != nil {
+			clog.Error("Exec_BlockInfo invalid evidence", "height", val.GetHeight(), "err", err)
+			return nil, err
+		}
+		pubKey := evidence.GetPubKey()
+		last, ok := slashHeight[pubKey]
+		if !ok {
+			last = getSlashHeight(pubKey, val.GetStateDB())
+		}
+		// 上次处罚时验证者已经被移除, 之前的双签不再重复处罚
+		if evidence.GetVoteA().GetHeight() <= last {
+			clog.Info("Exec_BlockInfo validator already slashed", "pubkey", pubKey, "slashHeight", last)
+			continue
+		}
+		slashHeight[pubKey] = val.GetHeight()
+		receipt.KV = append(receipt.KV, &types.KeyValue{
+			Key:   calcSlashHeightKey(pubKey),
+			Value: types.Encode(&types.Int64{Data: val.GetHeight()}),
+		})
+		slashed.Evidence = append(slashed.Evidence, evidence)
+	}
+	if len(slashed.Evidence) > 0 {
+		receipt.Logs = append(receipt.Logs, &types.ReceiptLog{Ty: pty.TyLogQbftNodeSlash, Log: types.Encode(slashed)})
+	}
 	return receipt, nil
 }
 
+// checkEvidence 两次投票必须来自同一个验证者, 高度, 轮次和类型相同, 但是投给不同的区块, 并且都是该验证者的有效签名
+func (val *QbftNode) checkEvidence(chainID string, evidence *pty.QbftDuplicateVoteEvidence) error {
+	voteA, voteB := evidence.GetVoteA(), evidence.GetVoteB()
+	if voteA == nil || voteB == nil {
+		return errors.New("evidence vote is nil")
+	}
+	if voteA.Height != voteB.Height || voteA.Round != voteB.Round || voteA.Type != voteB.Type {
+		return errors.New("evidence votes have different height, round or type")
+	}
+	if bytes.Equal(voteA.GetBlockID().GetHash(), voteB.GetBlockID().GetHash()) {
+		return errors.New("evidence votes for the same block")
+	}
+	if !bytes.Equal(voteA.ValidatorAddress, voteB.ValidatorAddress) {
+		return errors.New("evidence votes from different validators")
+	}
+	cr, err := val.loadCrypto()
+	if err != nil {
+		return err
+	}
+	pubKeyBytes, err := hex.DecodeString(evidence.GetPubKey())
+	if err != nil {
+		return err
+	}
+	pubKey, err := cr.PubKeyFromBytes(pubKeyBytes)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(ttypes.GenAddressByPubKey(pubKey), voteA.ValidatorAddress) {
+		return errors.New("evidence pubkey not match validator address")
+	}
+	for _, vote := range []*pty.QbftVote{voteA, voteB} {
+		sig, err := cr.SignatureFromBytes(vote.Signature)
+		if err != nil {
+			return err
+		}
+		if !pubKey.VerifyBytes(ttypes.SignBytes(chainID, &ttypes.Vote{QbftVote: vote}), sig) {
+			return errors.New("evidence vote signature is invalid")
+		}
+	}
+	return nil
+}
+
+// loadCrypto 按照qbft共识配置的签名类型加载验证投票签名的算法
+func (val *QbftNode) loadCrypto() (crypto.Crypto, error) {
+	subcfg := struct {
+		SignName string `json:"signName"`
+	}{SignName: "ed25519"}
+	sub := val.GetAPI().GetConfig().GetSubConfig().Consensus["qbft"]
+	if len(sub) > 0 {
+		if err := json.Unmarshal(sub, &subcfg); err != nil {
+			return nil, err
+		}
+	}
+	signType, ok := ttypes.SignMap[subcfg.SignName]
+	if !ok {
+		return nil, errors.New("invalid qbft sign name")
+	}
+	return crypto.Load(types.GetSignName("", signType), -1)
+}
+
+func getSlashHeight(pubKey string, db dbm.KV) int64 {
+	value, err := db.Get(calcSlashHeightKey(pubKey))
+	if err != nil {
+		return -1
+	}
+	var height types.Int64
+	err = types.Decode(value, &height)
+	if err != nil {
+		clog.Error("getSlashHeight decode fail", "err", err)
+		return -1
+	}
+	return height.Data
+}
+
 func getConfigKey(key string, db dbm.KV) ([]byte, error) {
 	configKey := types.ConfigKey(key)
 	value, err := db.Get([]byte(configKey))
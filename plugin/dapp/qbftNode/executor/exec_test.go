@@ -0,0 +1,78 @@
+package executor
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/33cn/chain33/client/mocks"
+	"github.com/33cn/chain33/common/crypto"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	ttypes "github.com/33cn/plugin/plugin/consensus/qbft/types"
+	pty "github.com/33cn/plugin/plugin/dapp/qbftNode/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecBlockInfoEvidence(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	api := new(mocks.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg, nil)
+	stateDB, err := dbm.NewGoMemDB("state", "", 0)
+	require.Nil(t, err)
+	exec := newQbftNode().(*QbftNode)
+	exec.SetAPI(api)
+	exec.SetStateDB(stateDB)
+	exec.SetEnv(10, 1539918074, 1)
+
+	cr, err := crypto.Load(types.GetSignName("", ttypes.SignMap["ed25519"]), -1)
+	require.Nil(t, err)
+	priv, err := cr.GenKey()
+	require.Nil(t, err)
+	pubKey := hex.EncodeToString(priv.PubKey().Bytes())
+	chainID := "chain33-qbft-test"
+	signVote := func(height int64, hash []byte) *pty.QbftVote {
+		vote := &ttypes.Vote{QbftVote: &pty.QbftVote{
+			ValidatorAddress: ttypes.GenAddressByPubKey(priv.PubKey()),
+			Height:           height,
+			Type:             uint32(ttypes.VoteTypePrevote),
+			BlockID:          &pty.QbftBlockID{Hash: hash},
+		}}
+		vote.Signature = priv.Sign(ttypes.SignBytes(chainID, vote)).Bytes()
+		return vote.QbftVote
+	}
+	blockInfo := func(evidence ...*pty.QbftDuplicateVoteEvidence) *pty.QbftBlockInfo {
+		return &pty.QbftBlockInfo{Block: &pty.QbftBlock{Header: &pty.QbftBlockHeader{ChainID: chainID}, Evidence: evidence}}
+	}
+
+	// 签名无效的证据
+	voteB := signVote(9, []byte("block2"))
+	voteB.Signature = signVote(8, []byte("block2")).Signature
+	evidence := &pty.QbftDuplicateVoteEvidence{PubKey: pubKey, VoteA: signVote(9, []byte("block1")), VoteB: voteB}
+	_, err = exec.Exec_BlockInfo(blockInfo(evidence), nil, 0)
+	assert.NotNil(t, err)
+
+	// 其他链的签名
+	evidence = &pty.QbftDuplicateVoteEvidence{PubKey: pubKey, VoteA: signVote(9, []byte("block1")), VoteB: signVote(9, []byte("block2"))}
+	info := blockInfo(evidence)
+	info.Block.Header.ChainID = "other-chain"
+	_, err = exec.Exec_BlockInfo(info, nil, 0)
+	assert.NotNil(t, err)
+
+	// 有效证据记录处罚高度, 回执日志中包含被处罚的验证者
+	receipt, err := exec.Exec_BlockInfo(blockInfo(evidence), nil, 0)
+	require.Nil(t, err)
+	require.Len(t, receipt.Logs, 1)
+	assert.Equal(t, int32(pty.TyLogQbftNodeSlash), receipt.Logs[0].Ty)
+	var slashed pty.QbftEvidenceList
+	require.Nil(t, types.Decode(receipt.Logs[0].Log, &slashed))
+	assert.Equal(t, pubKey, slashed.Evidence[0].PubKey)
+	require.Len(t, receipt.KV, 1)
+	require.Nil(t, stateDB.Set(receipt.KV[0].Key, receipt.KV[0].Value))
+
+	// 已经处罚过的双签不再重复处罚
+	receipt, err = exec.Exec_BlockInfo(blockInfo(evidence), nil, 0)
+	require.Nil(t, err)
+	assert.Len(t, receipt.Logs, 0)
+}
@@ -66,6 +66,11 @@ func CalcQbftNodeUpdateHeightKey(height int64) []byte {
 	return []byte(fmt.Sprintf("LODB-qbftNode-Update:%18d:", height))
 }
 
+// calcSlashHeightKey 记录验证者最近一次被处罚的高度
+func calcSlashHeightKey(pubKey string) []byte {
+	return []byte(fmt.Sprintf("mavl-qbftNode-slash-%s", pubKey))
+}
+
 // CalcQbftNodeBlockInfoHeightKey method
 func CalcQbftNodeBlockInfoHeightKey(height int64) []byte {
 	return []byte(fmt.Sprintf("LODB-qbftNode-BlockInfo:%18d:", height))
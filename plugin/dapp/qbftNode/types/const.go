@@ -17,3 +17,8 @@ const (
 const (
 	ActionNodeUpdate = "NodeUpdate"
 )
+
+// qbftNode log
+const (
+	TyLogQbftNodeSlash = 1101
+)
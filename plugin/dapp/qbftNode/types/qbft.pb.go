@@ -965,6 +965,7 @@ type QbftBlockHeader struct {
 	ProposerAddr    []byte       `protobuf:"bytes,13,opt,name=proposerAddr,proto3" json:"proposerAddr,omitempty"`
 	Sequence        int64        `protobuf:"varint,14,opt,name=sequence,proto3" json:"sequence,omitempty"`
 	LastSequence    int64        `protobuf:"varint,15,opt,name=lastSequence,proto3" json:"lastSequence,omitempty"`
+	EvidenceHash    []byte       `protobuf:"bytes,16,opt,name=evidenceHash,proto3" json:"evidenceHash,omitempty"`
 }
 
 func (x *QbftBlockHeader) Reset() {
@@ -1104,14 +1105,22 @@ func (x *QbftBlockHeader) GetLastSequence() int64 {
 	return 0
 }
 
+func (x *QbftBlockHeader) GetEvidenceHash() []byte {
+	if x != nil {
+		return x.EvidenceHash
+	}
+	return nil
+}
+
 type QbftBlock struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Header     *QbftBlockHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	Data       *types.Block     `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
-	LastCommit *QbftCommit      `protobuf:"bytes,4,opt,name=lastCommit,proto3" json:"lastCommit,omitempty"`
+	Header     *QbftBlockHeader             `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Data       *types.Block                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	LastCommit *QbftCommit                  `protobuf:"bytes,4,opt,name=lastCommit,proto3" json:"lastCommit,omitempty"`
+	Evidence   []*QbftDuplicateVoteEvidence `protobuf:"bytes,5,rep,name=evidence,proto3" json:"evidence,omitempty"`
 }
 
 func (x *QbftBlock) Reset() {
@@ -1167,6 +1176,124 @@ func (x *QbftBlock) GetLastCommit() *QbftCommit {
 	return nil
 }
 
+func (x *QbftBlock) GetEvidence() []*QbftDuplicateVoteEvidence {
+	if x != nil {
+		return x.Evidence
+	}
+	return nil
+}
+
+// 同一个验证者在相同高度, 轮次和阶段对不同区块的两次投票
+type QbftDuplicateVoteEvidence struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PubKey string    `protobuf:"bytes,1,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+	VoteA  *QbftVote `protobuf:"bytes,2,opt,name=voteA,proto3" json:"voteA,omitempty"`
+	VoteB  *QbftVote `protobuf:"bytes,3,opt,name=voteB,proto3" json:"voteB,omitempty"`
+}
+
+func (x *QbftDuplicateVoteEvidence) Reset() {
+	*x = QbftDuplicateVoteEvidence{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_qbft_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QbftDuplicateVoteEvidence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QbftDuplicateVoteEvidence) ProtoMessage() {}
+
+func (x *QbftDuplicateVoteEvidence) ProtoReflect() protoreflect.Message {
+	mi := &file_qbft_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QbftDuplicateVoteEvidence.ProtoReflect.Descriptor instead.
+func (*QbftDuplicateVoteEvidence) Descriptor() ([]byte, []int) {
+	return file_qbft_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *QbftDuplicateVoteEvidence) GetPubKey() string {
+	if x != nil {
+		return x.PubKey
+	}
+	return ""
+}
+
+func (x *QbftDuplicateVoteEvidence) GetVoteA() *QbftVote {
+	if x != nil {
+		return x.VoteA
+	}
+	return nil
+}
+
+func (x *QbftDuplicateVoteEvidence) GetVoteB() *QbftVote {
+	if x != nil {
+		return x.VoteB
+	}
+	return nil
+}
+
+type QbftEvidenceList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Evidence []*QbftDuplicateVoteEvidence `protobuf:"bytes,1,rep,name=evidence,proto3" json:"evidence,omitempty"`
+}
+
+func (x *QbftEvidenceList) Reset() {
+	*x = QbftEvidenceList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_qbft_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QbftEvidenceList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QbftEvidenceList) ProtoMessage() {}
+
+func (x *QbftEvidenceList) ProtoReflect() protoreflect.Message {
+	mi := &file_qbft_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QbftEvidenceList.ProtoReflect.Descriptor instead.
+func (*QbftEvidenceList) Descriptor() ([]byte, []int) {
+	return file_qbft_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *QbftEvidenceList) GetEvidence() []*QbftDuplicateVoteEvidence {
+	if x != nil {
+		return x.Evidence
+	}
+	return nil
+}
+
 type QbftProposal struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1185,7 +1312,7 @@ type QbftProposal struct {
 func (x *QbftProposal) Reset() {
 	*x = QbftProposal{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_qbft_proto_msgTypes[15]
+		mi := &file_qbft_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1198,7 +1325,7 @@ func (x *QbftProposal) String() string {
 func (*QbftProposal) ProtoMessage() {}
 
 func (x *QbftProposal) ProtoReflect() protoreflect.Message {
-	mi := &file_qbft_proto_msgTypes[15]
+	mi := &file_qbft_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1211,7 +1338,7 @@ func (x *QbftProposal) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QbftProposal.ProtoReflect.Descriptor instead.
 func (*QbftProposal) Descriptor() ([]byte, []int) {
-	return file_qbft_proto_rawDescGZIP(), []int{15}
+	return file_qbft_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *QbftProposal) GetHeight() int64 {
@@ -1285,7 +1412,7 @@ type QbftNewRoundStepMsg struct {
 func (x *QbftNewRoundStepMsg) Reset() {
 	*x = QbftNewRoundStepMsg{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_qbft_proto_msgTypes[16]
+		mi := &file_qbft_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1298,7 +1425,7 @@ func (x *QbftNewRoundStepMsg) String() string {
 func (*QbftNewRoundStepMsg) ProtoMessage() {}
 
 func (x *QbftNewRoundStepMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_qbft_proto_msgTypes[16]
+	mi := &file_qbft_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1311,7 +1438,7 @@ func (x *QbftNewRoundStepMsg) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QbftNewRoundStepMsg.ProtoReflect.Descriptor instead.
 func (*QbftNewRoundStepMsg) Descriptor() ([]byte, []int) {
-	return file_qbft_proto_rawDescGZIP(), []int{16}
+	return file_qbft_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *QbftNewRoundStepMsg) GetHeight() int64 {
@@ -1363,7 +1490,7 @@ type QbftValidBlockMsg struct {
 func (x *QbftValidBlockMsg) Reset() {
 	*x = QbftValidBlockMsg{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_qbft_proto_msgTypes[17]
+		mi := &file_qbft_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1376,7 +1503,7 @@ func (x *QbftValidBlockMsg) String() string {
 func (*QbftValidBlockMsg) ProtoMessage() {}
 
 func (x *QbftValidBlockMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_qbft_proto_msgTypes[17]
+	mi := &file_qbft_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1389,7 +1516,7 @@ func (x *QbftValidBlockMsg) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QbftValidBlockMsg.ProtoReflect.Descriptor instead.
 func (*QbftValidBlockMsg) Descriptor() ([]byte, []int) {
-	return file_qbft_proto_rawDescGZIP(), []int{17}
+	return file_qbft_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *QbftValidBlockMsg) GetHeight() int64 {
@@ -1433,7 +1560,7 @@ type QbftProposalPOLMsg struct {
 func (x *QbftProposalPOLMsg) Reset() {
 	*x = QbftProposalPOLMsg{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_qbft_proto_msgTypes[18]
+		mi := &file_qbft_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1446,7 +1573,7 @@ func (x *QbftProposalPOLMsg) String() string {
 func (*QbftProposalPOLMsg) ProtoMessage() {}
 
 func (x *QbftProposalPOLMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_qbft_proto_msgTypes[18]
+	mi := &file_qbft_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1459,7 +1586,7 @@ func (x *QbftProposalPOLMsg) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QbftProposalPOLMsg.ProtoReflect.Descriptor instead.
 func (*QbftProposalPOLMsg) Descriptor() ([]byte, []int) {
-	return file_qbft_proto_rawDescGZIP(), []int{18}
+	return file_qbft_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *QbftProposalPOLMsg) GetHeight() int64 {
@@ -1497,7 +1624,7 @@ type QbftHasVoteMsg struct {
 func (x *QbftHasVoteMsg) Reset() {
 	*x = QbftHasVoteMsg{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_qbft_proto_msgTypes[19]
+		mi := &file_qbft_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1510,7 +1637,7 @@ func (x *QbftHasVoteMsg) String() string {
 func (*QbftHasVoteMsg) ProtoMessage() {}
 
 func (x *QbftHasVoteMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_qbft_proto_msgTypes[19]
+	mi := &file_qbft_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1523,7 +1650,7 @@ func (x *QbftHasVoteMsg) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QbftHasVoteMsg.ProtoReflect.Descriptor instead.
 func (*QbftHasVoteMsg) Descriptor() ([]byte, []int) {
-	return file_qbft_proto_rawDescGZIP(), []int{19}
+	return file_qbft_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *QbftHasVoteMsg) GetHeight() int64 {
@@ -1568,7 +1695,7 @@ type QbftVoteSetMaj23Msg struct {
 func (x *QbftVoteSetMaj23Msg) Reset() {
 	*x = QbftVoteSetMaj23Msg{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_qbft_proto_msgTypes[20]
+		mi := &file_qbft_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1581,7 +1708,7 @@ func (x *QbftVoteSetMaj23Msg) String() string {
 func (*QbftVoteSetMaj23Msg) ProtoMessage() {}
 
 func (x *QbftVoteSetMaj23Msg) ProtoReflect() protoreflect.Message {
-	mi := &file_qbft_proto_msgTypes[20]
+	mi := &file_qbft_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1594,7 +1721,7 @@ func (x *QbftVoteSetMaj23Msg) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QbftVoteSetMaj23Msg.ProtoReflect.Descriptor instead.
 func (*QbftVoteSetMaj23Msg) Descriptor() ([]byte, []int) {
-	return file_qbft_proto_rawDescGZIP(), []int{20}
+	return file_qbft_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *QbftVoteSetMaj23Msg) GetHeight() int64 {
@@ -1640,7 +1767,7 @@ type QbftVoteSetBitsMsg struct {
 func (x *QbftVoteSetBitsMsg) Reset() {
 	*x = QbftVoteSetBitsMsg{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_qbft_proto_msgTypes[21]
+		mi := &file_qbft_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1653,7 +1780,7 @@ func (x *QbftVoteSetBitsMsg) String() string {
 func (*QbftVoteSetBitsMsg) ProtoMessage() {}
 
 func (x *QbftVoteSetBitsMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_qbft_proto_msgTypes[21]
+	mi := &file_qbft_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1666,7 +1793,7 @@ func (x *QbftVoteSetBitsMsg) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QbftVoteSetBitsMsg.ProtoReflect.Descriptor instead.
 func (*QbftVoteSetBitsMsg) Descriptor() ([]byte, []int) {
-	return file_qbft_proto_rawDescGZIP(), []int{21}
+	return file_qbft_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *QbftVoteSetBitsMsg) GetHeight() int64 {
@@ -1720,7 +1847,7 @@ type QbftHeartbeat struct {
 func (x *QbftHeartbeat) Reset() {
 	*x = QbftHeartbeat{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_qbft_proto_msgTypes[22]
+		mi := &file_qbft_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1733,7 +1860,7 @@ func (x *QbftHeartbeat) String() string {
 func (*QbftHeartbeat) ProtoMessage() {}
 
 func (x *QbftHeartbeat) ProtoReflect() protoreflect.Message {
-	mi := &file_qbft_proto_msgTypes[22]
+	mi := &file_qbft_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1746,7 +1873,7 @@ func (x *QbftHeartbeat) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QbftHeartbeat.ProtoReflect.Descriptor instead.
 func (*QbftHeartbeat) Descriptor() ([]byte, []int) {
-	return file_qbft_proto_rawDescGZIP(), []int{22}
+	return file_qbft_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *QbftHeartbeat) GetValidatorAddress() []byte {
@@ -1802,7 +1929,7 @@ type QbftIsHealthy struct {
 func (x *QbftIsHealthy) Reset() {
 	*x = QbftIsHealthy{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_qbft_proto_msgTypes[23]
+		mi := &file_qbft_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1815,7 +1942,7 @@ func (x *QbftIsHealthy) String() string {
 func (*QbftIsHealthy) ProtoMessage() {}
 
 func (x *QbftIsHealthy) ProtoReflect() protoreflect.Message {
-	mi := &file_qbft_proto_msgTypes[23]
+	mi := &file_qbft_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1828,7 +1955,7 @@ func (x *QbftIsHealthy) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QbftIsHealthy.ProtoReflect.Descriptor instead.
 func (*QbftIsHealthy) Descriptor() ([]byte, []int) {
-	return file_qbft_proto_rawDescGZIP(), []int{23}
+	return file_qbft_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *QbftIsHealthy) GetIsHealthy() bool {
@@ -1856,7 +1983,7 @@ type QbftAggVote struct {
 func (x *QbftAggVote) Reset() {
 	*x = QbftAggVote{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_qbft_proto_msgTypes[24]
+		mi := &file_qbft_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1869,7 +1996,7 @@ func (x *QbftAggVote) String() string {
 func (*QbftAggVote) ProtoMessage() {}
 
 func (x *QbftAggVote) ProtoReflect() protoreflect.Message {
-	mi := &file_qbft_proto_msgTypes[24]
+	mi := &file_qbft_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1882,7 +2009,7 @@ func (x *QbftAggVote) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QbftAggVote.ProtoReflect.Descriptor instead.
 func (*QbftAggVote) Descriptor() ([]byte, []int) {
-	return file_qbft_proto_rawDescGZIP(), []int{24}
+	return file_qbft_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *QbftAggVote) GetValidatorAddress() []byte {
@@ -2087,7 +2214,7 @@ var file_qbft_proto_rawDesc = []byte{
 	0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x0f,
 	0x6c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18,
 	0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
-	0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0xf5, 0x03, 0x0a, 0x0f, 0x51, 0x62, 0x66, 0x74, 0x42,
+	0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0x99, 0x04, 0x0a, 0x0f, 0x51, 0x62, 0x66, 0x74, 0x42,
 	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68,
 	0x61, 0x69, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61,
 	0x69, 0x6e, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02,
@@ -2118,123 +2245,143 @@ var file_qbft_proto_rawDesc = []byte{
 	0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03,
 	0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x61,
 	0x73, 0x74, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x90,
-	0x01, 0x0a, 0x09, 0x51, 0x62, 0x66, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x2e, 0x0a, 0x06,
-	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x62, 0x66, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x31,
-	0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x62, 0x66, 0x74, 0x43,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
-	0x74, 0x22, 0x82, 0x02, 0x0a, 0x0c, 0x51, 0x62, 0x66, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
-	0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f,
-	0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64,
-	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1a,
-	0x0a, 0x08, 0x50, 0x4f, 0x4c, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x08, 0x50, 0x4f, 0x4c, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x32, 0x0a, 0x0a, 0x50, 0x4f,
-	0x4c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x62, 0x66, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x49, 0x44, 0x52, 0x0a, 0x50, 0x4f, 0x4c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44, 0x12, 0x1c,
-	0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1c, 0x0a, 0x09,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x68, 0x61, 0x73, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x68, 0x61, 0x73, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65,
-	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65,
-	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x22, 0xb7, 0x01, 0x0a, 0x13, 0x51, 0x62, 0x66, 0x74, 0x4e,
-	0x65, 0x77, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x74, 0x65, 0x70, 0x4d, 0x73, 0x67, 0x12, 0x16,
-	0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
-	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04,
-	0x73, 0x74, 0x65, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70,
-	0x12, 0x34, 0x0a, 0x15, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x53, 0x69, 0x6e, 0x63, 0x65,
-	0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x15, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61,
-	0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x6f,
-	0x6d, 0x6d, 0x69, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x0f, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64,
-	0x22, 0x7b, 0x0a, 0x11, 0x51, 0x62, 0x66, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x4d, 0x73, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a,
-	0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f,
-	0x75, 0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x68, 0x61, 0x73, 0x68,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x68, 0x61, 0x73,
-	0x68, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22, 0x8f, 0x01,
-	0x0a, 0x12, 0x51, 0x62, 0x66, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x50, 0x4f,
-	0x4c, 0x4d, 0x73, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2a, 0x0a, 0x10,
+	0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x22,
+	0x0a, 0x0c, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x48, 0x61, 0x73, 0x68, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x48, 0x61,
+	0x73, 0x68, 0x22, 0xce, 0x01, 0x0a, 0x09, 0x51, 0x62, 0x66, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x12, 0x2e, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x62, 0x66, 0x74, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x12, 0x20, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x31, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51,
+	0x62, 0x66, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x3c, 0x0a, 0x08, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63,
+	0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x51, 0x62, 0x66, 0x74, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x74,
+	0x65, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x65, 0x76, 0x69, 0x64, 0x65,
+	0x6e, 0x63, 0x65, 0x22, 0x81, 0x01, 0x0a, 0x19, 0x51, 0x62, 0x66, 0x74, 0x44, 0x75, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x25, 0x0a, 0x05, 0x76, 0x6f, 0x74,
+	0x65, 0x41, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x51, 0x62, 0x66, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x41,
+	0x12, 0x25, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x42, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x62, 0x66, 0x74, 0x56, 0x6f, 0x74, 0x65,
+	0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x42, 0x22, 0x50, 0x0a, 0x10, 0x51, 0x62, 0x66, 0x74, 0x45,
+	0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x08, 0x65,
+	0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x62, 0x66, 0x74, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x52,
+	0x08, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x82, 0x02, 0x0a, 0x0c, 0x51, 0x62,
+	0x66, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x4f, 0x4c, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x50, 0x4f, 0x4c, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x12, 0x32, 0x0a, 0x0a, 0x50, 0x4f, 0x4c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51,
+	0x62, 0x66, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44, 0x52, 0x0a, 0x50, 0x4f, 0x4c, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x68, 0x61,
+	0x73, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x22, 0xb7,
+	0x01, 0x0a, 0x13, 0x51, 0x62, 0x66, 0x74, 0x4e, 0x65, 0x77, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53,
+	0x74, 0x65, 0x70, 0x4d, 0x73, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x65, 0x70, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70, 0x12, 0x34, 0x0a, 0x15, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x15, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x53, 0x69, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x28,
+	0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0x7b, 0x0a, 0x11, 0x51, 0x62, 0x66, 0x74,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x73, 0x67, 0x12, 0x16, 0x0a,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x68, 0x61, 0x73, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22, 0x8f, 0x01, 0x0a, 0x12, 0x51, 0x62, 0x66, 0x74, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x50, 0x4f, 0x4c, 0x4d, 0x73, 0x67, 0x12, 0x16, 0x0a, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x2a, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
+	0x50, 0x4f, 0x4c, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10,
 	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x50, 0x4f, 0x4c, 0x52, 0x6f, 0x75, 0x6e, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
-	0x50, 0x4f, 0x4c, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x35, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70,
-	0x6f, 0x73, 0x61, 0x6c, 0x50, 0x4f, 0x4c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x62, 0x66, 0x74, 0x42, 0x69, 0x74, 0x41, 0x72, 0x72,
-	0x61, 0x79, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x50, 0x4f, 0x4c, 0x22,
-	0x68, 0x0a, 0x0e, 0x51, 0x62, 0x66, 0x74, 0x48, 0x61, 0x73, 0x56, 0x6f, 0x74, 0x65, 0x4d, 0x73,
-	0x67, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x12, 0x35, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x50, 0x4f, 0x4c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x62,
+	0x66, 0x74, 0x42, 0x69, 0x74, 0x41, 0x72, 0x72, 0x61, 0x79, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x61, 0x6c, 0x50, 0x4f, 0x4c, 0x22, 0x68, 0x0a, 0x0e, 0x51, 0x62, 0x66, 0x74, 0x48,
+	0x61, 0x73, 0x56, 0x6f, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x22, 0x85, 0x01, 0x0a, 0x13, 0x51, 0x62, 0x66, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x53, 0x65,
+	0x74, 0x4d, 0x61, 0x6a, 0x32, 0x33, 0x4d, 0x73, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x62, 0x66, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44,
+	0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44, 0x22, 0xaf, 0x01, 0x0a, 0x12, 0x51, 0x62,
+	0x66, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x53, 0x65, 0x74, 0x42, 0x69, 0x74, 0x73, 0x4d, 0x73, 0x67,
+	0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x62, 0x66, 0x74,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44,
+	0x12, 0x29, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x62, 0x66, 0x74, 0x42, 0x69, 0x74, 0x41,
+	0x72, 0x72, 0x61, 0x79, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x22, 0xcb, 0x01, 0x0a, 0x0d,
+	0x51, 0x62, 0x66, 0x74, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x12, 0x2a, 0x0a,
+	0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
 	0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75,
-	0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12,
-	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x85, 0x01, 0x0a, 0x13, 0x51, 0x62,
-	0x66, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x53, 0x65, 0x74, 0x4d, 0x61, 0x6a, 0x32, 0x33, 0x4d, 0x73,
-	0x67, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x2d, 0x0a, 0x0d, 0x51, 0x62, 0x66,
+	0x74, 0x49, 0x73, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69,
+	0x73, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x22, 0xa2, 0x02, 0x0a, 0x0b, 0x51, 0x62, 0x66,
+	0x74, 0x41, 0x67, 0x67, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x3b, 0x0a, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x41, 0x72, 0x72, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x62, 0x66, 0x74, 0x42, 0x69, 0x74, 0x41, 0x72, 0x72, 0x61,
+	0x79, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x72, 0x72, 0x61,
+	0x79, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
 	0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75,
-	0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12,
-	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x62, 0x66,
-	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49,
-	0x44, 0x22, 0xaf, 0x01, 0x0a, 0x12, 0x51, 0x62, 0x66, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x53, 0x65,
-	0x74, 0x42, 0x69, 0x74, 0x73, 0x4d, 0x73, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x51, 0x62, 0x66, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44, 0x52,
-	0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44, 0x12, 0x29, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65,
-	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x51, 0x62, 0x66, 0x74, 0x42, 0x69, 0x74, 0x41, 0x72, 0x72, 0x61, 0x79, 0x52, 0x05, 0x76, 0x6f,
-	0x74, 0x65, 0x73, 0x22, 0xcb, 0x01, 0x0a, 0x0d, 0x51, 0x62, 0x66, 0x74, 0x48, 0x65, 0x61, 0x72,
-	0x74, 0x62, 0x65, 0x61, 0x74, 0x12, 0x2a, 0x0a, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x12, 0x26, 0x0a, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e,
-	0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65,
-	0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65,
-	0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
-	0x65, 0x22, 0x2d, 0x0a, 0x0d, 0x51, 0x62, 0x66, 0x74, 0x49, 0x73, 0x48, 0x65, 0x61, 0x6c, 0x74,
-	0x68, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79,
-	0x22, 0xa2, 0x02, 0x0a, 0x0b, 0x51, 0x62, 0x66, 0x74, 0x41, 0x67, 0x67, 0x56, 0x6f, 0x74, 0x65,
-	0x12, 0x2a, 0x0a, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3b, 0x0a, 0x0e,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x72, 0x72, 0x61, 0x79, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x62, 0x66,
-	0x74, 0x42, 0x69, 0x74, 0x41, 0x72, 0x72, 0x61, 0x79, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x41, 0x72, 0x72, 0x61, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x49, 0x44, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x51, 0x62, 0x66, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44, 0x52, 0x07,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
-	0x61, 0x74, 0x75, 0x72, 0x65, 0x42, 0x0a, 0x5a, 0x08, 0x2e, 0x2e, 0x2f, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x2c, 0x0a, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x62, 0x66, 0x74, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x42, 0x0a, 0x5a,
+	0x08, 0x2e, 0x2e, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -2249,43 +2396,45 @@ func file_qbft_proto_rawDescGZIP() []byte {
 	return file_qbft_proto_rawDescData
 }
 
-var file_qbft_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_qbft_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
 var file_qbft_proto_goTypes = []interface{}{
-	(*QbftBlockID)(nil),         // 0: types.QbftBlockID
-	(*QbftBitArray)(nil),        // 1: types.QbftBitArray
-	(*QbftVote)(nil),            // 2: types.QbftVote
-	(*QbftCommit)(nil),          // 3: types.QbftCommit
-	(*QbftBlockInfo)(nil),       // 4: types.QbftBlockInfo
-	(*QbftBlockSize)(nil),       // 5: types.QbftBlockSize
-	(*QbftTxSize)(nil),          // 6: types.QbftTxSize
-	(*QbftBlockGossip)(nil),     // 7: types.QbftBlockGossip
-	(*QbftEvidenceParams)(nil),  // 8: types.QbftEvidenceParams
-	(*QbftConsensusParams)(nil), // 9: types.QbftConsensusParams
-	(*QbftValidator)(nil),       // 10: types.QbftValidator
-	(*QbftValidatorSet)(nil),    // 11: types.QbftValidatorSet
-	(*QbftState)(nil),           // 12: types.QbftState
-	(*QbftBlockHeader)(nil),     // 13: types.QbftBlockHeader
-	(*QbftBlock)(nil),           // 14: types.QbftBlock
-	(*QbftProposal)(nil),        // 15: types.QbftProposal
-	(*QbftNewRoundStepMsg)(nil), // 16: types.QbftNewRoundStepMsg
-	(*QbftValidBlockMsg)(nil),   // 17: types.QbftValidBlockMsg
-	(*QbftProposalPOLMsg)(nil),  // 18: types.QbftProposalPOLMsg
-	(*QbftHasVoteMsg)(nil),      // 19: types.QbftHasVoteMsg
-	(*QbftVoteSetMaj23Msg)(nil), // 20: types.QbftVoteSetMaj23Msg
-	(*QbftVoteSetBitsMsg)(nil),  // 21: types.QbftVoteSetBitsMsg
-	(*QbftHeartbeat)(nil),       // 22: types.QbftHeartbeat
-	(*QbftIsHealthy)(nil),       // 23: types.QbftIsHealthy
-	(*QbftAggVote)(nil),         // 24: types.QbftAggVote
-	(*types.Block)(nil),         // 25: types.Block
+	(*QbftBlockID)(nil),               // 0: types.QbftBlockID
+	(*QbftBitArray)(nil),              // 1: types.QbftBitArray
+	(*QbftVote)(nil),                  // 2: types.QbftVote
+	(*QbftCommit)(nil),                // 3: types.QbftCommit
+	(*QbftBlockInfo)(nil),             // 4: types.QbftBlockInfo
+	(*QbftBlockSize)(nil),             // 5: types.QbftBlockSize
+	(*QbftTxSize)(nil),                // 6: types.QbftTxSize
+	(*QbftBlockGossip)(nil),           // 7: types.QbftBlockGossip
+	(*QbftEvidenceParams)(nil),        // 8: types.QbftEvidenceParams
+	(*QbftConsensusParams)(nil),       // 9: types.QbftConsensusParams
+	(*QbftValidator)(nil),             // 10: types.QbftValidator
+	(*QbftValidatorSet)(nil),          // 11: types.QbftValidatorSet
+	(*QbftState)(nil),                 // 12: types.QbftState
+	(*QbftBlockHeader)(nil),           // 13: types.QbftBlockHeader
+	(*QbftBlock)(nil),                 // 14: types.QbftBlock
+	(*QbftDuplicateVoteEvidence)(nil), // 15: types.QbftDuplicateVoteEvidence
+	(*QbftEvidenceList)(nil),          // 16: types.QbftEvidenceList
+	(*QbftProposal)(nil),              // 17: types.QbftProposal
+	(*QbftNewRoundStepMsg)(nil),       // 18: types.QbftNewRoundStepMsg
+	(*QbftValidBlockMsg)(nil),         // 19: types.QbftValidBlockMsg
+	(*QbftProposalPOLMsg)(nil),        // 20: types.QbftProposalPOLMsg
+	(*QbftHasVoteMsg)(nil),            // 21: types.QbftHasVoteMsg
+	(*QbftVoteSetMaj23Msg)(nil),       // 22: types.QbftVoteSetMaj23Msg
+	(*QbftVoteSetBitsMsg)(nil),        // 23: types.QbftVoteSetBitsMsg
+	(*QbftHeartbeat)(nil),             // 24: types.QbftHeartbeat
+	(*QbftIsHealthy)(nil),             // 25: types.QbftIsHealthy
+	(*QbftAggVote)(nil),               // 26: types.QbftAggVote
+	(*types.Block)(nil),               // 27: types.Block
 }
 var file_qbft_proto_depIdxs = []int32{
 	0,  // 0: types.QbftVote.blockID:type_name -> types.QbftBlockID
 	0,  // 1: types.QbftCommit.blockID:type_name -> types.QbftBlockID
 	2,  // 2: types.QbftCommit.prevotes:type_name -> types.QbftVote
 	2,  // 3: types.QbftCommit.precommits:type_name -> types.QbftVote
-	24, // 4: types.QbftCommit.aggVote:type_name -> types.QbftAggVote
+	26, // 4: types.QbftCommit.aggVote:type_name -> types.QbftAggVote
 	12, // 5: types.QbftBlockInfo.state:type_name -> types.QbftState
-	15, // 6: types.QbftBlockInfo.proposal:type_name -> types.QbftProposal
+	17, // 6: types.QbftBlockInfo.proposal:type_name -> types.QbftProposal
 	14, // 7: types.QbftBlockInfo.block:type_name -> types.QbftBlock
 	5,  // 8: types.QbftConsensusParams.blockSize:type_name -> types.QbftBlockSize
 	6,  // 9: types.QbftConsensusParams.txSize:type_name -> types.QbftTxSize
@@ -2299,20 +2448,24 @@ var file_qbft_proto_depIdxs = []int32{
 	9,  // 17: types.QbftState.consensusParams:type_name -> types.QbftConsensusParams
 	0,  // 18: types.QbftBlockHeader.lastBlockID:type_name -> types.QbftBlockID
 	13, // 19: types.QbftBlock.header:type_name -> types.QbftBlockHeader
-	25, // 20: types.QbftBlock.data:type_name -> types.Block
+	27, // 20: types.QbftBlock.data:type_name -> types.Block
 	3,  // 21: types.QbftBlock.lastCommit:type_name -> types.QbftCommit
-	0,  // 22: types.QbftProposal.POLBlockID:type_name -> types.QbftBlockID
-	1,  // 23: types.QbftProposalPOLMsg.proposalPOL:type_name -> types.QbftBitArray
-	0,  // 24: types.QbftVoteSetMaj23Msg.blockID:type_name -> types.QbftBlockID
-	0,  // 25: types.QbftVoteSetBitsMsg.blockID:type_name -> types.QbftBlockID
-	1,  // 26: types.QbftVoteSetBitsMsg.votes:type_name -> types.QbftBitArray
-	1,  // 27: types.QbftAggVote.validatorArray:type_name -> types.QbftBitArray
-	0,  // 28: types.QbftAggVote.blockID:type_name -> types.QbftBlockID
-	29, // [29:29] is the sub-list for method output_type
-	29, // [29:29] is the sub-list for method input_type
-	29, // [29:29] is the sub-list for extension type_name
-	29, // [29:29] is the sub-list for extension extendee
-	0,  // [0:29] is the sub-list for field type_name
+	15, // 22: types.QbftBlock.evidence:type_name -> types.QbftDuplicateVoteEvidence
+	2,  // 23: types.QbftDuplicateVoteEvidence.voteA:type_name -> types.QbftVote
+	2,  // 24: types.QbftDuplicateVoteEvidence.voteB:type_name -> types.QbftVote
+	15, // 25: types.QbftEvidenceList.evidence:type_name -> types.QbftDuplicateVoteEvidence
+	0,  // 26: types.QbftProposal.POLBlockID:type_name -> types.QbftBlockID
+	1,  // 27: types.QbftProposalPOLMsg.proposalPOL:type_name -> types.QbftBitArray
+	0,  // 28: types.QbftVoteSetMaj23Msg.blockID:type_name -> types.QbftBlockID
+	0,  // 29: types.QbftVoteSetBitsMsg.blockID:type_name -> types.QbftBlockID
+	1,  // 30: types.QbftVoteSetBitsMsg.votes:type_name -> types.QbftBitArray
+	1,  // 31: types.QbftAggVote.validatorArray:type_name -> types.QbftBitArray
+	0,  // 32: types.QbftAggVote.blockID:type_name -> types.QbftBlockID
+	33, // [33:33] is the sub-list for method output_type
+	33, // [33:33] is the sub-list for method input_type
+	33, // [33:33] is the sub-list for extension type_name
+	33, // [33:33] is the sub-list for extension extendee
+	0,  // [0:33] is the sub-list for field type_name
 }
 
 func init() { file_qbft_proto_init() }
@@ -2502,7 +2655,7 @@ func file_qbft_proto_init() {
 			}
 		}
 		file_qbft_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QbftProposal); i {
+			switch v := v.(*QbftDuplicateVoteEvidence); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2514,7 +2667,7 @@ func file_qbft_proto_init() {
 			}
 		}
 		file_qbft_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QbftNewRoundStepMsg); i {
+			switch v := v.(*QbftEvidenceList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2526,7 +2679,7 @@ func file_qbft_proto_init() {
 			}
 		}
 		file_qbft_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QbftValidBlockMsg); i {
+			switch v := v.(*QbftProposal); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2538,7 +2691,7 @@ func file_qbft_proto_init() {
 			}
 		}
 		file_qbft_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QbftProposalPOLMsg); i {
+			switch v := v.(*QbftNewRoundStepMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2550,7 +2703,7 @@ func file_qbft_proto_init() {
 			}
 		}
 		file_qbft_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QbftHasVoteMsg); i {
+			switch v := v.(*QbftValidBlockMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2562,7 +2715,7 @@ func file_qbft_proto_init() {
 			}
 		}
 		file_qbft_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QbftVoteSetMaj23Msg); i {
+			switch v := v.(*QbftProposalPOLMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2574,7 +2727,7 @@ func file_qbft_proto_init() {
 			}
 		}
 		file_qbft_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QbftVoteSetBitsMsg); i {
+			switch v := v.(*QbftHasVoteMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2586,7 +2739,7 @@ func file_qbft_proto_init() {
 			}
 		}
 		file_qbft_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QbftHeartbeat); i {
+			switch v := v.(*QbftVoteSetMaj23Msg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2598,7 +2751,7 @@ func file_qbft_proto_init() {
 			}
 		}
 		file_qbft_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QbftIsHealthy); i {
+			switch v := v.(*QbftVoteSetBitsMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2610,6 +2763,30 @@ func file_qbft_proto_init() {
 			}
 		}
 		file_qbft_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QbftHeartbeat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_qbft_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QbftIsHealthy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_qbft_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QbftAggVote); i {
 			case 0:
 				return &v.state
@@ -2628,7 +2805,7 @@ func file_qbft_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_qbft_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   25,
+			NumMessages:   27,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -6,6 +6,7 @@ package types
 
 import (
 	"encoding/json"
+	"reflect"
 
 	"github.com/33cn/chain33/common/address"
 	log "github.com/33cn/chain33/common/log/log15"
@@ -63,7 +64,9 @@ func (t *QbftNodeType) GetTypeMap() map[string]int32 {
 
 // GetLogMap method
 func (t *QbftNodeType) GetLogMap() map[int64]*types.LogInfo {
-	return map[int64]*types.LogInfo{}
+	return map[int64]*types.LogInfo{
+		TyLogQbftNodeSlash: {Ty: reflect.TypeOf(QbftEvidenceList{}), Name: "LogQbftNodeSlash"},
+	}
 }
 
 // CreateTx ...
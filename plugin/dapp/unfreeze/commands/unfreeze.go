@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 
 	pt "github.com/33cn/plugin/plugin/dapp/paracross/types"
@@ -48,6 +49,8 @@ func createCmd() *cobra.Command {
 
 	cmd.AddCommand(fixAmountCmd())
 	cmd.AddCommand(leftCmd())
+	cmd.AddCommand(cliffLinearCmd())
+	cmd.AddCommand(customScheduleCmd())
 	return cmd
 }
 
@@ -225,6 +228,129 @@ func left(cmd *cobra.Command, args []string) {
 	ctx.RunWithoutMarshal()
 }
 
+func cliffLinearCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cliff_linear",
+		Short: "create cliff and linear means unfreeze construct",
+		Run:   cliffLinear,
+	}
+	cmd = createFlag(cmd)
+	cmd.Flags().Int64P("cliff", "c", 0, "cliff in second, nothing unfreeze before cliff")
+	cmd.MarkFlagRequired("cliff")
+
+	cmd.Flags().Int64P("duration", "d", 0, "duration in second from start to all unfreezed")
+	cmd.MarkFlagRequired("duration")
+
+	cmd.Flags().Int64P("period", "p", 0, "period in second")
+	cmd.MarkFlagRequired("period")
+	return cmd
+}
+
+func cliffLinear(cmd *cobra.Command, args []string) {
+	paraName, _ := cmd.Flags().GetString("paraName")
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	cfg, err := cmdtypes.GetChainConfig(rpcLaddr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, errors.Wrapf(err, "GetChainConfig"))
+		return
+	}
+
+	create, err := getCreateFlags(cmd, cfg)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+
+	cliff, _ := cmd.Flags().GetInt64("cliff")
+	duration, _ := cmd.Flags().GetInt64("duration")
+	period, _ := cmd.Flags().GetInt64("period")
+	if cliff < 0 || period <= 0 || duration < cliff || duration < period {
+		fmt.Fprintf(os.Stderr, "need 0 <= cliff <= duration and 0 < period <= duration")
+		return
+	}
+	create.Means = pty.CliffLinearX
+	create.MeansOpt = &pty.UnfreezeCreate_CliffLinear{
+		CliffLinear: &pty.CliffLinear{Cliff: cliff, Duration: duration, Period: period}}
+
+	params := &rpctypes.CreateTxIn{
+		Execer:     types.GetExecName(pty.UnfreezeX, paraName),
+		ActionName: pty.Action_CreateUnfreeze,
+		Payload:    types.MustPBToJSON(create),
+	}
+
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.CreateTransaction", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
+func customScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "custom_schedule",
+		Short: "create custom schedule means unfreeze construct",
+		Run:   customSchedule,
+	}
+	cmd = createFlag(cmd)
+	cmd.Flags().StringP("tranches", "r", "", "tranches as UTC timestamp:amount, use comma between tranches, such as 1700000000:100,1710000000:200")
+	cmd.MarkFlagRequired("tranches")
+	return cmd
+}
+
+func customSchedule(cmd *cobra.Command, args []string) {
+	paraName, _ := cmd.Flags().GetString("paraName")
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	cfg, err := cmdtypes.GetChainConfig(rpcLaddr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, errors.Wrapf(err, "GetChainConfig"))
+		return
+	}
+
+	create, err := getCreateFlags(cmd, cfg)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+
+	tranchesStr, _ := cmd.Flags().GetString("tranches")
+	schedule := &pty.CustomSchedule{}
+	for _, item := range strings.Split(tranchesStr, ",") {
+		kv := strings.Split(item, ":")
+		if len(kv) != 2 {
+			fmt.Fprintf(os.Stderr, "tranche format must be timestamp:amount")
+			return
+		}
+		ts, err := strconv.ParseInt(kv[0], 10, 64)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, errors.Wrapf(err, "ParseInt.timestamp"))
+			return
+		}
+		amount, err := strconv.ParseFloat(kv[1], 64)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, errors.Wrapf(err, "ParseFloat.amount"))
+			return
+		}
+		if err = checkAmount(amount); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return
+		}
+		amountInt64, err := types.FormatFloatDisplay2Value(amount, cfg.CoinPrecision)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, errors.Wrapf(err, "FormatFloatDisplay2Value.amount"))
+			return
+		}
+		schedule.Tranches = append(schedule.Tranches, &pty.Tranche{Time: ts, Amount: amountInt64})
+	}
+	create.Means = pty.CustomScheduleX
+	create.MeansOpt = &pty.UnfreezeCreate_CustomSchedule{CustomSchedule: schedule}
+
+	params := &rpctypes.CreateTxIn{
+		Execer:     types.GetExecName(pty.UnfreezeX, paraName),
+		ActionName: pty.Action_CreateUnfreeze,
+		Payload:    types.MustPBToJSON(create),
+	}
+
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.CreateTransaction", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
 func withdrawCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "withdraw",
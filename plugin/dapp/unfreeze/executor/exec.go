@@ -139,6 +139,11 @@ func (u *Unfreeze) newEntity(payload *pty.UnfreezeCreate, tx *types.Transaction)
 		unfreeze.StartTime = u.GetBlockTime()
 	}
 	cfg := u.GetAPI().GetConfig()
+	if payload.Means == pty.CliffLinearX || payload.Means == pty.CustomScheduleX {
+		if !cfg.IsDappFork(u.GetHeight(), pty.UnfreezeX, pty.ForkUnfreezeVestingX) {
+			return nil, types.ErrNotSupport
+		}
+	}
 	means, err := newMeans(cfg, payload.Means, u.GetHeight())
 	if err != nil {
 		return nil, err
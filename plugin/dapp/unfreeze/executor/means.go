@@ -5,6 +5,8 @@
 package executor
 
 import (
+	"math/big"
+
 	"github.com/33cn/chain33/types"
 	pty "github.com/33cn/plugin/plugin/dapp/unfreeze/types"
 )
@@ -21,6 +23,10 @@ func newMeans(cfg *types.Chain33Config, means string, height int64) (Means, erro
 			return &fixAmountV2{}, nil
 		} else if means == "LeftProportion" {
 			return &leftProportionV2{}, nil
+		} else if means == pty.CliffLinearX {
+			return &cliffLinear{}, nil
+		} else if means == pty.CustomScheduleX {
+			return &customSchedule{}, nil
 		}
 		return nil, types.ErrNotSupport
 	}
@@ -158,3 +164,86 @@ func (opt *leftProportionV2) calcFrozen(unfreeze *pty.Unfreeze, now int64) (int6
 	}
 	return int64(frozen), nil
 }
+
+// cliffLinear 锁定期内不解冻, 之后按间隔线性解冻, 到duration时全部解冻
+type cliffLinear struct {
+}
+
+func (opt *cliffLinear) setOpt(unfreeze *pty.Unfreeze, from *pty.UnfreezeCreate) (*pty.Unfreeze, error) {
+	o := from.GetCliffLinear()
+	if o == nil {
+		return nil, types.ErrInvalidParam
+	}
+	if o.Cliff < 0 || o.Duration <= 0 || o.Period <= 0 || o.Cliff > o.Duration || o.Period > o.Duration {
+		return nil, types.ErrInvalidParam
+	}
+	unfreeze.MeansOpt = &pty.Unfreeze_CliffLinear{CliffLinear: from.GetCliffLinear()}
+	return unfreeze, nil
+}
+
+func (opt *cliffLinear) calcFrozen(unfreeze *pty.Unfreeze, now int64) (int64, error) {
+	means := unfreeze.GetCliffLinear()
+	if means == nil {
+		return 0, types.ErrInvalidParam
+	}
+	if unfreeze.Terminated {
+		return 0, nil
+	}
+	elapsed := now - unfreeze.StartTime
+	if elapsed < means.Cliff {
+		return unfreeze.TotalCount, nil
+	}
+	if elapsed >= means.Duration {
+		return 0, nil
+	}
+	elapsed = elapsed / means.Period * means.Period
+	// TotalCount * elapsed 可能超出int64, 用大数计算
+	unfreezeAmount := new(big.Int).Mul(big.NewInt(unfreeze.TotalCount), big.NewInt(elapsed))
+	unfreezeAmount.Div(unfreezeAmount, big.NewInt(means.Duration))
+	return unfreeze.TotalCount - unfreezeAmount.Int64(), nil
+}
+
+// customSchedule 按指定的时间点和额度解冻
+type customSchedule struct {
+}
+
+func (opt *customSchedule) setOpt(unfreeze *pty.Unfreeze, from *pty.UnfreezeCreate) (*pty.Unfreeze, error) {
+	o := from.GetCustomSchedule()
+	if o == nil || len(o.Tranches) == 0 {
+		return nil, types.ErrInvalidParam
+	}
+	var total, last int64
+	for i, t := range o.Tranches {
+		if t.Amount <= 0 || (i > 0 && t.Time <= last) {
+			return nil, types.ErrInvalidParam
+		}
+		last = t.Time
+		total += t.Amount
+		if total > unfreeze.TotalCount {
+			return nil, types.ErrInvalidParam
+		}
+	}
+	if total != unfreeze.TotalCount {
+		return nil, types.ErrInvalidParam
+	}
+	unfreeze.MeansOpt = &pty.Unfreeze_CustomSchedule{CustomSchedule: from.GetCustomSchedule()}
+	return unfreeze, nil
+}
+
+func (opt *customSchedule) calcFrozen(unfreeze *pty.Unfreeze, now int64) (int64, error) {
+	means := unfreeze.GetCustomSchedule()
+	if means == nil {
+		return 0, types.ErrInvalidParam
+	}
+	if unfreeze.Terminated {
+		return 0, nil
+	}
+	frozen := unfreeze.TotalCount
+	for _, t := range means.Tranches {
+		if t.Time > now {
+			break
+		}
+		frozen -= t.Amount
+	}
+	return frozen, nil
+}
@@ -3,6 +3,7 @@ package executor
 import (
 	"testing"
 
+	"github.com/33cn/chain33/types"
 	"github.com/stretchr/testify/assert"
 
 	pty "github.com/33cn/plugin/plugin/dapp/unfreeze/types"
@@ -233,3 +234,149 @@ func TestLeftV2(t *testing.T) {
 		})
 	}
 }
+
+func TestCliffLinear(t *testing.T) {
+	cases := []struct {
+		start    int64
+		now      int64
+		cliff    int64
+		duration int64
+		period   int64
+		total    int64
+		expect   int64
+	}{
+		{10000, 10050, 100, 1000, 10, 10000, 10000},
+		{10000, 10100, 100, 1000, 10, 10000, 9000},
+		{10000, 10505, 100, 1000, 10, 10000, 5000},
+		{10000, 11000, 100, 1000, 10, 10000, 0},
+		{10000, 10500, 0, 1000, 1000, 10000, 10000},
+		{10000, 10500, 100, 1000, 10, 1e17, 5e16},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run("test CliffLinear", func(t *testing.T) {
+			create := pty.UnfreezeCreate{
+				StartTime:   c.start,
+				AssetExec:   "coins",
+				AssetSymbol: "bty",
+				TotalCount:  c.total,
+				Beneficiary: "x",
+				Means:       pty.CliffLinearX,
+				MeansOpt: &pty.UnfreezeCreate_CliffLinear{
+					CliffLinear: &pty.CliffLinear{
+						Cliff:    c.cliff,
+						Duration: c.duration,
+						Period:   c.period,
+					},
+				},
+			}
+			u := &pty.Unfreeze{
+				TotalCount: c.total,
+				Means:      pty.CliffLinearX,
+				StartTime:  c.start,
+			}
+			m := cliffLinear{}
+			u, err := m.setOpt(u, &create)
+			assert.Nil(t, err)
+
+			f, err := m.calcFrozen(u, c.now)
+			assert.Nil(t, err)
+			assert.Equal(t, c.expect, f)
+
+			u.Terminated = true
+			f, err = m.calcFrozen(u, c.now)
+			assert.Nil(t, err)
+			assert.Equal(t, int64(0), f)
+		})
+	}
+
+	// 参数错误
+	invalid := []*pty.CliffLinear{
+		{Cliff: -1, Duration: 1000, Period: 10},
+		{Cliff: 100, Duration: 0, Period: 10},
+		{Cliff: 100, Duration: 1000, Period: 0},
+		{Cliff: 1001, Duration: 1000, Period: 10},
+		{Cliff: 100, Duration: 1000, Period: 1001},
+	}
+	for _, opt := range invalid {
+		create := pty.UnfreezeCreate{
+			TotalCount: 10000,
+			Means:      pty.CliffLinearX,
+			MeansOpt:   &pty.UnfreezeCreate_CliffLinear{CliffLinear: opt},
+		}
+		m := cliffLinear{}
+		_, err := m.setOpt(&pty.Unfreeze{TotalCount: 10000}, &create)
+		assert.Equal(t, types.ErrInvalidParam, err)
+	}
+}
+
+func TestCustomSchedule(t *testing.T) {
+	tranches := []*pty.Tranche{
+		{Time: 10100, Amount: 1000},
+		{Time: 10200, Amount: 4000},
+		{Time: 10300, Amount: 5000},
+	}
+	cases := []struct {
+		now    int64
+		expect int64
+	}{
+		{10000, 10000},
+		{10100, 9000},
+		{10199, 9000},
+		{10200, 5000},
+		{10300, 0},
+		{20000, 0},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run("test CustomSchedule", func(t *testing.T) {
+			create := pty.UnfreezeCreate{
+				StartTime:   10000,
+				AssetExec:   "coins",
+				AssetSymbol: "bty",
+				TotalCount:  10000,
+				Beneficiary: "x",
+				Means:       pty.CustomScheduleX,
+				MeansOpt: &pty.UnfreezeCreate_CustomSchedule{
+					CustomSchedule: &pty.CustomSchedule{Tranches: tranches},
+				},
+			}
+			u := &pty.Unfreeze{
+				TotalCount: 10000,
+				Means:      pty.CustomScheduleX,
+				StartTime:  10000,
+			}
+			m := customSchedule{}
+			u, err := m.setOpt(u, &create)
+			assert.Nil(t, err)
+
+			f, err := m.calcFrozen(u, c.now)
+			assert.Nil(t, err)
+			assert.Equal(t, c.expect, f)
+		})
+	}
+
+	// 参数错误: 总额不符, 时间无序, 额度非正
+	invalid := [][]*pty.Tranche{
+		{},
+		{{Time: 10100, Amount: 1000}, {Time: 10200, Amount: 1000}},
+		{{Time: 10200, Amount: 5000}, {Time: 10100, Amount: 5000}},
+		{{Time: 10100, Amount: 5000}, {Time: 10100, Amount: 5000}},
+		{{Time: 10100, Amount: 0}, {Time: 10200, Amount: 10000}},
+		{{Time: 10100, Amount: 20000}, {Time: 10200, Amount: -10000}},
+	}
+	for _, trs := range invalid {
+		create := pty.UnfreezeCreate{
+			TotalCount: 10000,
+			Means:      pty.CustomScheduleX,
+			MeansOpt: &pty.UnfreezeCreate_CustomSchedule{
+				CustomSchedule: &pty.CustomSchedule{Tranches: trs},
+			},
+		}
+		m := customSchedule{}
+		_, err := m.setOpt(&pty.Unfreeze{TotalCount: 10000}, &create)
+		assert.Equal(t, types.ErrInvalidParam, err)
+	}
+}
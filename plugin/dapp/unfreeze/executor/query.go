@@ -146,6 +146,10 @@ func fmtLocalUnfreeze(rows []*table.Row) (*pty.ReplyUnfreezes, error) {
 			v.MeansOpt = &pty.ReplyUnfreeze_FixAmount{FixAmount: r.Unfreeze.GetFixAmount()}
 		} else if v.Means == pty.LeftProportionX {
 			v.MeansOpt = &pty.ReplyUnfreeze_LeftProportion{LeftProportion: r.Unfreeze.GetLeftProportion()}
+		} else if v.Means == pty.CliffLinearX {
+			v.MeansOpt = &pty.ReplyUnfreeze_CliffLinear{CliffLinear: r.Unfreeze.GetCliffLinear()}
+		} else if v.Means == pty.CustomScheduleX {
+			v.MeansOpt = &pty.ReplyUnfreeze_CustomSchedule{CustomSchedule: r.Unfreeze.GetCustomSchedule()}
 		}
 		results.Unfreeze = append(results.Unfreeze, v)
 	}
@@ -41,8 +41,11 @@ var (
 
 	FixAmountX      = "FixAmount"
 	LeftProportionX = "LeftProportion"
-	SupportMeans    = []string{"FixAmount", "LeftProportion"}
+	CliffLinearX    = "CliffLinear"
+	CustomScheduleX = "CustomSchedule"
+	SupportMeans    = []string{"FixAmount", "LeftProportion", "CliffLinear", "CustomSchedule"}
 
-	ForkTerminatePartX = "ForkTerminatePart"
-	ForkUnfreezeIDX    = "ForkUnfreezeIDX"
+	ForkTerminatePartX   = "ForkTerminatePart"
+	ForkUnfreezeIDX      = "ForkUnfreezeIDX"
+	ForkUnfreezeVestingX = "ForkUnfreezeVesting"
 )
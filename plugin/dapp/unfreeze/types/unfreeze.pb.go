@@ -55,6 +55,8 @@ type Unfreeze struct {
 	// Types that are assignable to MeansOpt:
 	//	*Unfreeze_FixAmount
 	//	*Unfreeze_LeftProportion
+	//	*Unfreeze_CliffLinear
+	//	*Unfreeze_CustomSchedule
 	MeansOpt   isUnfreeze_MeansOpt `protobuf_oneof:"meansOpt"`
 	Terminated bool                `protobuf:"varint,12,opt,name=terminated,proto3" json:"terminated,omitempty"`
 }
@@ -175,6 +177,20 @@ func (x *Unfreeze) GetLeftProportion() *LeftProportion {
 	return nil
 }
 
+func (x *Unfreeze) GetCliffLinear() *CliffLinear {
+	if x, ok := x.GetMeansOpt().(*Unfreeze_CliffLinear); ok {
+		return x.CliffLinear
+	}
+	return nil
+}
+
+func (x *Unfreeze) GetCustomSchedule() *CustomSchedule {
+	if x, ok := x.GetMeansOpt().(*Unfreeze_CustomSchedule); ok {
+		return x.CustomSchedule
+	}
+	return nil
+}
+
 func (x *Unfreeze) GetTerminated() bool {
 	if x != nil {
 		return x.Terminated
@@ -194,10 +210,22 @@ type Unfreeze_LeftProportion struct {
 	LeftProportion *LeftProportion `protobuf:"bytes,11,opt,name=leftProportion,proto3,oneof"`
 }
 
+type Unfreeze_CliffLinear struct {
+	CliffLinear *CliffLinear `protobuf:"bytes,13,opt,name=cliffLinear,proto3,oneof"`
+}
+
+type Unfreeze_CustomSchedule struct {
+	CustomSchedule *CustomSchedule `protobuf:"bytes,14,opt,name=customSchedule,proto3,oneof"`
+}
+
 func (*Unfreeze_FixAmount) isUnfreeze_MeansOpt() {}
 
 func (*Unfreeze_LeftProportion) isUnfreeze_MeansOpt() {}
 
+func (*Unfreeze_CliffLinear) isUnfreeze_MeansOpt() {}
+
+func (*Unfreeze_CustomSchedule) isUnfreeze_MeansOpt() {}
+
 // 按时间固定额度解冻
 type FixAmount struct {
 	state         protoimpl.MessageState
@@ -310,6 +338,178 @@ func (x *LeftProportion) GetTenThousandth() int64 {
 	return 0
 }
 
+// 锁定期后按时间线性解冻
+type CliffLinear struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//锁定期(秒), 期间不解冻, 到期后补足锁定期内应解冻的部分
+	Cliff int64 `protobuf:"varint,1,opt,name=cliff,proto3" json:"cliff,omitempty"`
+	//从开始时间起全部解冻所需的时间(秒)
+	Duration int64 `protobuf:"varint,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	//解冻间隔(秒)
+	Period int64 `protobuf:"varint,3,opt,name=period,proto3" json:"period,omitempty"`
+}
+
+func (x *CliffLinear) Reset() {
+	*x = CliffLinear{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_unfreeze_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CliffLinear) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CliffLinear) ProtoMessage() {}
+
+func (x *CliffLinear) ProtoReflect() protoreflect.Message {
+	mi := &file_unfreeze_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CliffLinear.ProtoReflect.Descriptor instead.
+func (*CliffLinear) Descriptor() ([]byte, []int) {
+	return file_unfreeze_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CliffLinear) GetCliff() int64 {
+	if x != nil {
+		return x.Cliff
+	}
+	return 0
+}
+
+func (x *CliffLinear) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *CliffLinear) GetPeriod() int64 {
+	if x != nil {
+		return x.Period
+	}
+	return 0
+}
+
+// 按指定时间点解冻指定额度
+type CustomSchedule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tranches []*Tranche `protobuf:"bytes,1,rep,name=tranches,proto3" json:"tranches,omitempty"`
+}
+
+func (x *CustomSchedule) Reset() {
+	*x = CustomSchedule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_unfreeze_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CustomSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomSchedule) ProtoMessage() {}
+
+func (x *CustomSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_unfreeze_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomSchedule.ProtoReflect.Descriptor instead.
+func (*CustomSchedule) Descriptor() ([]byte, []int) {
+	return file_unfreeze_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CustomSchedule) GetTranches() []*Tranche {
+	if x != nil {
+		return x.Tranches
+	}
+	return nil
+}
+
+type Tranche struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//解冻时间(UTC时间戳)
+	Time int64 `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	//该时间点解冻的额度
+	Amount int64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *Tranche) Reset() {
+	*x = Tranche{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_unfreeze_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Tranche) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tranche) ProtoMessage() {}
+
+func (x *Tranche) ProtoReflect() protoreflect.Message {
+	mi := &file_unfreeze_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tranche.ProtoReflect.Descriptor instead.
+func (*Tranche) Descriptor() ([]byte, []int) {
+	return file_unfreeze_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Tranche) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *Tranche) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
 // message for execs.unfreeze
 type UnfreezeAction struct {
 	state         protoimpl.MessageState
@@ -327,7 +527,7 @@ type UnfreezeAction struct {
 func (x *UnfreezeAction) Reset() {
 	*x = UnfreezeAction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_unfreeze_proto_msgTypes[3]
+		mi := &file_unfreeze_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -340,7 +540,7 @@ func (x *UnfreezeAction) String() string {
 func (*UnfreezeAction) ProtoMessage() {}
 
 func (x *UnfreezeAction) ProtoReflect() protoreflect.Message {
-	mi := &file_unfreeze_proto_msgTypes[3]
+	mi := &file_unfreeze_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -353,7 +553,7 @@ func (x *UnfreezeAction) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnfreezeAction.ProtoReflect.Descriptor instead.
 func (*UnfreezeAction) Descriptor() ([]byte, []int) {
-	return file_unfreeze_proto_rawDescGZIP(), []int{3}
+	return file_unfreeze_proto_rawDescGZIP(), []int{6}
 }
 
 func (m *UnfreezeAction) GetValue() isUnfreezeAction_Value {
@@ -428,13 +628,15 @@ type UnfreezeCreate struct {
 	// Types that are assignable to MeansOpt:
 	//	*UnfreezeCreate_FixAmount
 	//	*UnfreezeCreate_LeftProportion
+	//	*UnfreezeCreate_CliffLinear
+	//	*UnfreezeCreate_CustomSchedule
 	MeansOpt isUnfreezeCreate_MeansOpt `protobuf_oneof:"meansOpt"`
 }
 
 func (x *UnfreezeCreate) Reset() {
 	*x = UnfreezeCreate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_unfreeze_proto_msgTypes[4]
+		mi := &file_unfreeze_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -447,7 +649,7 @@ func (x *UnfreezeCreate) String() string {
 func (*UnfreezeCreate) ProtoMessage() {}
 
 func (x *UnfreezeCreate) ProtoReflect() protoreflect.Message {
-	mi := &file_unfreeze_proto_msgTypes[4]
+	mi := &file_unfreeze_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -460,7 +662,7 @@ func (x *UnfreezeCreate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnfreezeCreate.ProtoReflect.Descriptor instead.
 func (*UnfreezeCreate) Descriptor() ([]byte, []int) {
-	return file_unfreeze_proto_rawDescGZIP(), []int{4}
+	return file_unfreeze_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *UnfreezeCreate) GetStartTime() int64 {
@@ -526,6 +728,20 @@ func (x *UnfreezeCreate) GetLeftProportion() *LeftProportion {
 	return nil
 }
 
+func (x *UnfreezeCreate) GetCliffLinear() *CliffLinear {
+	if x, ok := x.GetMeansOpt().(*UnfreezeCreate_CliffLinear); ok {
+		return x.CliffLinear
+	}
+	return nil
+}
+
+func (x *UnfreezeCreate) GetCustomSchedule() *CustomSchedule {
+	if x, ok := x.GetMeansOpt().(*UnfreezeCreate_CustomSchedule); ok {
+		return x.CustomSchedule
+	}
+	return nil
+}
+
 type isUnfreezeCreate_MeansOpt interface {
 	isUnfreezeCreate_MeansOpt()
 }
@@ -538,10 +754,22 @@ type UnfreezeCreate_LeftProportion struct {
 	LeftProportion *LeftProportion `protobuf:"bytes,8,opt,name=leftProportion,proto3,oneof"`
 }
 
+type UnfreezeCreate_CliffLinear struct {
+	CliffLinear *CliffLinear `protobuf:"bytes,9,opt,name=cliffLinear,proto3,oneof"`
+}
+
+type UnfreezeCreate_CustomSchedule struct {
+	CustomSchedule *CustomSchedule `protobuf:"bytes,10,opt,name=customSchedule,proto3,oneof"`
+}
+
 func (*UnfreezeCreate_FixAmount) isUnfreezeCreate_MeansOpt() {}
 
 func (*UnfreezeCreate_LeftProportion) isUnfreezeCreate_MeansOpt() {}
 
+func (*UnfreezeCreate_CliffLinear) isUnfreezeCreate_MeansOpt() {}
+
+func (*UnfreezeCreate_CustomSchedule) isUnfreezeCreate_MeansOpt() {}
+
 type UnfreezeWithdraw struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -553,7 +781,7 @@ type UnfreezeWithdraw struct {
 func (x *UnfreezeWithdraw) Reset() {
 	*x = UnfreezeWithdraw{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_unfreeze_proto_msgTypes[5]
+		mi := &file_unfreeze_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -566,7 +794,7 @@ func (x *UnfreezeWithdraw) String() string {
 func (*UnfreezeWithdraw) ProtoMessage() {}
 
 func (x *UnfreezeWithdraw) ProtoReflect() protoreflect.Message {
-	mi := &file_unfreeze_proto_msgTypes[5]
+	mi := &file_unfreeze_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -579,7 +807,7 @@ func (x *UnfreezeWithdraw) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnfreezeWithdraw.ProtoReflect.Descriptor instead.
 func (*UnfreezeWithdraw) Descriptor() ([]byte, []int) {
-	return file_unfreeze_proto_rawDescGZIP(), []int{5}
+	return file_unfreeze_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *UnfreezeWithdraw) GetUnfreezeID() string {
@@ -600,7 +828,7 @@ type UnfreezeTerminate struct {
 func (x *UnfreezeTerminate) Reset() {
 	*x = UnfreezeTerminate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_unfreeze_proto_msgTypes[6]
+		mi := &file_unfreeze_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -613,7 +841,7 @@ func (x *UnfreezeTerminate) String() string {
 func (*UnfreezeTerminate) ProtoMessage() {}
 
 func (x *UnfreezeTerminate) ProtoReflect() protoreflect.Message {
-	mi := &file_unfreeze_proto_msgTypes[6]
+	mi := &file_unfreeze_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -626,7 +854,7 @@ func (x *UnfreezeTerminate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnfreezeTerminate.ProtoReflect.Descriptor instead.
 func (*UnfreezeTerminate) Descriptor() ([]byte, []int) {
-	return file_unfreeze_proto_rawDescGZIP(), []int{6}
+	return file_unfreeze_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UnfreezeTerminate) GetUnfreezeID() string {
@@ -649,7 +877,7 @@ type ReceiptUnfreeze struct {
 func (x *ReceiptUnfreeze) Reset() {
 	*x = ReceiptUnfreeze{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_unfreeze_proto_msgTypes[7]
+		mi := &file_unfreeze_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -662,7 +890,7 @@ func (x *ReceiptUnfreeze) String() string {
 func (*ReceiptUnfreeze) ProtoMessage() {}
 
 func (x *ReceiptUnfreeze) ProtoReflect() protoreflect.Message {
-	mi := &file_unfreeze_proto_msgTypes[7]
+	mi := &file_unfreeze_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -675,7 +903,7 @@ func (x *ReceiptUnfreeze) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReceiptUnfreeze.ProtoReflect.Descriptor instead.
 func (*ReceiptUnfreeze) Descriptor() ([]byte, []int) {
-	return file_unfreeze_proto_rawDescGZIP(), []int{7}
+	return file_unfreeze_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ReceiptUnfreeze) GetPrev() *Unfreeze {
@@ -704,7 +932,7 @@ type LocalUnfreeze struct {
 func (x *LocalUnfreeze) Reset() {
 	*x = LocalUnfreeze{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_unfreeze_proto_msgTypes[8]
+		mi := &file_unfreeze_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -717,7 +945,7 @@ func (x *LocalUnfreeze) String() string {
 func (*LocalUnfreeze) ProtoMessage() {}
 
 func (x *LocalUnfreeze) ProtoReflect() protoreflect.Message {
-	mi := &file_unfreeze_proto_msgTypes[8]
+	mi := &file_unfreeze_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -730,7 +958,7 @@ func (x *LocalUnfreeze) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LocalUnfreeze.ProtoReflect.Descriptor instead.
 func (*LocalUnfreeze) Descriptor() ([]byte, []int) {
-	return file_unfreeze_proto_rawDescGZIP(), []int{8}
+	return file_unfreeze_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *LocalUnfreeze) GetUnfreeze() *Unfreeze {
@@ -760,7 +988,7 @@ type ReplyQueryUnfreezeWithdraw struct {
 func (x *ReplyQueryUnfreezeWithdraw) Reset() {
 	*x = ReplyQueryUnfreezeWithdraw{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_unfreeze_proto_msgTypes[9]
+		mi := &file_unfreeze_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -773,7 +1001,7 @@ func (x *ReplyQueryUnfreezeWithdraw) String() string {
 func (*ReplyQueryUnfreezeWithdraw) ProtoMessage() {}
 
 func (x *ReplyQueryUnfreezeWithdraw) ProtoReflect() protoreflect.Message {
-	mi := &file_unfreeze_proto_msgTypes[9]
+	mi := &file_unfreeze_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -786,7 +1014,7 @@ func (x *ReplyQueryUnfreezeWithdraw) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReplyQueryUnfreezeWithdraw.ProtoReflect.Descriptor instead.
 func (*ReplyQueryUnfreezeWithdraw) Descriptor() ([]byte, []int) {
-	return file_unfreeze_proto_rawDescGZIP(), []int{9}
+	return file_unfreeze_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ReplyQueryUnfreezeWithdraw) GetUnfreezeID() string {
@@ -818,7 +1046,7 @@ type ReqUnfreezes struct {
 func (x *ReqUnfreezes) Reset() {
 	*x = ReqUnfreezes{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_unfreeze_proto_msgTypes[10]
+		mi := &file_unfreeze_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -831,7 +1059,7 @@ func (x *ReqUnfreezes) String() string {
 func (*ReqUnfreezes) ProtoMessage() {}
 
 func (x *ReqUnfreezes) ProtoReflect() protoreflect.Message {
-	mi := &file_unfreeze_proto_msgTypes[10]
+	mi := &file_unfreeze_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -844,7 +1072,7 @@ func (x *ReqUnfreezes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReqUnfreezes.ProtoReflect.Descriptor instead.
 func (*ReqUnfreezes) Descriptor() ([]byte, []int) {
-	return file_unfreeze_proto_rawDescGZIP(), []int{10}
+	return file_unfreeze_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ReqUnfreezes) GetDirection() int32 {
@@ -907,6 +1135,8 @@ type ReplyUnfreeze struct {
 	// Types that are assignable to MeansOpt:
 	//	*ReplyUnfreeze_FixAmount
 	//	*ReplyUnfreeze_LeftProportion
+	//	*ReplyUnfreeze_CliffLinear
+	//	*ReplyUnfreeze_CustomSchedule
 	MeansOpt   isReplyUnfreeze_MeansOpt `protobuf_oneof:"meansOpt"`
 	Terminated bool                     `protobuf:"varint,12,opt,name=terminated,proto3" json:"terminated,omitempty"`
 	Key        string                   `protobuf:"bytes,13,opt,name=key,proto3" json:"key,omitempty"`
@@ -915,7 +1145,7 @@ type ReplyUnfreeze struct {
 func (x *ReplyUnfreeze) Reset() {
 	*x = ReplyUnfreeze{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_unfreeze_proto_msgTypes[11]
+		mi := &file_unfreeze_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -928,7 +1158,7 @@ func (x *ReplyUnfreeze) String() string {
 func (*ReplyUnfreeze) ProtoMessage() {}
 
 func (x *ReplyUnfreeze) ProtoReflect() protoreflect.Message {
-	mi := &file_unfreeze_proto_msgTypes[11]
+	mi := &file_unfreeze_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -941,7 +1171,7 @@ func (x *ReplyUnfreeze) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReplyUnfreeze.ProtoReflect.Descriptor instead.
 func (*ReplyUnfreeze) Descriptor() ([]byte, []int) {
-	return file_unfreeze_proto_rawDescGZIP(), []int{11}
+	return file_unfreeze_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ReplyUnfreeze) GetUnfreezeID() string {
@@ -1028,6 +1258,20 @@ func (x *ReplyUnfreeze) GetLeftProportion() *LeftProportion {
 	return nil
 }
 
+func (x *ReplyUnfreeze) GetCliffLinear() *CliffLinear {
+	if x, ok := x.GetMeansOpt().(*ReplyUnfreeze_CliffLinear); ok {
+		return x.CliffLinear
+	}
+	return nil
+}
+
+func (x *ReplyUnfreeze) GetCustomSchedule() *CustomSchedule {
+	if x, ok := x.GetMeansOpt().(*ReplyUnfreeze_CustomSchedule); ok {
+		return x.CustomSchedule
+	}
+	return nil
+}
+
 func (x *ReplyUnfreeze) GetTerminated() bool {
 	if x != nil {
 		return x.Terminated
@@ -1054,10 +1298,22 @@ type ReplyUnfreeze_LeftProportion struct {
 	LeftProportion *LeftProportion `protobuf:"bytes,11,opt,name=leftProportion,proto3,oneof"`
 }
 
+type ReplyUnfreeze_CliffLinear struct {
+	CliffLinear *CliffLinear `protobuf:"bytes,14,opt,name=cliffLinear,proto3,oneof"`
+}
+
+type ReplyUnfreeze_CustomSchedule struct {
+	CustomSchedule *CustomSchedule `protobuf:"bytes,15,opt,name=customSchedule,proto3,oneof"`
+}
+
 func (*ReplyUnfreeze_FixAmount) isReplyUnfreeze_MeansOpt() {}
 
 func (*ReplyUnfreeze_LeftProportion) isReplyUnfreeze_MeansOpt() {}
 
+func (*ReplyUnfreeze_CliffLinear) isReplyUnfreeze_MeansOpt() {}
+
+func (*ReplyUnfreeze_CustomSchedule) isReplyUnfreeze_MeansOpt() {}
+
 type ReplyUnfreezes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1069,7 +1325,7 @@ type ReplyUnfreezes struct {
 func (x *ReplyUnfreezes) Reset() {
 	*x = ReplyUnfreezes{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_unfreeze_proto_msgTypes[12]
+		mi := &file_unfreeze_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1082,7 +1338,7 @@ func (x *ReplyUnfreezes) String() string {
 func (*ReplyUnfreezes) ProtoMessage() {}
 
 func (x *ReplyUnfreezes) ProtoReflect() protoreflect.Message {
-	mi := &file_unfreeze_proto_msgTypes[12]
+	mi := &file_unfreeze_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1095,7 +1351,7 @@ func (x *ReplyUnfreezes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReplyUnfreezes.ProtoReflect.Descriptor instead.
 func (*ReplyUnfreezes) Descriptor() ([]byte, []int) {
-	return file_unfreeze_proto_rawDescGZIP(), []int{12}
+	return file_unfreeze_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ReplyUnfreezes) GetUnfreeze() []*ReplyUnfreeze {
@@ -1110,7 +1366,7 @@ var File_unfreeze_proto protoreflect.FileDescriptor
 var file_unfreeze_proto_rawDesc = []byte{
 	0x0a, 0x0e, 0x75, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x12, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x1a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbb, 0x03, 0x0a, 0x08, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb4, 0x04, 0x0a, 0x08, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65,
 	0x7a, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x49, 0x44,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65,
 	0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18,
@@ -1135,51 +1391,79 @@ var file_unfreeze_proto_rawDesc = []byte{
 	0x6f, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
 	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4c, 0x65, 0x66, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f,
 	0x72, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0e, 0x6c, 0x65, 0x66, 0x74, 0x50, 0x72, 0x6f,
-	0x70, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x65, 0x72, 0x6d, 0x69,
-	0x6e, 0x61, 0x74, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x74, 0x65, 0x72,
-	0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x42, 0x0a, 0x0a, 0x08, 0x6d, 0x65, 0x61, 0x6e, 0x73,
-	0x4f, 0x70, 0x74, 0x22, 0x3b, 0x0a, 0x09, 0x46, 0x69, 0x78, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x22, 0x4e, 0x0a, 0x0e, 0x4c, 0x65, 0x66, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x72, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x65,
-	0x6e, 0x54, 0x68, 0x6f, 0x75, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0d, 0x74, 0x65, 0x6e, 0x54, 0x68, 0x6f, 0x75, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x68,
-	0x22, 0xcb, 0x01, 0x0a, 0x0e, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x41, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x06, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55, 0x6e, 0x66, 0x72,
-	0x65, 0x65, 0x7a, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x06, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55,
-	0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x48,
-	0x00, 0x52, 0x08, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x38, 0x0a, 0x09, 0x74,
-	0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x54,
-	0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x09, 0x74, 0x65, 0x72, 0x6d,
-	0x69, 0x6e, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x02, 0x74, 0x79, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xc5,
-	0x02, 0x0a, 0x0e, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12,
-	0x1c, 0x0a, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x45, 0x78, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x45, 0x78, 0x65, 0x63, 0x12, 0x20, 0x0a,
-	0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12,
-	0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x20, 0x0a, 0x0b, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x65, 0x61, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x6d, 0x65, 0x61, 0x6e, 0x73, 0x12, 0x30, 0x0a, 0x09, 0x66, 0x69, 0x78, 0x41, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x46, 0x69, 0x78, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x09,
-	0x66, 0x69, 0x78, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3f, 0x0a, 0x0e, 0x6c, 0x65, 0x66,
-	0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4c, 0x65, 0x66, 0x74, 0x50, 0x72,
-	0x6f, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0e, 0x6c, 0x65, 0x66, 0x74,
-	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0a, 0x0a, 0x08, 0x6d, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x66, 0x66,
+	0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6c, 0x69, 0x66, 0x66, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72,
+	0x48, 0x00, 0x52, 0x0b, 0x63, 0x6c, 0x69, 0x66, 0x66, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x12,
+	0x3f, 0x0a, 0x0e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x48, 0x00,
+	0x52, 0x0e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64,
+	0x42, 0x0a, 0x0a, 0x08, 0x6d, 0x65, 0x61, 0x6e, 0x73, 0x4f, 0x70, 0x74, 0x22, 0x3b, 0x0a, 0x09,
+	0x46, 0x69, 0x78, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x4e, 0x0a, 0x0e, 0x4c, 0x65, 0x66,
+	0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x65, 0x6e, 0x54, 0x68, 0x6f, 0x75, 0x73, 0x61,
+	0x6e, 0x64, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x74, 0x65, 0x6e, 0x54,
+	0x68, 0x6f, 0x75, 0x73, 0x61, 0x6e, 0x64, 0x74, 0x68, 0x22, 0x57, 0x0a, 0x0b, 0x43, 0x6c, 0x69,
+	0x66, 0x66, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x69, 0x66,
+	0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6c, 0x69, 0x66, 0x66, 0x12, 0x1a,
+	0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x22, 0x3c, 0x0a, 0x0e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x12, 0x2a, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54,
+	0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x52, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73,
+	0x22, 0x35, 0x0a, 0x07, 0x54, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xcb, 0x01, 0x0a, 0x0e, 0x55, 0x6e, 0x66, 0x72,
+	0x65, 0x65, 0x7a, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x06, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x48, 0x00, 0x52, 0x06, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x77,
+	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x57, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x48, 0x00, 0x52, 0x08, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55, 0x6e,
+	0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x48,
+	0x00, 0x52, 0x09, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02,
+	0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x74, 0x79, 0x42, 0x07, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xbe, 0x03, 0x0a, 0x0e, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65,
+	0x7a, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x45,
+	0x78, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x45, 0x78, 0x65, 0x63, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x53, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69,
+	0x63, 0x69, 0x61, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x65, 0x6e,
+	0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x65, 0x61, 0x6e,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x65, 0x61, 0x6e, 0x73, 0x12, 0x30,
+	0x0a, 0x09, 0x66, 0x69, 0x78, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x46, 0x69, 0x78, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x09, 0x66, 0x69, 0x78, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x3f, 0x0a, 0x0e, 0x6c, 0x65, 0x66, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x72, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x4c, 0x65, 0x66, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x48,
+	0x00, 0x52, 0x0e, 0x6c, 0x65, 0x66, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x36, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x66, 0x66, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43,
+	0x6c, 0x69, 0x66, 0x66, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x6c,
+	0x69, 0x66, 0x66, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x12, 0x3f, 0x0a, 0x0e, 0x63, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x0e, 0x63, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x6d, 0x65,
 	0x61, 0x6e, 0x73, 0x4f, 0x70, 0x74, 0x22, 0x32, 0x0a, 0x10, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65,
 	0x7a, 0x65, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x6e,
 	0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
@@ -1215,7 +1499,7 @@ var file_unfreeze_proto_rawDesc = []byte{
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x12,
 	0x20, 0x0a, 0x0b, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x18, 0x05,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72,
-	0x79, 0x22, 0xd2, 0x03, 0x0a, 0x0d, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x55, 0x6e, 0x66, 0x72, 0x65,
+	0x79, 0x22, 0xcb, 0x04, 0x0a, 0x0d, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x55, 0x6e, 0x66, 0x72, 0x65,
 	0x65, 0x7a, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x49,
 	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a,
 	0x65, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65,
@@ -1240,25 +1524,33 @@ var file_unfreeze_proto_rawDesc = []byte{
 	0x72, 0x6f, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4c, 0x65, 0x66, 0x74, 0x50, 0x72, 0x6f, 0x70,
 	0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0e, 0x6c, 0x65, 0x66, 0x74, 0x50, 0x72,
-	0x6f, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x65, 0x72, 0x6d,
-	0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x74, 0x65,
-	0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x42, 0x0a, 0x0a, 0x08, 0x6d, 0x65,
-	0x61, 0x6e, 0x73, 0x4f, 0x70, 0x74, 0x22, 0x42, 0x0a, 0x0e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x55,
-	0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x08, 0x75, 0x6e, 0x66, 0x72,
-	0x65, 0x65, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65,
-	0x52, 0x08, 0x75, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x32, 0x8e, 0x01, 0x0a, 0x08, 0x75,
-	0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x12, 0x4c, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x55, 0x6e,
-	0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x10,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x1a, 0x21, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x57, 0x69, 0x74, 0x68, 0x64,
-	0x72, 0x61, 0x77, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x0d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55, 0x6e,
-	0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x12, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52,
-	0x65, 0x71, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x22, 0x00, 0x42, 0x0a, 0x5a, 0x08, 0x2e,
-	0x2e, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x66,
+	0x66, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6c, 0x69, 0x66, 0x66, 0x4c, 0x69, 0x6e, 0x65, 0x61,
+	0x72, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x6c, 0x69, 0x66, 0x66, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72,
+	0x12, 0x3f, 0x0a, 0x0e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x48,
+	0x00, 0x52, 0x0e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65,
+	0x64, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x42, 0x0a, 0x0a, 0x08, 0x6d, 0x65, 0x61, 0x6e, 0x73, 0x4f, 0x70, 0x74, 0x22,
+	0x42, 0x0a, 0x0e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65,
+	0x73, 0x12, 0x30, 0x0a, 0x08, 0x75, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x52, 0x08, 0x75, 0x6e, 0x66, 0x72, 0x65,
+	0x65, 0x7a, 0x65, 0x32, 0x8e, 0x01, 0x0a, 0x08, 0x75, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65,
+	0x12, 0x4c, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x57,
+	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x52, 0x65, 0x71, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x1a, 0x21, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55, 0x6e, 0x66, 0x72,
+	0x65, 0x65, 0x7a, 0x65, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x22, 0x00, 0x12, 0x34,
+	0x0a, 0x0d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x12,
+	0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65,
+	0x7a, 0x65, 0x22, 0x00, 0x42, 0x0a, 0x5a, 0x08, 0x2e, 0x2e, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1273,46 +1565,56 @@ func file_unfreeze_proto_rawDescGZIP() []byte {
 	return file_unfreeze_proto_rawDescData
 }
 
-var file_unfreeze_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_unfreeze_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_unfreeze_proto_goTypes = []interface{}{
 	(*Unfreeze)(nil),                   // 0: types.Unfreeze
 	(*FixAmount)(nil),                  // 1: types.FixAmount
 	(*LeftProportion)(nil),             // 2: types.LeftProportion
-	(*UnfreezeAction)(nil),             // 3: types.UnfreezeAction
-	(*UnfreezeCreate)(nil),             // 4: types.UnfreezeCreate
-	(*UnfreezeWithdraw)(nil),           // 5: types.UnfreezeWithdraw
-	(*UnfreezeTerminate)(nil),          // 6: types.UnfreezeTerminate
-	(*ReceiptUnfreeze)(nil),            // 7: types.ReceiptUnfreeze
-	(*LocalUnfreeze)(nil),              // 8: types.LocalUnfreeze
-	(*ReplyQueryUnfreezeWithdraw)(nil), // 9: types.ReplyQueryUnfreezeWithdraw
-	(*ReqUnfreezes)(nil),               // 10: types.ReqUnfreezes
-	(*ReplyUnfreeze)(nil),              // 11: types.ReplyUnfreeze
-	(*ReplyUnfreezes)(nil),             // 12: types.ReplyUnfreezes
-	(*types.ReqString)(nil),            // 13: types.ReqString
+	(*CliffLinear)(nil),                // 3: types.CliffLinear
+	(*CustomSchedule)(nil),             // 4: types.CustomSchedule
+	(*Tranche)(nil),                    // 5: types.Tranche
+	(*UnfreezeAction)(nil),             // 6: types.UnfreezeAction
+	(*UnfreezeCreate)(nil),             // 7: types.UnfreezeCreate
+	(*UnfreezeWithdraw)(nil),           // 8: types.UnfreezeWithdraw
+	(*UnfreezeTerminate)(nil),          // 9: types.UnfreezeTerminate
+	(*ReceiptUnfreeze)(nil),            // 10: types.ReceiptUnfreeze
+	(*LocalUnfreeze)(nil),              // 11: types.LocalUnfreeze
+	(*ReplyQueryUnfreezeWithdraw)(nil), // 12: types.ReplyQueryUnfreezeWithdraw
+	(*ReqUnfreezes)(nil),               // 13: types.ReqUnfreezes
+	(*ReplyUnfreeze)(nil),              // 14: types.ReplyUnfreeze
+	(*ReplyUnfreezes)(nil),             // 15: types.ReplyUnfreezes
+	(*types.ReqString)(nil),            // 16: types.ReqString
 }
 var file_unfreeze_proto_depIdxs = []int32{
 	1,  // 0: types.Unfreeze.fixAmount:type_name -> types.FixAmount
 	2,  // 1: types.Unfreeze.leftProportion:type_name -> types.LeftProportion
-	4,  // 2: types.UnfreezeAction.create:type_name -> types.UnfreezeCreate
-	5,  // 3: types.UnfreezeAction.withdraw:type_name -> types.UnfreezeWithdraw
-	6,  // 4: types.UnfreezeAction.terminate:type_name -> types.UnfreezeTerminate
-	1,  // 5: types.UnfreezeCreate.fixAmount:type_name -> types.FixAmount
-	2,  // 6: types.UnfreezeCreate.leftProportion:type_name -> types.LeftProportion
-	0,  // 7: types.ReceiptUnfreeze.prev:type_name -> types.Unfreeze
-	0,  // 8: types.ReceiptUnfreeze.current:type_name -> types.Unfreeze
-	0,  // 9: types.LocalUnfreeze.unfreeze:type_name -> types.Unfreeze
-	1,  // 10: types.ReplyUnfreeze.fixAmount:type_name -> types.FixAmount
-	2,  // 11: types.ReplyUnfreeze.leftProportion:type_name -> types.LeftProportion
-	11, // 12: types.ReplyUnfreezes.unfreeze:type_name -> types.ReplyUnfreeze
-	13, // 13: types.unfreeze.GetUnfreezeWithdraw:input_type -> types.ReqString
-	13, // 14: types.unfreeze.QueryUnfreeze:input_type -> types.ReqString
-	9,  // 15: types.unfreeze.GetUnfreezeWithdraw:output_type -> types.ReplyQueryUnfreezeWithdraw
-	0,  // 16: types.unfreeze.QueryUnfreeze:output_type -> types.Unfreeze
-	15, // [15:17] is the sub-list for method output_type
-	13, // [13:15] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	3,  // 2: types.Unfreeze.cliffLinear:type_name -> types.CliffLinear
+	4,  // 3: types.Unfreeze.customSchedule:type_name -> types.CustomSchedule
+	5,  // 4: types.CustomSchedule.tranches:type_name -> types.Tranche
+	7,  // 5: types.UnfreezeAction.create:type_name -> types.UnfreezeCreate
+	8,  // 6: types.UnfreezeAction.withdraw:type_name -> types.UnfreezeWithdraw
+	9,  // 7: types.UnfreezeAction.terminate:type_name -> types.UnfreezeTerminate
+	1,  // 8: types.UnfreezeCreate.fixAmount:type_name -> types.FixAmount
+	2,  // 9: types.UnfreezeCreate.leftProportion:type_name -> types.LeftProportion
+	3,  // 10: types.UnfreezeCreate.cliffLinear:type_name -> types.CliffLinear
+	4,  // 11: types.UnfreezeCreate.customSchedule:type_name -> types.CustomSchedule
+	0,  // 12: types.ReceiptUnfreeze.prev:type_name -> types.Unfreeze
+	0,  // 13: types.ReceiptUnfreeze.current:type_name -> types.Unfreeze
+	0,  // 14: types.LocalUnfreeze.unfreeze:type_name -> types.Unfreeze
+	1,  // 15: types.ReplyUnfreeze.fixAmount:type_name -> types.FixAmount
+	2,  // 16: types.ReplyUnfreeze.leftProportion:type_name -> types.LeftProportion
+	3,  // 17: types.ReplyUnfreeze.cliffLinear:type_name -> types.CliffLinear
+	4,  // 18: types.ReplyUnfreeze.customSchedule:type_name -> types.CustomSchedule
+	14, // 19: types.ReplyUnfreezes.unfreeze:type_name -> types.ReplyUnfreeze
+	16, // 20: types.unfreeze.GetUnfreezeWithdraw:input_type -> types.ReqString
+	16, // 21: types.unfreeze.QueryUnfreeze:input_type -> types.ReqString
+	12, // 22: types.unfreeze.GetUnfreezeWithdraw:output_type -> types.ReplyQueryUnfreezeWithdraw
+	0,  // 23: types.unfreeze.QueryUnfreeze:output_type -> types.Unfreeze
+	22, // [22:24] is the sub-list for method output_type
+	20, // [20:22] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_unfreeze_proto_init() }
@@ -1358,7 +1660,7 @@ func file_unfreeze_proto_init() {
 			}
 		}
 		file_unfreeze_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnfreezeAction); i {
+			switch v := v.(*CliffLinear); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1370,7 +1672,7 @@ func file_unfreeze_proto_init() {
 			}
 		}
 		file_unfreeze_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnfreezeCreate); i {
+			switch v := v.(*CustomSchedule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1382,7 +1684,7 @@ func file_unfreeze_proto_init() {
 			}
 		}
 		file_unfreeze_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnfreezeWithdraw); i {
+			switch v := v.(*Tranche); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1394,7 +1696,7 @@ func file_unfreeze_proto_init() {
 			}
 		}
 		file_unfreeze_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnfreezeTerminate); i {
+			switch v := v.(*UnfreezeAction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1406,7 +1708,7 @@ func file_unfreeze_proto_init() {
 			}
 		}
 		file_unfreeze_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReceiptUnfreeze); i {
+			switch v := v.(*UnfreezeCreate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1418,7 +1720,7 @@ func file_unfreeze_proto_init() {
 			}
 		}
 		file_unfreeze_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LocalUnfreeze); i {
+			switch v := v.(*UnfreezeWithdraw); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1430,7 +1732,7 @@ func file_unfreeze_proto_init() {
 			}
 		}
 		file_unfreeze_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReplyQueryUnfreezeWithdraw); i {
+			switch v := v.(*UnfreezeTerminate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1442,7 +1744,7 @@ func file_unfreeze_proto_init() {
 			}
 		}
 		file_unfreeze_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqUnfreezes); i {
+			switch v := v.(*ReceiptUnfreeze); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1454,7 +1756,7 @@ func file_unfreeze_proto_init() {
 			}
 		}
 		file_unfreeze_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReplyUnfreeze); i {
+			switch v := v.(*LocalUnfreeze); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1466,6 +1768,42 @@ func file_unfreeze_proto_init() {
 			}
 		}
 		file_unfreeze_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplyQueryUnfreezeWithdraw); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_unfreeze_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqUnfreezes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_unfreeze_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplyUnfreeze); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_unfreeze_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReplyUnfreezes); i {
 			case 0:
 				return &v.state
@@ -1481,19 +1819,25 @@ func file_unfreeze_proto_init() {
 	file_unfreeze_proto_msgTypes[0].OneofWrappers = []interface{}{
 		(*Unfreeze_FixAmount)(nil),
 		(*Unfreeze_LeftProportion)(nil),
+		(*Unfreeze_CliffLinear)(nil),
+		(*Unfreeze_CustomSchedule)(nil),
 	}
-	file_unfreeze_proto_msgTypes[3].OneofWrappers = []interface{}{
+	file_unfreeze_proto_msgTypes[6].OneofWrappers = []interface{}{
 		(*UnfreezeAction_Create)(nil),
 		(*UnfreezeAction_Withdraw)(nil),
 		(*UnfreezeAction_Terminate)(nil),
 	}
-	file_unfreeze_proto_msgTypes[4].OneofWrappers = []interface{}{
+	file_unfreeze_proto_msgTypes[7].OneofWrappers = []interface{}{
 		(*UnfreezeCreate_FixAmount)(nil),
 		(*UnfreezeCreate_LeftProportion)(nil),
+		(*UnfreezeCreate_CliffLinear)(nil),
+		(*UnfreezeCreate_CustomSchedule)(nil),
 	}
-	file_unfreeze_proto_msgTypes[11].OneofWrappers = []interface{}{
+	file_unfreeze_proto_msgTypes[14].OneofWrappers = []interface{}{
 		(*ReplyUnfreeze_FixAmount)(nil),
 		(*ReplyUnfreeze_LeftProportion)(nil),
+		(*ReplyUnfreeze_CliffLinear)(nil),
+		(*ReplyUnfreeze_CustomSchedule)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1501,7 +1845,7 @@ func file_unfreeze_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_unfreeze_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,250 @@
+package feemarket
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/33cn/chain33/common/skiplist"
+	"github.com/33cn/chain33/system/mempool"
+	"github.com/33cn/chain33/types"
+)
+
+// maxBaseFeeHistory 保存最近区块的基础费率, 用于区块回滚时恢复
+const maxBaseFeeHistory = 256
+
+// ErrReplaceUnderpriced 替换交易的手续费率提高不足
+var ErrReplaceUnderpriced = errors.New("ErrReplaceUnderpriced")
+
+// Queue 手续费市场队列模式(基础费率随区块交易数浮动, 低于基础费率的交易不能进入mempool,
+// 费率=手续费/交易单元数,超过基础费率的部分为小费, 小费高者优先, 同价则时间早优先)
+type Queue struct {
+	*skiplist.Queue
+	subConfig subConfig
+
+	mtx        sync.RWMutex
+	baseFee    int64
+	lastHeight int64
+	history    map[int64]int64
+	// from:nonce -> tx hash, 用于同一账户相同nonce的交易替换
+	nonces map[string]string
+	// 已经被替换等待删除的交易, 不再打包
+	replaced  map[string]bool
+	onReplace func(hash []byte)
+}
+
+type feeScore struct {
+	*mempool.Item
+}
+
+// GetScore 基础费率对所有交易相同, 按费率排序即按小费排序
+func (item *feeScore) GetScore() int64 {
+	return feeRate(item.Value)
+}
+
+func (item *feeScore) Hash() []byte {
+	return item.Value.Hash()
+}
+
+func (item *feeScore) Compare(cmp skiplist.Scorer) int {
+	it := cmp.(*feeScore)
+	//时间越小，权重越高
+	if item.EnterTime < it.EnterTime {
+		return skiplist.Big
+	}
+	if item.EnterTime == it.EnterTime {
+		return skiplist.Equal
+	}
+	return skiplist.Small
+}
+
+func (item *feeScore) ByteSize() int64 {
+	return int64(item.Value.Size())
+}
+
+// feeRate 交易的手续费率, 与最低手续费的计算方式相同, 每1000字节为一个交易单元
+func feeRate(tx *types.Transaction) int64 {
+	unitFeeNum := int64(tx.Size()/1000 + 1)
+	return tx.Fee / unitFeeNum
+}
+
+func nonceKey(tx *types.Transaction) string {
+	return fmt.Sprintf("%s:%d", tx.From(), tx.Nonce)
+}
+
+// NewQueue 创建队列
+func NewQueue(subcfg subConfig) *Queue {
+	return &Queue{
+		Queue:      skiplist.NewQueue(subcfg.PoolCacheSize),
+		subConfig:  subcfg,
+		baseFee:    subcfg.MinBaseFee,
+		lastHeight: -1,
+		history:    make(map[int64]int64),
+		nonces:     make(map[string]string),
+		replaced:   make(map[string]bool),
+	}
+}
+
+//GetItem 获取数据通过 key
+func (cache *Queue) GetItem(hash string) (*mempool.Item, error) {
+	item, err := cache.Queue.GetItem(hash)
+	if err != nil {
+		return nil, err
+	}
+	return item.(*feeScore).Item, nil
+}
+
+//Push 加入数据到队列, 费率需要不低于基础费率, 同一账户相同nonce的交易提高费率后可以替换
+func (cache *Queue) Push(item *mempool.Item) error {
+	rate := feeRate(item.Value)
+	if rate < cache.GetBaseFee() {
+		return types.ErrTxFeeTooLow
+	}
+	hash := string(item.Value.Hash())
+	key := nonceKey(item.Value)
+
+	cache.mtx.Lock()
+	defer cache.mtx.Unlock()
+	oldHash, ok := cache.nonces[key]
+	if !ok || oldHash == hash || cache.replaced[oldHash] {
+		return cache.push(key, hash, item)
+	}
+	old, err := cache.Queue.GetItem(oldHash)
+	if err != nil {
+		// 已经被挤出队列
+		return cache.push(key, hash, item)
+	}
+	oldRate := old.GetScore()
+	if rate*100 < oldRate*(100+cache.subConfig.PriceBump) {
+		return ErrReplaceUnderpriced
+	}
+	err = cache.push(key, hash, item)
+	if err != nil {
+		return err
+	}
+	cache.replaced[oldHash] = true
+	if cache.onReplace != nil {
+		cache.onReplace([]byte(oldHash))
+	}
+	return nil
+}
+
+func (cache *Queue) push(key, hash string, item *mempool.Item) error {
+	err := cache.Queue.Push(&feeScore{Item: item})
+	if err != nil {
+		return err
+	}
+	cache.nonces[key] = hash
+	return nil
+}
+
+//Remove 删除数据
+func (cache *Queue) Remove(hash string) error {
+	item, err := cache.Queue.GetItem(hash)
+	if err != nil {
+		return err
+	}
+	cache.mtx.Lock()
+	key := nonceKey(item.(*feeScore).Value)
+	if cache.nonces[key] == hash {
+		delete(cache.nonces, key)
+	}
+	delete(cache.replaced, hash)
+	cache.mtx.Unlock()
+	return cache.Queue.Remove(hash)
+}
+
+//Walk 遍历队列, 跳过已经被替换的交易
+func (cache *Queue) Walk(count int, cb func(tx *mempool.Item) bool) {
+	cache.Queue.Walk(count, func(item skiplist.Scorer) bool {
+		if cache.isReplaced(string(item.Hash())) {
+			return true
+		}
+		return cb(item.(*feeScore).Item)
+	})
+}
+
+func (cache *Queue) isReplaced(hash string) bool {
+	cache.mtx.RLock()
+	defer cache.mtx.RUnlock()
+	return cache.replaced[hash]
+}
+
+//GetBaseFee 获取当前的基础费率
+func (cache *Queue) GetBaseFee() int64 {
+	cache.mtx.RLock()
+	defer cache.mtx.RUnlock()
+	return cache.baseFee
+}
+
+// GetProperFee 获取合适的手续费率, 基础费率加上前100笔交易小费的中位数
+func (cache *Queue) GetProperFee() int64 {
+	baseFee := cache.GetBaseFee()
+	tip := cache.subConfig.PriorityFee
+	if cache.Size() < 100 {
+		return baseFee + tip
+	}
+	var tips []int64
+	cache.Walk(100, func(item *mempool.Item) bool {
+		if t := feeRate(item.Value) - baseFee; t > 0 {
+			tips = append(tips, t)
+		}
+		return true
+	})
+	// 按费率从高到低遍历, 中间的即为中位数
+	if len(tips) > 0 && tips[len(tips)/2] > tip {
+		tip = tips[len(tips)/2]
+	}
+	return baseFee + tip
+}
+
+//AddBlock 根据区块交易数相对目标交易数的比例调整基础费率
+func (cache *Queue) AddBlock(height, txCount, maxTxNumber int64) {
+	cache.mtx.Lock()
+	defer cache.mtx.Unlock()
+	if height <= cache.lastHeight {
+		return
+	}
+	target := maxTxNumber * cache.subConfig.TargetPercent / 100
+	if target <= 0 {
+		target = 1
+	}
+	denominator := cache.subConfig.BaseFeeChangeDenominator
+	baseFee := cache.baseFee
+	if txCount > target {
+		delta := baseFee * (txCount - target) / target / denominator
+		if delta < 1 {
+			delta = 1
+		}
+		baseFee += delta
+	} else if txCount < target {
+		baseFee -= baseFee * (target - txCount) / target / denominator
+	}
+	if baseFee < cache.subConfig.MinBaseFee {
+		baseFee = cache.subConfig.MinBaseFee
+	}
+	if cache.subConfig.MaxBaseFee > 0 && baseFee > cache.subConfig.MaxBaseFee {
+		baseFee = cache.subConfig.MaxBaseFee
+	}
+	// 记录打包该区块时的基础费率
+	cache.history[height-1] = cache.baseFee
+	delete(cache.history, height-1-maxBaseFeeHistory)
+	cache.baseFee = baseFee
+	cache.lastHeight = height
+}
+
+//DelBlock 区块回滚时恢复到上一个区块的基础费率
+func (cache *Queue) DelBlock(height int64) {
+	cache.mtx.Lock()
+	defer cache.mtx.Unlock()
+	if height != cache.lastHeight {
+		return
+	}
+	baseFee, ok := cache.history[height-1]
+	if !ok {
+		return
+	}
+	delete(cache.history, height-1)
+	cache.baseFee = baseFee
+	cache.lastHeight = height - 1
+}
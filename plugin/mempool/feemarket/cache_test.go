@@ -0,0 +1,179 @@
+package feemarket
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	cty "github.com/33cn/chain33/system/dapp/coins/types"
+	drivers "github.com/33cn/chain33/system/mempool"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+
+	_ "github.com/33cn/chain33/system/crypto/init"
+)
+
+var (
+	c, _       = crypto.Load(types.GetSignName("", types.SECP256K1), -1)
+	hex        = "CC38546E9E659D15E6B4893F0AB32A06D103931A8230B0BDE71459D2B27D6944"
+	a, _       = common.FromHex(hex)
+	privKey, _ = c.PrivKeyFromBytes(a)
+	toAddr     = address.PubKeyToAddr(address.DefaultID, privKey.PubKey().Bytes())
+	amount     = int64(1e8)
+	v          = &cty.CoinsAction_Transfer{Transfer: &types.AssetsTransfer{Amount: amount}}
+	transfer   = &cty.CoinsAction{Value: v, Ty: cty.CoinsActionTransfer}
+)
+
+func initEnv(size int64) *Queue {
+	if size == 0 {
+		size = 1000
+	}
+	_, sub := types.InitCfg("chain33.test.toml")
+	var subcfg subConfig
+	types.MustDecode(sub.Mempool["feemarket"], &subcfg)
+	subcfg.PoolCacheSize = size
+	cache := NewQueue(subcfg)
+	return cache
+}
+
+func newItem(fee, nonce int64, priv crypto.PrivKey) *drivers.Item {
+	tx := &types.Transaction{Execer: []byte("coins"), Payload: types.Encode(transfer), Fee: fee, Nonce: nonce, To: toAddr}
+	tx.Sign(types.SECP256K1, priv)
+	return &drivers.Item{Value: tx, Priority: tx.Fee, EnterTime: types.Now().Unix()}
+}
+
+func TestMemFull(t *testing.T) {
+	cache := initEnv(1)
+	item1 := newItem(1000000, 1, privKey)
+	hash := string(item1.Value.Hash())
+	err := cache.Push(item1)
+	assert.Nil(t, err)
+	assert.Equal(t, true, cache.Exist(hash))
+	it, err := cache.GetItem(hash)
+	assert.Nil(t, err)
+	assert.Equal(t, item1, it)
+
+	err = cache.Push(item1)
+	assert.Equal(t, types.ErrTxExist, err)
+
+	err = cache.Push(newItem(1000000, 2, privKey))
+	assert.Equal(t, types.ErrMemFull, err)
+
+	assert.Nil(t, cache.Remove(hash))
+	assert.Equal(t, 0, cache.Size())
+	assert.Len(t, cache.nonces, 0)
+}
+
+func TestBaseFee(t *testing.T) {
+	cache := initEnv(0)
+	minBaseFee := cache.subConfig.MinBaseFee
+	assert.Equal(t, minBaseFee, cache.GetBaseFee())
+
+	// 区块交易数低于目标时不低于最小基础费率
+	cache.AddBlock(1, 0, 100)
+	assert.Equal(t, minBaseFee, cache.GetBaseFee())
+	// 满区块上涨1/8
+	cache.AddBlock(2, 100, 100)
+	assert.Equal(t, minBaseFee*9/8, cache.GetBaseFee())
+	cache.AddBlock(3, 100, 100)
+	assert.Equal(t, minBaseFee*9/8*9/8, cache.GetBaseFee())
+	// 重复的区块不再调整
+	cache.AddBlock(3, 100, 100)
+	assert.Equal(t, minBaseFee*9/8*9/8, cache.GetBaseFee())
+	// 达到目标时不变
+	cache.AddBlock(4, 50, 100)
+	assert.Equal(t, minBaseFee*9/8*9/8, cache.GetBaseFee())
+
+	// 低于基础费率的交易不能进入mempool
+	assert.Equal(t, types.ErrTxFeeTooLow, cache.Push(newItem(minBaseFee, 1, privKey)))
+	assert.Nil(t, cache.Push(newItem(minBaseFee*2, 1, privKey)))
+
+	// 回滚区块恢复基础费率
+	cache.DelBlock(4)
+	assert.Equal(t, minBaseFee*9/8*9/8, cache.GetBaseFee())
+	cache.DelBlock(3)
+	assert.Equal(t, minBaseFee*9/8, cache.GetBaseFee())
+	cache.DelBlock(3)
+	assert.Equal(t, minBaseFee*9/8, cache.GetBaseFee())
+
+	// 空区块下降1/8
+	cache.AddBlock(3, 100, 100)
+	cache.AddBlock(4, 0, 100)
+	assert.Equal(t, minBaseFee*9/8*9/8-minBaseFee*9/8*9/8/8, cache.GetBaseFee())
+
+	// 不超过最大基础费率
+	for i := int64(5); i < 100; i++ {
+		cache.AddBlock(i, 100, 100)
+	}
+	assert.Equal(t, cache.subConfig.MaxBaseFee, cache.GetBaseFee())
+}
+
+func TestReplaceByFee(t *testing.T) {
+	cache := initEnv(0)
+	var replaced [][]byte
+	cache.onReplace = func(hash []byte) {
+		replaced = append(replaced, hash)
+	}
+	item1 := newItem(1000000, 1, privKey)
+	assert.Nil(t, cache.Push(item1))
+	// 不同nonce的交易不替换
+	item2 := newItem(1000000, 2, privKey)
+	assert.Nil(t, cache.Push(item2))
+	// 其他账户相同nonce的交易不替换
+	priv, err := c.GenKey()
+	assert.Nil(t, err)
+	assert.Nil(t, cache.Push(newItem(1000001, 1, priv)))
+	assert.Len(t, replaced, 0)
+
+	// 手续费提高不足10%
+	assert.Equal(t, ErrReplaceUnderpriced, cache.Push(newItem(1050000, 1, privKey)))
+	item3 := newItem(1100000, 1, privKey)
+	assert.Nil(t, cache.Push(item3))
+	assert.Equal(t, [][]byte{item1.Value.Hash()}, replaced)
+
+	// 被替换的交易等待删除, 不会被打包
+	var hashes []string
+	cache.Walk(0, func(item *drivers.Item) bool {
+		hashes = append(hashes, string(item.Value.Hash()))
+		return true
+	})
+	assert.Len(t, hashes, 3)
+	assert.Equal(t, string(item3.Value.Hash()), hashes[0])
+	assert.NotContains(t, hashes, string(item1.Value.Hash()))
+
+	assert.Nil(t, cache.Remove(string(item1.Value.Hash())))
+	assert.Equal(t, 3, cache.Size())
+	assert.Equal(t, string(item3.Value.Hash()), cache.nonces[nonceKey(item3.Value)])
+	assert.Len(t, cache.replaced, 0)
+}
+
+func TestGetProperFee(t *testing.T) {
+	cache := initEnv(0)
+	baseFee := cache.GetBaseFee()
+	priorityFee := cache.subConfig.PriorityFee
+	assert.Equal(t, baseFee+priorityFee, cache.GetProperFee())
+
+	for i := 0; i < 60; i++ {
+		assert.Nil(t, cache.Push(newItem(baseFee*2, int64(i), privKey)))
+	}
+	assert.Equal(t, baseFee+priorityFee, cache.GetProperFee())
+	for i := 60; i < 100; i++ {
+		assert.Nil(t, cache.Push(newItem(baseFee, int64(i), privKey)))
+	}
+	// 前100笔交易小费的中位数
+	assert.Equal(t, baseFee*2, cache.GetProperFee())
+
+	// 基础费率上涨之后, 小费随之减少
+	cache.AddBlock(1, 100, 100)
+	assert.True(t, cache.GetBaseFee() > baseFee)
+	assert.Equal(t, baseFee*2, cache.GetProperFee())
+	cache.AddBlock(2, 100, 100)
+	cache.AddBlock(3, 100, 100)
+	cache.AddBlock(4, 100, 100)
+	cache.AddBlock(5, 100, 100)
+	cache.AddBlock(6, 100, 100)
+	// 基础费率超过所有交易的费率
+	assert.True(t, cache.GetBaseFee() > baseFee*2)
+	assert.Equal(t, cache.GetBaseFee()+priorityFee, cache.GetProperFee())
+}
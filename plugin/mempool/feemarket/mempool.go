@@ -0,0 +1,113 @@
+package feemarket
+
+import (
+	"sync"
+
+	"github.com/33cn/chain33/queue"
+	drivers "github.com/33cn/chain33/system/mempool"
+	"github.com/33cn/chain33/types"
+)
+
+//--------------------------------------------------------------------------------
+// Module Mempool
+
+type subConfig struct {
+	PoolCacheSize int64 `json:"poolCacheSize"`
+	// 基础费率的下限和上限, 默认为minTxFeeRate和maxTxFeeRate
+	MinBaseFee int64 `json:"minBaseFee"`
+	MaxBaseFee int64 `json:"maxBaseFee"`
+	// 区块目标交易数占maxTxNumber的百分比, 超过目标时基础费率上涨, 反之下降
+	TargetPercent int64 `json:"targetPercent"`
+	// 每个区块基础费率的最大变化比例为1/BaseFeeChangeDenominator
+	BaseFeeChangeDenominator int64 `json:"baseFeeChangeDenominator"`
+	// 估算手续费时最低的小费费率
+	PriorityFee int64 `json:"priorityFee"`
+	// 同一账户相同nonce的交易替换时, 手续费率至少需要提高的百分比
+	PriceBump int64 `json:"priceBump"`
+}
+
+func init() {
+	drivers.Reg("feemarket", New)
+}
+
+// Mempool 在基础mempool上根据区块交易数调整基础费率
+type Mempool struct {
+	*drivers.Mempool
+	queue *Queue
+}
+
+//New 创建feemarket cache 结构的 mempool
+func New(cfg *types.Mempool, sub []byte) queue.Module {
+	c := drivers.NewMempool(cfg)
+	var subcfg subConfig
+	types.MustDecode(sub, &subcfg)
+	if subcfg.PoolCacheSize == 0 {
+		subcfg.PoolCacheSize = cfg.PoolCacheSize
+	}
+	if subcfg.MinBaseFee == 0 {
+		subcfg.MinBaseFee = cfg.MinTxFeeRate
+	}
+	if subcfg.MaxBaseFee == 0 {
+		subcfg.MaxBaseFee = cfg.MaxTxFeeRate
+	}
+	if subcfg.TargetPercent <= 0 || subcfg.TargetPercent > 100 {
+		subcfg.TargetPercent = 50
+	}
+	if subcfg.BaseFeeChangeDenominator <= 0 {
+		subcfg.BaseFeeChangeDenominator = 8
+	}
+	if subcfg.PriceBump <= 0 {
+		subcfg.PriceBump = 10
+	}
+	qcache := NewQueue(subcfg)
+	// 被替换的交易需要通过mempool删除, 才能同时清理账户索引等缓存, Push时mempool已加锁, 所以异步删除
+	qcache.onReplace = func(hash []byte) {
+		go c.RemoveTxs(&types.TxHashList{Hashes: [][]byte{hash}})
+	}
+	c.SetQueueCache(qcache)
+	return &Mempool{Mempool: c, queue: qcache}
+}
+
+//SetQueueClient 监听区块的添加和回滚消息, 用于调整基础费率
+func (mem *Mempool) SetQueueClient(client queue.Client) {
+	mem.Mempool.SetQueueClient(&blockClient{Client: client, queue: mem.queue})
+}
+
+// blockClient 转发所有消息给mempool, 转发之前根据区块更新基础费率
+type blockClient struct {
+	queue.Client
+	queue *Queue
+	once  sync.Once
+	recv  chan *queue.Message
+}
+
+func (client *blockClient) Recv() chan *queue.Message {
+	client.once.Do(func() {
+		client.recv = make(chan *queue.Message, cap(client.Client.Recv()))
+		go func() {
+			defer close(client.recv)
+			for msg := range client.Client.Recv() {
+				client.handleBlock(msg)
+				client.recv <- msg
+			}
+		}()
+	})
+	return client.recv
+}
+
+func (client *blockClient) handleBlock(msg *queue.Message) {
+	if msg.Ty != types.EventAddBlock && msg.Ty != types.EventDelBlock {
+		return
+	}
+	detail, ok := msg.GetData().(*types.BlockDetail)
+	if !ok || detail.GetBlock() == nil {
+		return
+	}
+	block := detail.GetBlock()
+	if msg.Ty == types.EventDelBlock {
+		client.queue.DelBlock(block.Height)
+		return
+	}
+	maxTxNumber := client.GetConfig().GetP(block.Height).MaxTxNumber
+	client.queue.AddBlock(block.Height, int64(len(block.Txs)), maxTxNumber)
+}
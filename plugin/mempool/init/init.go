@@ -1,7 +1,8 @@
 package init
 
 import (
-	_ "github.com/33cn/plugin/plugin/mempool/para"  //auto gen
-	_ "github.com/33cn/plugin/plugin/mempool/price" //auto gen
-	_ "github.com/33cn/plugin/plugin/mempool/score" //auto gen
+	_ "github.com/33cn/plugin/plugin/mempool/feemarket" //auto gen
+	_ "github.com/33cn/plugin/plugin/mempool/para"      //auto gen
+	_ "github.com/33cn/plugin/plugin/mempool/price"     //auto gen
+	_ "github.com/33cn/plugin/plugin/mempool/score"     //auto gen
 )
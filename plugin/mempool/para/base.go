@@ -2,19 +2,27 @@ package para
 
 import (
 	"context"
+	"errors"
 	"sync/atomic"
+	"time"
 
 	"sync"
 
+	"github.com/33cn/chain33/common"
 	log "github.com/33cn/chain33/common/log/log15"
 	"github.com/33cn/chain33/queue"
 	"github.com/33cn/chain33/rpc/grpcclient"
 	"github.com/33cn/chain33/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var mlog = log.New("module", "mempool.para")
 var topic = "mempool"
 
+// ErrTxTitleNotMatch 其他平行链的交易
+var ErrTxTitleNotMatch = errors.New("ErrTxTitleNotMatch")
+
 //Mempool mempool 基础类
 type Mempool struct {
 	key         string
@@ -22,12 +30,45 @@ type Mempool struct {
 	client      queue.Client
 	mainGrpcCli types.Chain33Client
 	isclose     int32
+	done        chan struct{}
+	subConfig   subConfig
+	cache       *txCache
+	// 平行链最新的区块头, 用于本地检查交易签名和过期
+	header *types.Header
 }
 
 //NewMempool 新建mempool 实例
 func NewMempool(cfg *types.Mempool) *Mempool {
+	return newMempool(cfg, subConfig{})
+}
+
+func newMempool(cfg *types.Mempool, subcfg subConfig) *Mempool {
+	if subcfg.DedupTTL <= 0 {
+		subcfg.DedupTTL = defaultDedupTTL
+	}
+	if subcfg.PendingTTL <= 0 {
+		subcfg.PendingTTL = defaultPendingTTL
+	}
+	if subcfg.MaxPending <= 0 && cfg != nil {
+		subcfg.MaxPending = cfg.PoolCacheSize
+	}
+	if subcfg.MaxPending <= 0 {
+		subcfg.MaxPending = defaultMaxPending
+	}
+	if subcfg.RetryInterval <= 0 {
+		subcfg.RetryInterval = defaultRetryInterval
+	}
+	if subcfg.MaxRetry <= 0 {
+		subcfg.MaxRetry = defaultMaxRetry
+	}
+	if subcfg.SendTimeout <= 0 {
+		subcfg.SendTimeout = defaultSendTimeout
+	}
 	pool := &Mempool{}
 	pool.key = topic
+	pool.done = make(chan struct{})
+	pool.subConfig = subcfg
+	pool.cache = newTxCache(subcfg)
 	return pool
 }
 
@@ -37,6 +78,8 @@ func (mem *Mempool) SetQueueClient(client queue.Client) {
 	mem.client.Sub(mem.key)
 	mem.setMainGrpcCli(client.GetConfig())
 	mem.wg.Add(1)
+	go mem.retrySendTx()
+	mem.wg.Add(1)
 	go func() {
 		defer mem.wg.Done()
 		for msg := range client.Recv() {
@@ -46,13 +89,22 @@ func (mem *Mempool) SetQueueClient(client queue.Client) {
 			case types.EventTx:
 				mlog.Info("Receive msg from para mempool")
 				tx := msg.GetData().(*types.Transaction)
-				reply, err = mem.mainGrpcCli.SendTransaction(context.Background(), tx)
+				reply, err = mem.sendTx(tx)
 			case types.EventGetProperFee:
 				reply, err = mem.mainGrpcCli.GetProperFee(context.Background(), &types.ReqProperFee{})
 			case types.EventGetMempoolSize:
-				// 消息类型EventGetMempoolSize：获取mempool大小
-				size := types.Conf(client.GetConfig(), "config.mempool").GInt("poolCacheSize")
-				msg.Reply(mem.client.NewMessage("rpc", types.EventMempoolSize, &types.MempoolSize{Size: size}))
+				// 消息类型EventGetMempoolSize：获取本地还没有打包的交易数
+				msg.Reply(mem.client.NewMessage("rpc", types.EventMempoolSize, &types.MempoolSize{Size: int64(mem.cache.Size())}))
+				continue
+			case types.EventGetMempool:
+				// 消息类型EventGetMempool：获取本地还没有打包的交易
+				msg.Reply(mem.client.NewMessage("rpc", types.EventReplyTxList, &types.ReplyTxList{Txs: mem.cache.GetTxs()}))
+				continue
+			case types.EventAddBlock:
+				mem.addBlock(msg.GetData().(*types.BlockDetail).Block)
+				continue
+			case types.EventDelBlock:
+				mem.delBlock(msg.GetData().(*types.BlockDetail).Block)
 				continue
 			default:
 				msg.Reply(client.NewMessage(mem.key, types.EventReply, types.ErrActionNotSupport))
@@ -67,7 +119,148 @@ func (mem *Mempool) SetQueueClient(client queue.Client) {
 	}()
 }
 
+// sendTx 本地检查交易后转发到主链, 主链节点暂时不可用时交易保留在本地, 由重试协程转发
+func (mem *Mempool) sendTx(tx *types.Transaction) (*types.Reply, error) {
+	err := mem.checkTx(tx)
+	if err != nil {
+		return nil, err
+	}
+	hash := string(tx.Hash())
+	err = mem.cache.Push(tx, false, types.Now().Unix())
+	if err != nil {
+		return nil, err
+	}
+	reply, err := mem.sendToMain(tx)
+	if err == nil {
+		mem.cache.SetSent(hash)
+		return reply, nil
+	}
+	if isUnavailable(err) {
+		mlog.Error("para mempool main node unavailable, retry later", "hash", common.ToHex(tx.Hash()), "err", err)
+		return &types.Reply{IsOk: true, Msg: tx.Hash()}, nil
+	}
+	mem.cache.Remove(hash)
+	return nil, err
+}
+
+// retrySendTx 定时重新转发主链节点不可用时没有发送成功的交易, 同时清理过期的缓存
+func (mem *Mempool) retrySendTx() {
+	defer mem.wg.Done()
+	ticker := time.NewTicker(time.Duration(mem.subConfig.RetryInterval) * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-mem.done:
+			return
+		case <-ticker.C:
+		}
+		mem.cache.RemoveExpired(types.Now().Unix())
+		mem.resendUnsent()
+	}
+}
+
+// resendUnsent 重新转发没有发送成功的交易
+func (mem *Mempool) resendUnsent() {
+	for _, tx := range mem.cache.Unsent(int(mem.subConfig.MaxRetry)) {
+		hash := string(tx.Hash())
+		// 只有实际转发过的交易才增加重试次数
+		mem.cache.AddRetry(hash)
+		_, err := mem.sendToMain(tx)
+		if err == nil {
+			mem.cache.SetSent(hash)
+			continue
+		}
+		if isUnavailable(err) {
+			// 主链节点依然不可用, 剩余的交易下次再试, 不计入重试次数
+			return
+		}
+		mlog.Error("para mempool retry send tx fail", "hash", common.ToHex(tx.Hash()), "err", err)
+		mem.cache.Remove(hash)
+	}
+}
+
+// sendToMain 转发交易到主链, 主链节点没有响应时超时返回, 避免阻塞消息处理和重试协程
+func (mem *Mempool) sendToMain(tx *types.Transaction) (*types.Reply, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(mem.subConfig.SendTimeout)*time.Millisecond)
+	defer cancel()
+	return mem.mainGrpcCli.SendTransaction(ctx, tx)
+}
+
+func isUnavailable(err error) bool {
+	code := status.Code(err)
+	return code == codes.Unavailable || code == codes.DeadlineExceeded
+}
+
+// checkTx 本地检查签名, 过期和平行链title, 避免无效的交易转发到主链
+func (mem *Mempool) checkTx(tx *types.Transaction) error {
+	cfg := mem.client.GetConfig()
+	txs := []*types.Transaction{tx}
+	group, err := tx.GetTxGroup()
+	if err != nil {
+		return err
+	}
+	if group != nil {
+		txs = group.GetTxs()
+	}
+	for _, item := range txs {
+		exec := string(item.Execer)
+		if types.IsParaExecName(exec) && !cfg.IsMyParaExecName(exec) {
+			return ErrTxTitleNotMatch
+		}
+	}
+	header := mem.getHeader()
+	if header == nil {
+		// 获取不到平行链高度时, 由主链检查
+		return nil
+	}
+	if !types.NewTransactionCache(tx).CheckSign(header.GetHeight() + 1) {
+		return types.ErrSign
+	}
+	if tx.IsExpire(cfg, header.GetHeight()+1, header.GetBlockTime()) {
+		return types.ErrTxExpire
+	}
+	return nil
+}
+
+func (mem *Mempool) getHeader() *types.Header {
+	if mem.header != nil {
+		return mem.header
+	}
+	msg := mem.client.NewMessage("blockchain", types.EventGetLastHeader, nil)
+	err := mem.client.SendTimeout(msg, true, time.Second)
+	if err != nil {
+		mlog.Error("para mempool get last header fail", "err", err)
+		return nil
+	}
+	resp, err := mem.client.WaitTimeout(msg, time.Second)
+	if err != nil {
+		mlog.Error("para mempool get last header fail", "err", err)
+		return nil
+	}
+	if header, ok := resp.GetData().(*types.Header); ok {
+		mem.header = header
+	}
+	return mem.header
+}
+
+func (mem *Mempool) addBlock(block *types.Block) {
+	if mem.header == nil || block.Height > mem.header.Height {
+		mem.header = &types.Header{Height: block.Height, BlockTime: block.BlockTime}
+	}
+	mem.cache.RemoveTxs(block.Txs)
+}
+
+// delBlock 回滚区块的交易由主链重新打包, 本地只更新高度
+func (mem *Mempool) delBlock(block *types.Block) {
+	if mem.header != nil && block.Height <= mem.header.Height {
+		mem.header = nil
+	}
+}
+
 func (mem *Mempool) setMainGrpcCli(cfg *types.Chain33Config) {
+	if mem.mainGrpcCli != nil {
+		return
+	}
 	if cfg != nil && cfg.IsPara() {
 		grpcCli, err := grpcclient.NewMainChainClient(cfg, "")
 		if err != nil {
@@ -85,6 +278,7 @@ func (mem *Mempool) Close() {
 	if !atomic.CompareAndSwapInt32(&mem.isclose, 0, 1) {
 		return
 	}
+	close(mem.done)
 	if mem.client != nil {
 		mem.client.Close()
 	}
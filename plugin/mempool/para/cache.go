@@ -0,0 +1,153 @@
+package para
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/types"
+)
+
+// pendingTx 已经转发或等待重新转发到主链的交易
+type pendingTx struct {
+	tx        *types.Transaction
+	enterTime int64
+	// 主链节点暂时不可用时未转发成功, 由重试协程重新转发
+	sent  bool
+	retry int
+}
+
+// txCache 本地交易缓存, dedup用于短时间内的重复交易过滤, pending记录还没有打包进平行链区块的交易
+type txCache struct {
+	mtx        sync.RWMutex
+	dedupTTL   int64
+	pendingTTL int64
+	maxPending int
+	dedup      map[string]int64
+	pending    map[string]*pendingTx
+}
+
+func newTxCache(cfg subConfig) *txCache {
+	return &txCache{
+		dedupTTL:   cfg.DedupTTL,
+		pendingTTL: cfg.PendingTTL,
+		maxPending: int(cfg.MaxPending),
+		dedup:      make(map[string]int64),
+		pending:    make(map[string]*pendingTx),
+	}
+}
+
+// Push 加入本地缓存, 重复的交易返回ErrTxExist
+func (cache *txCache) Push(tx *types.Transaction, sent bool, now int64) error {
+	hash := string(tx.Hash())
+	cache.mtx.Lock()
+	defer cache.mtx.Unlock()
+	if t, ok := cache.dedup[hash]; ok && now-t < cache.dedupTTL {
+		return types.ErrTxExist
+	}
+	if _, ok := cache.pending[hash]; ok {
+		return types.ErrTxExist
+	}
+	if len(cache.pending) >= cache.maxPending {
+		return types.ErrMemFull
+	}
+	cache.dedup[hash] = now
+	cache.pending[hash] = &pendingTx{tx: tx, enterTime: now, sent: sent}
+	return nil
+}
+
+// Remove 转发失败的交易从缓存中删除, 允许重新发送
+func (cache *txCache) Remove(hash string) {
+	cache.mtx.Lock()
+	defer cache.mtx.Unlock()
+	delete(cache.dedup, hash)
+	delete(cache.pending, hash)
+}
+
+// RemoveTxs 区块打包的交易不再是pending状态, dedup记录保留到过期
+func (cache *txCache) RemoveTxs(txs []*types.Transaction) {
+	cache.mtx.Lock()
+	defer cache.mtx.Unlock()
+	for _, tx := range txs {
+		delete(cache.pending, string(tx.Hash()))
+	}
+}
+
+// SetSent 标记交易转发成功
+func (cache *txCache) SetSent(hash string) {
+	cache.mtx.Lock()
+	defer cache.mtx.Unlock()
+	if ptx, ok := cache.pending[hash]; ok {
+		ptx.sent = true
+	}
+}
+
+// Unsent 返回需要重新转发的交易, 重试次数达到maxRetry的交易被删除
+func (cache *txCache) Unsent(maxRetry int) []*types.Transaction {
+	cache.mtx.Lock()
+	defer cache.mtx.Unlock()
+	var txs []*types.Transaction
+	for hash, ptx := range cache.pending {
+		if ptx.sent {
+			continue
+		}
+		if ptx.retry >= maxRetry {
+			mlog.Error("para mempool drop tx after retry", "hash", common.ToHex(ptx.tx.Hash()), "retry", ptx.retry)
+			delete(cache.pending, hash)
+			delete(cache.dedup, hash)
+			continue
+		}
+		txs = append(txs, ptx.tx)
+	}
+	return txs
+}
+
+// AddRetry 交易重新转发前增加重试次数
+func (cache *txCache) AddRetry(hash string) {
+	cache.mtx.Lock()
+	defer cache.mtx.Unlock()
+	if ptx, ok := cache.pending[hash]; ok {
+		ptx.retry++
+	}
+}
+
+// RemoveExpired 删除过期的dedup记录和pending交易
+func (cache *txCache) RemoveExpired(now int64) {
+	cache.mtx.Lock()
+	defer cache.mtx.Unlock()
+	for hash, t := range cache.dedup {
+		if now-t >= cache.dedupTTL {
+			delete(cache.dedup, hash)
+		}
+	}
+	for hash, ptx := range cache.pending {
+		if now-ptx.enterTime >= cache.pendingTTL {
+			delete(cache.pending, hash)
+		}
+	}
+}
+
+// Size pending交易数
+func (cache *txCache) Size() int {
+	cache.mtx.RLock()
+	defer cache.mtx.RUnlock()
+	return len(cache.pending)
+}
+
+// GetTxs 按进入时间返回pending交易
+func (cache *txCache) GetTxs() []*types.Transaction {
+	cache.mtx.RLock()
+	list := make([]*pendingTx, 0, len(cache.pending))
+	for _, ptx := range cache.pending {
+		list = append(list, ptx)
+	}
+	cache.mtx.RUnlock()
+	sort.SliceStable(list, func(i, j int) bool {
+		return list[i].enterTime < list[j].enterTime
+	})
+	txs := make([]*types.Transaction, len(list))
+	for i, ptx := range list {
+		txs[i] = ptx.tx
+	}
+	return txs
+}
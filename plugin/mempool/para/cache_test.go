@@ -0,0 +1,179 @@
+package para
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	_ "github.com/33cn/chain33/system/crypto/init"
+)
+
+func newTestTx(nonce int64) *types.Transaction {
+	return &types.Transaction{Execer: []byte("user.p.test.none"), Payload: []byte("none"), Fee: 100000, Nonce: nonce}
+}
+
+func TestTxCache(t *testing.T) {
+	cache := newTxCache(subConfig{DedupTTL: 10, PendingTTL: 100, MaxPending: 2})
+	tx1, tx2, tx3 := newTestTx(1), newTestTx(2), newTestTx(3)
+	assert.Nil(t, cache.Push(tx1, false, 1))
+	assert.Equal(t, types.ErrTxExist, cache.Push(tx1, false, 2))
+	assert.Nil(t, cache.Push(tx2, true, 2))
+	assert.Equal(t, types.ErrMemFull, cache.Push(tx3, false, 3))
+	assert.Equal(t, 2, cache.Size())
+	assert.Equal(t, []*types.Transaction{tx1, tx2}, cache.GetTxs())
+
+	// 打包的交易在dedup过期前仍然不能重复发送
+	cache.RemoveTxs([]*types.Transaction{tx1})
+	assert.Equal(t, 1, cache.Size())
+	assert.Equal(t, types.ErrTxExist, cache.Push(tx1, false, 5))
+	cache.RemoveExpired(11)
+	assert.Nil(t, cache.Push(tx1, false, 11))
+
+	// 只重试没有发送成功的交易, 没有实际转发时不计入重试次数, 达到次数后删除
+	assert.Equal(t, []*types.Transaction{tx1}, cache.Unsent(2))
+	assert.Equal(t, []*types.Transaction{tx1}, cache.Unsent(2))
+	cache.AddRetry(string(tx1.Hash()))
+	assert.Equal(t, []*types.Transaction{tx1}, cache.Unsent(2))
+	cache.AddRetry(string(tx1.Hash()))
+	assert.Equal(t, 0, len(cache.Unsent(2)))
+	assert.Equal(t, 1, cache.Size())
+	assert.Nil(t, cache.Push(tx1, false, 12))
+	cache.SetSent(string(tx1.Hash()))
+	assert.Equal(t, 0, len(cache.Unsent(2)))
+
+	cache.RemoveExpired(102)
+	assert.Equal(t, 1, cache.Size())
+	cache.RemoveExpired(200)
+	assert.Equal(t, 0, cache.Size())
+}
+
+func getTestPrivKey(t *testing.T) crypto.PrivKey {
+	c, err := crypto.Load(types.GetSignName("", types.SECP256K1), -1)
+	assert.Nil(t, err)
+	priv, err := c.GenKey()
+	assert.Nil(t, err)
+	return priv
+}
+
+type mockMainClient struct {
+	types.Chain33Client
+	unavailable int32
+	// 主链节点没有响应, 直到请求超时
+	hang       int32
+	sent       int32
+	calls      int32
+	noDeadline int32
+}
+
+func (c *mockMainClient) SendTransaction(ctx context.Context, tx *types.Transaction, opts ...grpc.CallOption) (*types.Reply, error) {
+	atomic.AddInt32(&c.calls, 1)
+	if _, ok := ctx.Deadline(); !ok {
+		atomic.AddInt32(&c.noDeadline, 1)
+	}
+	if atomic.LoadInt32(&c.hang) == 1 {
+		select {
+		case <-ctx.Done():
+			return nil, status.Error(codes.DeadlineExceeded, ctx.Err().Error())
+		case <-time.After(time.Second):
+			return nil, status.Error(codes.Unavailable, "main node no response")
+		}
+	}
+	if atomic.LoadInt32(&c.unavailable) == 1 {
+		return nil, status.Error(codes.Unavailable, "main node unavailable")
+	}
+	atomic.AddInt32(&c.sent, 1)
+	return &types.Reply{IsOk: true, Msg: tx.Hash()}, nil
+}
+
+func TestSendTxRetry(t *testing.T) {
+	cfg := types.NewChain33Config(strings.Replace(types.GetDefaultCfgstring(), "Title=\"local\"", "Title=\"user.p.test.\"", 1))
+	q := queue.New("channel")
+	q.SetConfig(cfg)
+	defer q.Close()
+
+	cli := &mockMainClient{unavailable: 1}
+	mem := newMempool(cfg.GetModuleConfig().Mempool, subConfig{RetryInterval: 10})
+	mem.mainGrpcCli = cli
+	mem.header = &types.Header{Height: 1, BlockTime: types.Now().Unix()}
+	mem.SetQueueClient(q.Client())
+	defer mem.Close()
+
+	client := q.Client()
+	sendTx := func(tx *types.Transaction) interface{} {
+		msg := client.NewMessage("mempool", types.EventTx, tx)
+		assert.Nil(t, client.Send(msg, true))
+		resp, err := client.Wait(msg)
+		if err != nil {
+			return err
+		}
+		return resp.GetData()
+	}
+
+	// 其他平行链的交易直接拒绝
+	other := &types.Transaction{Execer: []byte("user.p.other.none"), Payload: []byte("none"), Fee: 100000}
+	assert.Equal(t, ErrTxTitleNotMatch, sendTx(other))
+
+	// 未签名的交易本地检查失败
+	assert.Equal(t, types.ErrSign, sendTx(newTestTx(1)))
+
+	tx := newTestTx(2)
+	tx.Sign(types.SECP256K1, getTestPrivKey(t))
+	reply, ok := sendTx(tx).(*types.Reply)
+	assert.True(t, ok)
+	assert.True(t, reply.IsOk)
+	assert.Equal(t, types.ErrTxExist, sendTx(tx))
+	assert.Equal(t, 1, mem.cache.Size())
+
+	atomic.StoreInt32(&cli.unavailable, 0)
+	for i := 0; i < 100 && atomic.LoadInt32(&cli.sent) == 0; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&cli.sent))
+	assert.Equal(t, 0, len(mem.cache.Unsent(10)))
+
+	msg := client.NewMessage("mempool", types.EventAddBlock, &types.BlockDetail{Block: &types.Block{Height: 2, Txs: []*types.Transaction{tx}}})
+	assert.Nil(t, client.Send(msg, false))
+	msg = client.NewMessage("mempool", types.EventGetMempoolSize, nil)
+	assert.Nil(t, client.Send(msg, true))
+	resp, err := client.Wait(msg)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), resp.GetData().(*types.MempoolSize).Size)
+}
+
+func TestResendUnsent(t *testing.T) {
+	cli := &mockMainClient{hang: 1}
+	mem := newMempool(nil, subConfig{SendTimeout: 20, MaxRetry: 2})
+	mem.mainGrpcCli = cli
+	tx1, tx2 := newTestTx(1), newTestTx(2)
+	assert.Nil(t, mem.cache.Push(tx1, false, 1))
+	assert.Nil(t, mem.cache.Push(tx2, false, 1))
+
+	// 主链节点没有响应时请求超时返回, 没有转发的交易不计入重试次数
+	start := time.Now()
+	mem.resendUnsent()
+	assert.True(t, time.Since(start) < 500*time.Millisecond)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&cli.calls))
+	retry := 0
+	for _, ptx := range mem.cache.pending {
+		retry += ptx.retry
+	}
+	assert.Equal(t, 1, retry)
+
+	// 主链节点恢复后全部转发
+	atomic.StoreInt32(&cli.hang, 0)
+	mem.resendUnsent()
+	assert.Equal(t, int32(2), atomic.LoadInt32(&cli.sent))
+	assert.Equal(t, 0, len(mem.cache.Unsent(2)))
+	assert.Equal(t, 2, mem.cache.Size())
+	assert.Equal(t, int32(0), atomic.LoadInt32(&cli.noDeadline))
+}
@@ -6,14 +6,41 @@ import (
 	"github.com/33cn/chain33/types"
 )
 
+const (
+	defaultDedupTTL      = 60    // second
+	defaultPendingTTL    = 600   // second
+	defaultMaxPending    = 10240 //
+	defaultRetryInterval = 1000  // millisecond
+	defaultMaxRetry      = 10
+	defaultSendTimeout   = 5000 // millisecond
+)
+
 //--------------------------------------------------------------------------------
 // Module Mempool
 
+type subConfig struct {
+	// 相同交易的重复发送在DedupTTL秒内直接拒绝
+	DedupTTL int64 `json:"dedupTTL"`
+	// 没有打包进平行链区块的交易在本地保留的秒数
+	PendingTTL int64 `json:"pendingTTL"`
+	// 本地最多保留的交易数, 默认为poolCacheSize
+	MaxPending int64 `json:"maxPending"`
+	// 主链节点不可用时重新转发的间隔(毫秒)和最多次数
+	RetryInterval int64 `json:"retryInterval"`
+	MaxRetry      int64 `json:"maxRetry"`
+	// 转发交易到主链的超时时间(毫秒)
+	SendTimeout int64 `json:"sendTimeout"`
+}
+
 func init() {
 	drivers.Reg("para", New)
 }
 
 //New 创建price cache 结构的 mempool
 func New(cfg *types.Mempool, sub []byte) queue.Module {
-	return NewMempool(cfg)
+	var subcfg subConfig
+	if sub != nil {
+		types.MustDecode(sub, &subcfg)
+	}
+	return newMempool(cfg, subcfg)
 }
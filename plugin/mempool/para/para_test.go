@@ -37,15 +37,21 @@ func TestParaNodeMempool(t *testing.T) {
 	testnode.ModifyParaClient(chainCfg, main.GetCfg().RPC.GrpcBindAddr)
 	cfg := chainCfg.GetModuleConfig()
 	cfg.Mempool.Name = "para"
-	para := testnode.NewWithConfig(chainCfg, nil)
-	para.Listen()
-	mockpara := paratest.NewParaNode(main, para)
-	tx := util.CreateTxWithExecer(chainCfg, mockpara.Para.GetGenesisKey(), "user.p.guodun.none")
+	paraNode := testnode.NewWithConfig(chainCfg, nil)
+	paraNode.Listen()
+	mockpara := paratest.NewParaNode(main, paraNode)
+	tx := util.CreateTxWithExecer(chainCfg, mockpara.Para.GetGenesisKey(), chainCfg.GetTitle()+"none")
 	hash := mockpara.Para.SendTx(tx)
 	assert.Equal(t, tx.Hash(), hash)
-	msg := para.GetClient().NewMessage("mempool", types.EventGetMempoolSize, nil)
-	para.GetClient().Send(msg, true)
-	reply, err := para.GetClient().Wait(msg)
+
+	// 其他平行链的交易在本地被拒绝
+	other := util.CreateTxWithExecer(chainCfg, mockpara.Para.GetGenesisKey(), "user.p.guodun.none")
+	_, err := mockpara.Para.GetAPI().SendTx(other)
+	assert.EqualError(t, err, para.ErrTxTitleNotMatch.Error())
+
+	msg := paraNode.GetClient().NewMessage("mempool", types.EventGetMempoolSize, nil)
+	paraNode.GetClient().Send(msg, true)
+	reply, err := paraNode.GetClient().Wait(msg)
 	if err != nil {
 		t.Error(err)
 		return
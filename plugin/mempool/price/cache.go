@@ -3,6 +3,7 @@ package price
 import (
 	"github.com/33cn/chain33/common/skiplist"
 	"github.com/33cn/chain33/system/mempool"
+	"github.com/33cn/plugin/plugin/mempool/quota"
 	"github.com/golang/protobuf/proto"
 )
 
@@ -10,6 +11,7 @@ import (
 type Queue struct {
 	*skiplist.Queue
 	subConfig subConfig
+	index     *quota.Index
 }
 
 type priceScore struct {
@@ -46,6 +48,7 @@ func NewQueue(subcfg subConfig) *Queue {
 	return &Queue{
 		Queue:     skiplist.NewQueue(subcfg.PoolCacheSize),
 		subConfig: subcfg,
+		index:     quota.NewIndex(subcfg.Config),
 	}
 }
 
@@ -58,14 +61,22 @@ func (cache *Queue) GetItem(hash string) (*mempool.Item, error) {
 	return item.(*priceScore).Item, nil
 }
 
-//Push 加入数据到队列
+//Push 加入数据到队列, 受账户和执行器的限额约束
 func (cache *Queue) Push(item *mempool.Item) error {
-	return cache.Queue.Push(&priceScore{Item: item})
+	return cache.index.Push(cache.Queue, item.Value, &priceScore{Item: item})
 }
 
-//Walk 获取数据通过 key
+//Remove 删除数据, 同时更新账户索引
+func (cache *Queue) Remove(hash string) error {
+	return cache.index.Remove(cache.Queue, hash)
+}
+
+//Walk 获取数据通过 key, 跳过已经被挤出的交易
 func (cache *Queue) Walk(count int, cb func(tx *mempool.Item) bool) {
 	cache.Queue.Walk(count, func(item skiplist.Scorer) bool {
+		if cache.index.IsEvicted(string(item.Hash())) {
+			return true
+		}
 		return cb(item.(*priceScore).Item)
 	})
 }
@@ -7,11 +7,13 @@ import (
 	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/common/address"
 	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/queue"
 	cty "github.com/33cn/chain33/system/dapp/coins/types"
 	drivers "github.com/33cn/chain33/system/mempool"
 	"github.com/33cn/chain33/types"
 	"github.com/33cn/chain33/util"
 	"github.com/33cn/chain33/util/testnode"
+	"github.com/33cn/plugin/plugin/mempool/quota"
 	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 
@@ -182,6 +184,72 @@ func TestGetProperFee(t *testing.T) {
 	assert.Equal(t, (feeRate1*98+feeRate2+1200000)/100, properFee)
 }
 
+func TestAccountQuota(t *testing.T) {
+	cache := initEnv(0)
+	cache.index = quota.NewIndex(quota.Config{MaxAccountTx: 2})
+	assert.Nil(t, cache.Push(item1))
+	assert.Nil(t, cache.Push(item2))
+	assert.Equal(t, types.ErrManyTx, cache.Push(item3))
+
+	list := cache.index.AccountOccupancy(0)
+	assert.Len(t, list, 1)
+	assert.Equal(t, tx1.From(), list[0].Addr)
+	assert.Equal(t, int64(2), list[0].TxCount)
+	assert.Nil(t, cache.Remove(string(tx1.Hash())))
+	assert.Equal(t, int64(1), cache.index.AccountOccupancy(0)[0].TxCount)
+	assert.Nil(t, cache.Push(item3))
+}
+
+// 通过消息队列查询mempool中各个账户的占用情况
+func TestAccountOccupancyQuery(t *testing.T) {
+	cfg := types.NewChain33Config(types.ReadFile("chain33.test.toml"))
+	q := queue.New("channel")
+	q.SetConfig(cfg)
+	defer q.Close()
+	// 模拟blockchain应答mempool启动时的查询
+	chain := q.Client()
+	chain.Sub("blockchain")
+	go func() {
+		for msg := range chain.Recv() {
+			switch msg.Ty {
+			case types.EventGetLastHeader:
+				msg.Reply(chain.NewMessage("", types.EventHeader, &types.Header{}))
+			case types.EventIsSync:
+				msg.Reply(chain.NewMessage("", types.EventReplyIsSync, &types.IsCaughtUp{Iscaughtup: true}))
+			}
+		}
+	}()
+	mem := New(cfg.GetModuleConfig().Mempool, cfg.GetSubConfig().Mempool["price"]).(*quota.Mempool)
+	mem.SetQueueClient(q.Client())
+	defer mem.Close()
+
+	addr, priv := util.Genaddress()
+	for i := 0; i < 3; i++ {
+		assert.Nil(t, mem.PushTx(util.CreateCoinsTx(cfg, privKey, addr, amount)))
+	}
+	assert.Nil(t, mem.PushTx(util.CreateCoinsTx(cfg, priv, toAddr, amount)))
+
+	client := q.Client()
+	reply, err := quota.GetAccountOccupancy(client, &quota.ReqAccountOccupancy{Count: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(4), reply.TxCount)
+	assert.Len(t, reply.Accounts, 1)
+	assert.Equal(t, toAddr, reply.Accounts[0].Addr)
+	assert.Equal(t, int64(3), reply.Accounts[0].TxCount)
+
+	reply, err = quota.GetAccountOccupancy(client, &quota.ReqAccountOccupancy{})
+	assert.Nil(t, err)
+	assert.Len(t, reply.Accounts, 2)
+	assert.Equal(t, addr, reply.Accounts[1].Addr)
+
+	// 其他mempool消息依然由基础mempool处理
+	msg := client.NewMessage("mempool", types.EventGetMempoolSize, nil)
+	assert.Nil(t, client.Send(msg, true))
+	resp, err := client.Wait(msg)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(4), resp.GetData().(*types.MempoolSize).Size)
+}
+
 func TestRealNodeMempool(t *testing.T) {
 	mock33 := testnode.New("chain33.test.toml", nil)
 	cfg := mock33.GetClient().GetConfig()
@@ -4,12 +4,14 @@ import (
 	"github.com/33cn/chain33/queue"
 	drivers "github.com/33cn/chain33/system/mempool"
 	"github.com/33cn/chain33/types"
+	"github.com/33cn/plugin/plugin/mempool/quota"
 )
 
 //--------------------------------------------------------------------------------
 // Module Mempool
 
 type subConfig struct {
+	quota.Config
 	PoolCacheSize int64 `json:"poolCacheSize"`
 	ProperFee     int64 `json:"properFee"`
 }
@@ -29,6 +31,11 @@ func New(cfg *types.Mempool, sub []byte) queue.Module {
 	if subcfg.ProperFee == 0 {
 		subcfg.ProperFee = cfg.MinTxFeeRate
 	}
-	c.SetQueueCache(NewQueue(subcfg))
-	return c
+	qcache := NewQueue(subcfg)
+	// 被挤出的交易需要通过mempool删除, 才能同时清理账户索引等缓存, Push时mempool已加锁, 所以异步删除
+	qcache.index.SetOnEvict(func(hash []byte) {
+		go c.RemoveTxs(&types.TxHashList{Hashes: [][]byte{hash}})
+	})
+	c.SetQueueCache(qcache)
+	return quota.NewMempool(c, qcache.index)
 }
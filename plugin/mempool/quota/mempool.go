@@ -0,0 +1,108 @@
+package quota
+
+import (
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/queue"
+	drivers "github.com/33cn/chain33/system/mempool"
+	"github.com/33cn/chain33/types"
+)
+
+// EventGetAccountOccupancy 查询mempool中各个账户的占用情况, 由使用账户索引的mempool直接处理
+const EventGetAccountOccupancy = 3001
+
+// 其他类型的mempool不处理该查询, 超时后返回不支持
+var queryTimeout = 5 * time.Second
+
+// ReqAccountOccupancy 查询占用最多的Count个账户, Count为0时返回所有账户
+type ReqAccountOccupancy struct {
+	Count int32 `json:"count"`
+}
+
+// ReplyAccountOccupancy 账户占用情况
+type ReplyAccountOccupancy struct {
+	TxCount  int64               `json:"txCount"`
+	Accounts []*AccountOccupancy `json:"accounts"`
+}
+
+// Mempool 在基础mempool上处理账户占用情况的查询
+type Mempool struct {
+	*drivers.Mempool
+	index *Index
+}
+
+// NewMempool 创建mempool
+func NewMempool(mem *drivers.Mempool, index *Index) *Mempool {
+	return &Mempool{Mempool: mem, index: index}
+}
+
+// SetQueueClient 拦截账户占用情况的查询, 其他消息交给基础mempool处理
+func (mem *Mempool) SetQueueClient(client queue.Client) {
+	mem.Mempool.SetQueueClient(&queryClient{Client: client, mem: mem})
+}
+
+// GetAccountOccupancy 获取账户占用情况
+func (mem *Mempool) GetAccountOccupancy(req *ReqAccountOccupancy) *ReplyAccountOccupancy {
+	return &ReplyAccountOccupancy{
+		TxCount:  int64(mem.Size()),
+		Accounts: mem.index.AccountOccupancy(int(req.GetCount())),
+	}
+}
+
+// GetCount ...
+func (req *ReqAccountOccupancy) GetCount() int32 {
+	if req == nil {
+		return 0
+	}
+	return req.Count
+}
+
+type queryClient struct {
+	queue.Client
+	mem  *Mempool
+	once sync.Once
+	recv chan *queue.Message
+}
+
+func (client *queryClient) Recv() chan *queue.Message {
+	client.once.Do(func() {
+		client.recv = make(chan *queue.Message, cap(client.Client.Recv()))
+		go func() {
+			defer close(client.recv)
+			for msg := range client.Client.Recv() {
+				if msg.Ty == EventGetAccountOccupancy {
+					req, _ := msg.GetData().(*ReqAccountOccupancy)
+					msg.Reply(client.NewMessage("", types.EventReply, client.mem.GetAccountOccupancy(req)))
+					continue
+				}
+				client.recv <- msg
+			}
+		}()
+	})
+	return client.recv
+}
+
+// GetAccountOccupancy 通过消息队列查询mempool中各个账户的占用情况
+func GetAccountOccupancy(client queue.Client, req *ReqAccountOccupancy) (*ReplyAccountOccupancy, error) {
+	msg := client.NewMessage("mempool", EventGetAccountOccupancy, req)
+	err := client.Send(msg, true)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.WaitTimeout(msg, queryTimeout)
+	if err == queue.ErrQueueTimeout {
+		return nil, types.ErrNotSupport
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err, ok := resp.GetData().(error); ok {
+		return nil, err
+	}
+	reply, ok := resp.GetData().(*ReplyAccountOccupancy)
+	if !ok {
+		return nil, types.ErrTypeAsset
+	}
+	return reply, nil
+}
@@ -0,0 +1,257 @@
+package quota
+
+import (
+	"errors"
+	"sort"
+	"sync"
+
+	"github.com/33cn/chain33/common/skiplist"
+	"github.com/33cn/chain33/types"
+)
+
+// ErrExecQuota 执行器在队列中的交易数达到上限
+var ErrExecQuota = errors.New("ErrExecQuota")
+
+// Config 按账户和执行器限制队列中的交易, 作为price和score队列配置的一部分
+type Config struct {
+	// 单个账户在队列中最多的交易数, 0表示不限制
+	MaxAccountTx int64 `json:"maxAccountTx"`
+	// 队列满时, 交易数超过队列容量该百分比的账户优先被挤出, 0表示只按优先级挤出
+	FairSharePercent int64 `json:"fairSharePercent"`
+	// 各个执行器在队列中最多的交易数
+	ExecLimits map[string]int64 `json:"execLimits"`
+}
+
+// AccountOccupancy 账户在队列中占用的交易数和字节数
+type AccountOccupancy struct {
+	Addr    string `json:"addr"`
+	TxCount int64  `json:"txCount"`
+	Bytes   int64  `json:"bytes"`
+}
+
+type txMeta struct {
+	addr string
+	exec string
+	size int64
+}
+
+type account struct {
+	txs   map[string]bool
+	bytes int64
+}
+
+// Index 记录队列中每个账户和执行器的交易, 需要和队列同步更新
+type Index struct {
+	cfg      Config
+	mtx      sync.RWMutex
+	txs      map[string]*txMeta
+	accounts map[string]*account
+	execs    map[string]int64
+	// 已被挤出但还没有通过mempool删除的交易, 仍然保留在队列中直到Remove
+	evicted map[string]bool
+	onEvict func(hash []byte)
+}
+
+// NewIndex 创建索引
+func NewIndex(cfg Config) *Index {
+	return &Index{
+		cfg:      cfg,
+		txs:      make(map[string]*txMeta),
+		accounts: make(map[string]*account),
+		execs:    make(map[string]int64),
+		evicted:  make(map[string]bool),
+	}
+}
+
+// SetOnEvict 设置挤出交易的回调, 被挤出的交易需要通过mempool删除才能同时清理mempool中的其他缓存,
+// 没有设置时直接从队列中删除
+func (index *Index) SetOnEvict(onEvict func(hash []byte)) {
+	index.mtx.Lock()
+	defer index.mtx.Unlock()
+	index.onEvict = onEvict
+}
+
+// IsEvicted 交易是否已经被挤出, 遍历队列时需要跳过
+func (index *Index) IsEvicted(hash string) bool {
+	index.mtx.RLock()
+	defer index.mtx.RUnlock()
+	return index.evicted[hash]
+}
+
+// Push 按账户和执行器的限额把item加入队列, 队列满时优先挤出超过公平份额的账户中优先级最低的交易
+func (index *Index) Push(queue *skiplist.Queue, tx *types.Transaction, item skiplist.Scorer) error {
+	hash := string(item.Hash())
+	if queue.Exist(hash) {
+		return types.ErrTxExist
+	}
+	addr := tx.From()
+	exec := string(types.GetRealExecName(tx.Execer))
+
+	index.mtx.Lock()
+	defer index.mtx.Unlock()
+	if index.cfg.MaxAccountTx > 0 && index.accountTxCount(addr) >= index.cfg.MaxAccountTx {
+		return types.ErrManyTx
+	}
+	if limit, ok := index.cfg.ExecLimits[exec]; ok && index.execs[exec] >= limit {
+		return ErrExecQuota
+	}
+	if int64(queue.Size()-len(index.evicted)) >= queue.MaxSize() {
+		if err := index.evict(queue, addr, item); err != nil {
+			return err
+		}
+	}
+	//被挤出的交易在mempool删除之前仍然占用队列, 所以不经过队列的容量检查
+	queue.Insert(hash, item)
+	index.txs[hash] = &txMeta{addr: addr, exec: exec, size: item.ByteSize()}
+	acc, ok := index.accounts[addr]
+	if !ok {
+		acc = &account{txs: make(map[string]bool)}
+		index.accounts[addr] = acc
+	}
+	acc.txs[hash] = true
+	acc.bytes += item.ByteSize()
+	index.execs[exec]++
+	return nil
+}
+
+// Remove 从队列和索引中删除交易
+func (index *Index) Remove(queue *skiplist.Queue, hash string) error {
+	index.mtx.Lock()
+	defer index.mtx.Unlock()
+	return index.remove(queue, hash)
+}
+
+func (index *Index) remove(queue *skiplist.Queue, hash string) error {
+	err := queue.Remove(hash)
+	if err != nil {
+		return err
+	}
+	delete(index.evicted, hash)
+	index.drop(hash)
+	return nil
+}
+
+// drop 从账户和执行器的统计中删除交易
+func (index *Index) drop(hash string) {
+	meta, ok := index.txs[hash]
+	if !ok {
+		return
+	}
+	delete(index.txs, hash)
+	if acc, ok := index.accounts[meta.addr]; ok {
+		delete(acc.txs, hash)
+		acc.bytes -= meta.size
+		if len(acc.txs) == 0 {
+			delete(index.accounts, meta.addr)
+		}
+	}
+	index.execs[meta.exec]--
+	if index.execs[meta.exec] <= 0 {
+		delete(index.execs, meta.exec)
+	}
+}
+
+// evict 队列满时腾出一个位置, 超过公平份额的账户不能挤出其他账户的交易
+func (index *Index) evict(queue *skiplist.Queue, addr string, item skiplist.Scorer) error {
+	fairShare := queue.MaxSize() * index.cfg.FairSharePercent / 100
+	if index.cfg.FairSharePercent > 0 {
+		if index.accountTxCount(addr) >= fairShare {
+			return types.ErrMemFull
+		}
+		heaviest := index.heaviestAccount()
+		if heaviest != "" && int64(len(index.accounts[heaviest].txs)) > fairShare {
+			if lowest := index.lowestTx(queue, heaviest); lowest != nil {
+				return index.evictTx(queue, string(lowest.Hash()))
+			}
+		}
+	}
+	// 没有超过公平份额的账户时, 和默认队列一样挤出优先级最低的交易
+	tail := queue.Last()
+	if index.evicted[string(tail.Hash())] {
+		tail = nil
+		for addr := range index.accounts {
+			if lowest := index.lowestTx(queue, addr); lowest != nil && (tail == nil || higher(tail, lowest)) {
+				tail = lowest
+			}
+		}
+	}
+	if tail == nil || !higher(item, tail) {
+		return types.ErrMemFull
+	}
+	return index.evictTx(queue, string(tail.Hash()))
+}
+
+// evictTx 挤出交易, 设置了回调时交易从统计中删除并标记, 由mempool异步删除
+func (index *Index) evictTx(queue *skiplist.Queue, hash string) error {
+	if index.onEvict == nil {
+		return index.remove(queue, hash)
+	}
+	index.drop(hash)
+	index.evicted[hash] = true
+	index.onEvict([]byte(hash))
+	return nil
+}
+
+func (index *Index) accountTxCount(addr string) int64 {
+	if acc, ok := index.accounts[addr]; ok {
+		return int64(len(acc.txs))
+	}
+	return 0
+}
+
+func (index *Index) heaviestAccount() string {
+	var heaviest string
+	var max int
+	for addr, acc := range index.accounts {
+		if len(acc.txs) > max || (len(acc.txs) == max && addr < heaviest) {
+			heaviest, max = addr, len(acc.txs)
+		}
+	}
+	return heaviest
+}
+
+func (index *Index) lowestTx(queue *skiplist.Queue, addr string) skiplist.Scorer {
+	var lowest skiplist.Scorer
+	for hash := range index.accounts[addr].txs {
+		item, err := queue.GetItem(hash)
+		if err != nil {
+			continue
+		}
+		if lowest == nil || higher(lowest, item) {
+			lowest = item
+		}
+	}
+	return lowest
+}
+
+// higher 和skiplist一样, 先比较分数, 分数相同再比较先后
+func higher(a, b skiplist.Scorer) bool {
+	sa, sb := a.GetScore(), b.GetScore()
+	if sa != sb {
+		return sa > sb
+	}
+	return a.Compare(b) == skiplist.Big
+}
+
+// AccountOccupancy 按交易数从多到少返回账户的占用情况, count为0时返回所有账户
+func (index *Index) AccountOccupancy(count int) []*AccountOccupancy {
+	index.mtx.RLock()
+	list := make([]*AccountOccupancy, 0, len(index.accounts))
+	for addr, acc := range index.accounts {
+		list = append(list, &AccountOccupancy{Addr: addr, TxCount: int64(len(acc.txs)), Bytes: acc.bytes})
+	}
+	index.mtx.RUnlock()
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].TxCount != list[j].TxCount {
+			return list[i].TxCount > list[j].TxCount
+		}
+		if list[i].Bytes != list[j].Bytes {
+			return list[i].Bytes > list[j].Bytes
+		}
+		return list[i].Addr < list[j].Addr
+	})
+	if count > 0 && len(list) > count {
+		list = list[:count]
+	}
+	return list
+}
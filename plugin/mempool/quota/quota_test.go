@@ -0,0 +1,229 @@
+package quota
+
+import (
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/common/skiplist"
+	drivers "github.com/33cn/chain33/system/mempool"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	_ "github.com/33cn/chain33/system/crypto/init"
+)
+
+type testScore struct {
+	tx        *types.Transaction
+	enterTime int64
+}
+
+func (item *testScore) GetScore() int64 {
+	return item.tx.Fee
+}
+
+func (item *testScore) Hash() []byte {
+	return item.tx.Hash()
+}
+
+func (item *testScore) Compare(cmp skiplist.Scorer) int {
+	it := cmp.(*testScore)
+	if item.enterTime < it.enterTime {
+		return skiplist.Big
+	}
+	if item.enterTime == it.enterTime {
+		return skiplist.Equal
+	}
+	return skiplist.Small
+}
+
+func (item *testScore) ByteSize() int64 {
+	return int64(item.tx.Size())
+}
+
+func genKeys(t *testing.T, n int) []crypto.PrivKey {
+	c, err := crypto.Load(types.GetSignName("", types.SECP256K1), -1)
+	require.Nil(t, err)
+	keys := make([]crypto.PrivKey, n)
+	for i := range keys {
+		keys[i], err = c.GenKey()
+		require.Nil(t, err)
+	}
+	return keys
+}
+
+func newItem(exec string, fee, nonce int64, priv crypto.PrivKey) (*types.Transaction, *testScore) {
+	tx := &types.Transaction{Execer: []byte(exec), Payload: []byte("payload"), Fee: fee, Nonce: nonce}
+	tx.Sign(types.SECP256K1, priv)
+	return tx, &testScore{tx: tx, enterTime: nonce}
+}
+
+func TestAccountQuota(t *testing.T) {
+	keys := genKeys(t, 2)
+	index := NewIndex(Config{MaxAccountTx: 2, ExecLimits: map[string]int64{"token": 1}})
+	queue := skiplist.NewQueue(10)
+
+	tx, item := newItem("coins", 1000, 1, keys[0])
+	assert.Nil(t, index.Push(queue, tx, item))
+	assert.Equal(t, types.ErrTxExist, index.Push(queue, tx, item))
+	tx, item = newItem("coins", 1000, 2, keys[0])
+	assert.Nil(t, index.Push(queue, tx, item))
+	tx, item = newItem("coins", 1000, 3, keys[0])
+	assert.Equal(t, types.ErrManyTx, index.Push(queue, tx, item))
+
+	// 执行器限额
+	tx, item = newItem("token", 1000, 1, keys[1])
+	assert.Nil(t, index.Push(queue, tx, item))
+	tokenHash := string(tx.Hash())
+	tx, item = newItem("token", 1000, 2, keys[1])
+	assert.Equal(t, ErrExecQuota, index.Push(queue, tx, item))
+	assert.Nil(t, index.Remove(queue, tokenHash))
+	assert.Nil(t, index.Push(queue, tx, item))
+
+	list := index.AccountOccupancy(0)
+	require.Len(t, list, 2)
+	assert.Equal(t, int64(2), list[0].TxCount)
+	assert.Equal(t, int64(1), list[1].TxCount)
+	assert.Len(t, index.AccountOccupancy(1), 1)
+	assert.Equal(t, 3, queue.Size())
+}
+
+func TestFairShareEvict(t *testing.T) {
+	keys := genKeys(t, 3)
+	index := NewIndex(Config{FairSharePercent: 50})
+	queue := skiplist.NewQueue(4)
+
+	// 账户0用高手续费占满队列
+	var hashes []string
+	for i := int64(0); i < 4; i++ {
+		tx, item := newItem("coins", 2000+i, i, keys[0])
+		require.Nil(t, index.Push(queue, tx, item))
+		hashes = append(hashes, string(tx.Hash()))
+	}
+	// 超过公平份额的账户不能再挤出交易
+	tx, item := newItem("coins", 5000, 10, keys[0])
+	assert.Equal(t, types.ErrMemFull, index.Push(queue, tx, item))
+
+	// 其他账户的低手续费交易挤出账户0优先级最低的交易
+	tx, item = newItem("coins", 1000, 10, keys[1])
+	assert.Nil(t, index.Push(queue, tx, item))
+	assert.False(t, queue.Exist(hashes[0]))
+	tx, item = newItem("coins", 1000, 11, keys[2])
+	assert.Nil(t, index.Push(queue, tx, item))
+	assert.False(t, queue.Exist(hashes[1]))
+	lowest := string(tx.Hash())
+	assert.Equal(t, 4, queue.Size())
+
+	// 没有账户超过公平份额时按优先级挤出
+	tx, item = newItem("coins", 500, 12, keys[2])
+	assert.Equal(t, types.ErrMemFull, index.Push(queue, tx, item))
+	tx, item = newItem("coins", 1500, 12, keys[2])
+	assert.Nil(t, index.Push(queue, tx, item))
+	assert.False(t, queue.Exist(lowest))
+	list := index.AccountOccupancy(0)
+	require.Len(t, list, 3)
+	assert.Equal(t, int64(2), list[0].TxCount)
+	assert.Equal(t, int64(1), list[1].TxCount)
+	assert.Equal(t, int64(1), list[2].TxCount)
+}
+
+func TestEvictCallback(t *testing.T) {
+	keys := genKeys(t, 3)
+	index := NewIndex(Config{})
+	queue := skiplist.NewQueue(2)
+	var evicted []string
+	index.SetOnEvict(func(hash []byte) {
+		evicted = append(evicted, string(hash))
+	})
+
+	tx0, item := newItem("coins", 1000, 1, keys[0])
+	require.Nil(t, index.Push(queue, tx0, item))
+	tx1, item := newItem("coins", 2000, 2, keys[1])
+	require.Nil(t, index.Push(queue, tx1, item))
+
+	// 被挤出的交易通知mempool删除, 删除之前仍然保留在队列中
+	tx2, item := newItem("coins", 3000, 3, keys[2])
+	require.Nil(t, index.Push(queue, tx2, item))
+	assert.Equal(t, []string{string(tx0.Hash())}, evicted)
+	assert.True(t, queue.Exist(string(tx0.Hash())))
+	assert.True(t, index.IsEvicted(string(tx0.Hash())))
+	assert.Equal(t, 3, queue.Size())
+	assert.Len(t, index.AccountOccupancy(0), 2)
+
+	// 队尾是等待删除的交易时, 挤出剩余交易中优先级最低的
+	tx3, item := newItem("coins", 500, 4, keys[0])
+	assert.Equal(t, types.ErrMemFull, index.Push(queue, tx3, item))
+	tx3, item = newItem("coins", 2500, 4, keys[0])
+	require.Nil(t, index.Push(queue, tx3, item))
+	assert.Equal(t, []string{string(tx0.Hash()), string(tx1.Hash())}, evicted)
+
+	for _, hash := range evicted {
+		require.Nil(t, index.Remove(queue, hash))
+		assert.False(t, index.IsEvicted(hash))
+	}
+	assert.Equal(t, 2, queue.Size())
+	assert.Len(t, index.AccountOccupancy(0), 2)
+}
+
+// testQueue 使用账户索引的队列, 和price队列相同
+type testQueue struct {
+	*skiplist.Queue
+	index *Index
+}
+
+func (cache *testQueue) GetItem(hash string) (*drivers.Item, error) {
+	item, err := cache.Queue.GetItem(hash)
+	if err != nil {
+		return nil, err
+	}
+	return &drivers.Item{Value: item.(*testScore).tx}, nil
+}
+
+func (cache *testQueue) Push(item *drivers.Item) error {
+	return cache.index.Push(cache.Queue, item.Value, &testScore{tx: item.Value, enterTime: item.Value.Nonce})
+}
+
+func (cache *testQueue) Remove(hash string) error {
+	return cache.index.Remove(cache.Queue, hash)
+}
+
+func (cache *testQueue) Walk(count int, cb func(tx *drivers.Item) bool) {
+	cache.Queue.Walk(count, func(item skiplist.Scorer) bool {
+		if cache.index.IsEvicted(string(item.Hash())) {
+			return true
+		}
+		return cb(&drivers.Item{Value: item.(*testScore).tx})
+	})
+}
+
+func (cache *testQueue) GetProperFee() int64 {
+	return 0
+}
+
+// 被挤出的交易通过mempool删除, mempool中的账户索引等缓存同时被清理
+func TestEvictFromMempool(t *testing.T) {
+	keys := genKeys(t, 2)
+	mem := drivers.NewMempool(&types.Mempool{PoolCacheSize: 1})
+	defer mem.Close()
+	qcache := &testQueue{Queue: skiplist.NewQueue(1), index: NewIndex(Config{})}
+	qcache.index.SetOnEvict(func(hash []byte) {
+		go mem.RemoveTxs(&types.TxHashList{Hashes: [][]byte{hash}})
+	})
+	mem.SetQueueCache(qcache)
+
+	tx0, _ := newItem("coins", 1000, 1, keys[0])
+	require.Nil(t, mem.PushTx(tx0))
+	tx1, _ := newItem("coins", 2000, 2, keys[1])
+	require.Nil(t, mem.PushTx(tx1))
+
+	for i := 0; i < 100 && mem.Size() > 1; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.Equal(t, 1, mem.Size())
+	assert.False(t, qcache.Exist(string(tx0.Hash())))
+	assert.Len(t, mem.GetAccTxs(&types.ReqAddrs{Addrs: []string{tx0.From()}}).GetTxs(), 0)
+	latest := mem.GetLatestTx()
+	require.Len(t, latest, 1)
+	assert.Equal(t, tx1.Hash(), latest[0].Hash())
+}
@@ -0,0 +1,56 @@
+package quota
+
+import (
+	"github.com/33cn/chain33/pluginmgr"
+	"github.com/33cn/chain33/queue"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	ctypes "github.com/33cn/chain33/types"
+	wcom "github.com/33cn/chain33/wallet/common"
+	"github.com/spf13/cobra"
+)
+
+// RPCName 账户占用情况的json rpc名字, 接口为 mempool.GetAccountOccupancy
+const RPCName = "mempool"
+
+func init() {
+	pluginmgr.Register(&rpcPlugin{})
+}
+
+// rpcPlugin 只注册rpc接口, 没有执行器和钱包
+type rpcPlugin struct{}
+
+func (p *rpcPlugin) GetName() string {
+	return "mempool.quota"
+}
+
+func (p *rpcPlugin) GetExecutorName() string {
+	return "mempool.quota"
+}
+
+func (p *rpcPlugin) InitExec(cfg *ctypes.Chain33Config) {}
+
+func (p *rpcPlugin) InitWallet(wallet wcom.WalletOperate, sub map[string][]byte) {}
+
+func (p *rpcPlugin) AddCmd(rootCmd *cobra.Command) {}
+
+func (p *rpcPlugin) AddRPC(s rpctypes.RPCServer) {
+	err := s.JRPC().RegisterName(RPCName, &Jrpc{client: s.GetQueueClient()})
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Jrpc json rpc type
+type Jrpc struct {
+	client queue.Client
+}
+
+// GetAccountOccupancy 查询mempool中占用最多的账户, 只有price和score类型的mempool支持
+func (j *Jrpc) GetAccountOccupancy(in *ReqAccountOccupancy, result *interface{}) error {
+	reply, err := GetAccountOccupancy(j.client, in)
+	if err != nil {
+		return err
+	}
+	*result = reply
+	return nil
+}
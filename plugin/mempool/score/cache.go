@@ -5,6 +5,7 @@ import (
 
 	"github.com/33cn/chain33/common/skiplist"
 	"github.com/33cn/chain33/system/mempool"
+	"github.com/33cn/plugin/plugin/mempool/quota"
 	"github.com/golang/protobuf/proto"
 )
 
@@ -12,6 +13,7 @@ import (
 type Queue struct {
 	*skiplist.Queue
 	subConfig subConfig
+	index     *quota.Index
 }
 
 type scoreScore struct {
@@ -51,6 +53,7 @@ func NewQueue(subcfg subConfig) *Queue {
 	return &Queue{
 		Queue:     skiplist.NewQueue(subcfg.PoolCacheSize),
 		subConfig: subcfg,
+		index:     quota.NewIndex(subcfg.Config),
 	}
 }
 
@@ -69,14 +72,22 @@ func (cache *Queue) GetItem(hash string) (*mempool.Item, error) {
 	return item.(*scoreScore).Item, nil
 }
 
-// Push 把给定tx添加到Queue；如果tx已经存在Queue中或Mempool已满则返回对应error
+// Push 把给定tx添加到Queue；如果tx已经存在Queue中或Mempool已满或超过账户和执行器的限额则返回对应error
 func (cache *Queue) Push(item *mempool.Item) error {
-	return cache.Queue.Push(&scoreScore{Item: item, subConfig: cache.subConfig})
+	return cache.index.Push(cache.Queue, item.Value, &scoreScore{Item: item, subConfig: cache.subConfig})
 }
 
-// Walk 遍历整个队列
+// Remove 删除数据, 同时更新账户索引
+func (cache *Queue) Remove(hash string) error {
+	return cache.index.Remove(cache.Queue, hash)
+}
+
+// Walk 遍历整个队列, 跳过已经被挤出的交易
 func (cache *Queue) Walk(count int, cb func(value *mempool.Item) bool) {
 	cache.Queue.Walk(count, func(item skiplist.Scorer) bool {
+		if cache.index.IsEvicted(string(item.Hash())) {
+			return true
+		}
 		return cb(item.(*scoreScore).Item)
 	})
 }
@@ -93,6 +104,9 @@ func (cache *Queue) GetProperFee() int64 {
 		if i == 100 {
 			return false
 		}
+		if cache.index.IsEvicted(string(score.Hash())) {
+			return true
+		}
 		sumScore += score.GetScore()
 		i++
 		return true
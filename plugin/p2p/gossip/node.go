@@ -88,6 +88,7 @@ type Node struct {
 	pubsub     *pubsub.PubSub
 	chainCfg   *types.Chain33Config
 	p2pMgr     *p2p.Manager
+	limiter    *rateLimiter
 }
 
 // SetQueueClient return client for nodeinfo
@@ -104,6 +105,7 @@ func NewNode(mgr *p2p.Manager, mcfg *subConfig) (*Node, error) {
 		cacheBound: make(map[string]*Peer),
 		pubsub:     pubsub.NewPubSub(10200),
 		p2pMgr:     mgr,
+		limiter:    newRateLimiter(mcfg),
 	}
 	//node.tls = &Tls{serials:make(map[*big.Int]*certInfo)}
 	node.listenPort = 13802
@@ -66,6 +66,17 @@ type subConfig struct {
 	CertFile  string `json:"certFile,omitempty"`
 	// 私钥文件
 	KeyFile string `json:"keyFile,omitempty"`
+	// 关闭接收消息限流
+	DisableRateLimit bool `json:"disableRateLimit,omitempty"`
+	// 单个节点每秒最多接收的交易, 区块, 查询消息数, 超过限制的消息直接丢弃
+	TxRateLimit    int32 `json:"txRateLimit,omitempty"`
+	BlockRateLimit int32 `json:"blockRateLimit,omitempty"`
+	QueryRateLimit int32 `json:"queryRateLimit,omitempty"`
+	// 单个节点每秒最多接收的数据, KB
+	ByteRateLimit int32 `json:"byteRateLimit,omitempty"`
+	// 一分钟内超过限制的次数达到该值时断开连接, 并加入黑名单RateBanTime秒
+	MaxRateViolations int32 `json:"maxRateViolations,omitempty"`
+	RateBanTime       int64 `json:"rateBanTime,omitempty"`
 }
 
 // P2p interface
@@ -117,6 +128,25 @@ func New(mgr *p2p.Manager, subCfg []byte) p2p.IP2P {
 	}
 	log.Info("p2p", "InnerBounds", mcfg.InnerBounds)
 
+	if mcfg.TxRateLimit <= 0 {
+		mcfg.TxRateLimit = defaultTxRateLimit
+	}
+	if mcfg.BlockRateLimit <= 0 {
+		mcfg.BlockRateLimit = defaultBlockRateLimit
+	}
+	if mcfg.QueryRateLimit <= 0 {
+		mcfg.QueryRateLimit = defaultQueryRateLimit
+	}
+	if mcfg.ByteRateLimit <= 0 {
+		mcfg.ByteRateLimit = defaultByteRateLimit
+	}
+	if mcfg.MaxRateViolations <= 0 {
+		mcfg.MaxRateViolations = defaultMaxRateViolations
+	}
+	if mcfg.RateBanTime <= 0 {
+		mcfg.RateBanTime = defaultRateBanTime
+	}
+
 	node, err := NewNode(mgr, mcfg)
 	if err != nil {
 		log.Error(err.Error())
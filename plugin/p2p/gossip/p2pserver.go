@@ -467,6 +467,7 @@ func (s *P2pserver) ServerStreamRead(stream pb.P2Pgservice_ServerStreamReadServe
 	var peeraddr, peername string
 	//此处delete是defer调用, 提前绑定变量,需要传入指针, peeraddr的值才能被获取
 	defer s.deleteInBoundPeerInfo(&peername)
+	defer s.removeLimiter(&peername)
 	defer stream.SendAndClose(&pb.ReqNil{})
 
 	for {
@@ -479,6 +480,13 @@ func (s *P2pserver) ServerStreamRead(stream pb.P2Pgservice_ServerStreamReadServe
 			return err
 		}
 
+		if err := s.node.checkRecvLimit(in, peername, peeraddr); err != nil {
+			if err == errRateBanned {
+				return err
+			}
+			continue
+		}
+
 		if s.node.processRecvP2P(in, peername, s.pubToStream, peeraddr) {
 
 		} else if ver := in.GetVersion(); ver != nil {
@@ -673,6 +681,10 @@ func (s *P2pserver) deleteStream(peerName string, delChan chan interface{}) {
 	}
 }
 
+func (s *P2pserver) removeLimiter(peerName *string) {
+	s.node.limiter.remove(*peerName)
+}
+
 func (s *P2pserver) addInBoundPeerInfo(peerName string, info innerpeer) {
 	s.imtx.Lock()
 	defer s.imtx.Unlock()
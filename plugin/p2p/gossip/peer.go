@@ -36,6 +36,7 @@ func (p *Peer) Close() {
 		p.node.pubsub.Unsub(p.taskChan)
 	}
 	p.node.peerStore.Delete(p.Addr())
+	p.node.limiter.remove(p.GetPeerName())
 	log.Info("Peer", "closed", p.Addr())
 
 }
@@ -342,6 +343,16 @@ func (p *Peer) readStream() {
 				break
 			}
 
+			if err := p.node.checkRecvLimit(data, p.GetPeerName(), p.Addr()); err != nil {
+				if err == errRateBanned {
+					errs := resp.CloseSend()
+					if errs != nil {
+						log.Error("CloseSend", "err", errs)
+					}
+					return
+				}
+				continue
+			}
 			p.node.processRecvP2P(data, p.GetPeerName(), p.node.pubToPeer, p.Addr())
 		}
 	}
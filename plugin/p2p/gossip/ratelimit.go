@@ -0,0 +1,185 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gossip
+
+import (
+	"errors"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	errRateLimit  = errors.New("ErrRateLimit")
+	errRateBanned = errors.New("ErrRateBanned")
+)
+
+// 接收消息的限流类型
+const (
+	limitTx = iota
+	limitBlock
+	limitQuery
+	limitTypeNum
+)
+
+const (
+	defaultTxRateLimit       = 2000
+	defaultBlockRateLimit    = 20
+	defaultQueryRateLimit    = 200
+	defaultByteRateLimit     = 10 * 1024 //KB
+	defaultMaxRateViolations = 100
+	defaultRateBanTime       = 600
+	rateViolationWindow      = int64(60)
+)
+
+// tokenBucket 令牌桶, 每秒补充rate个令牌, 最多累积burst个
+type tokenBucket struct {
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate, burst float64, now time.Time) *tokenBucket {
+	return &tokenBucket{rate: rate, burst: burst, tokens: burst, last: now}
+}
+
+func (b *tokenBucket) allow(n float64, now time.Time) bool {
+	//0表示不限制
+	if b.rate <= 0 {
+		return true
+	}
+	if elapsed := now.Sub(b.last).Seconds(); elapsed > 0 {
+		b.tokens += elapsed * b.rate
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+		b.last = now
+	}
+	//超过桶容量的消息, 在桶满时允许通过
+	if n > b.burst {
+		n = b.burst
+	}
+	if b.tokens < n {
+		return false
+	}
+	b.tokens -= n
+	return true
+}
+
+type peerLimiter struct {
+	msgBuckets  [limitTypeNum]*tokenBucket
+	byteBucket  *tokenBucket
+	violations  int32
+	windowStart int64
+}
+
+// rateLimiter 按节点和消息类型对接收的广播数据限流
+type rateLimiter struct {
+	mtx           sync.Mutex
+	disable       bool
+	msgRates      [limitTypeNum]float64
+	byteRate      float64
+	maxViolations int32
+	banTime       int64
+	peers         map[string]*peerLimiter
+}
+
+func newRateLimiter(cfg *subConfig) *rateLimiter {
+	l := &rateLimiter{
+		disable:       cfg.DisableRateLimit,
+		maxViolations: cfg.MaxRateViolations,
+		banTime:       cfg.RateBanTime,
+		peers:         make(map[string]*peerLimiter),
+	}
+	l.msgRates[limitTx] = float64(cfg.TxRateLimit)
+	l.msgRates[limitBlock] = float64(cfg.BlockRateLimit)
+	l.msgRates[limitQuery] = float64(cfg.QueryRateLimit)
+	l.byteRate = float64(cfg.ByteRateLimit) * 1024
+	//黑名单时间为0表示永久, 这里必须设置
+	if l.banTime <= 0 {
+		l.banTime = defaultRateBanTime
+	}
+	return l
+}
+
+func limitType(data *types.BroadCastData) int {
+	switch data.Value.(type) {
+	case *types.BroadCastData_Tx, *types.BroadCastData_LtTx:
+		return limitTx
+	case *types.BroadCastData_Block, *types.BroadCastData_LtBlock:
+		return limitBlock
+	default:
+		return limitQuery
+	}
+}
+
+// allow 检查节点是否超过限制, 超过限制的消息需要丢弃, 一段时间内超过限制次数过多时ban为true
+func (l *rateLimiter) allow(pid string, data *types.BroadCastData, now time.Time) (ok bool, ban bool) {
+	if l.disable || pid == "" {
+		return true, false
+	}
+	size := float64(types.Size(data))
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	peer, exist := l.peers[pid]
+	if !exist {
+		peer = &peerLimiter{windowStart: now.Unix()}
+		for ty, rate := range l.msgRates {
+			peer.msgBuckets[ty] = newTokenBucket(rate, 2*rate, now)
+		}
+		//字节桶至少能容纳一个最大的区块
+		burst := 2 * l.byteRate
+		if burst < float64(types.MaxBlockSize) {
+			burst = float64(types.MaxBlockSize)
+		}
+		peer.byteBucket = newTokenBucket(l.byteRate, burst, now)
+		l.peers[pid] = peer
+	}
+	if peer.msgBuckets[limitType(data)].allow(1, now) && peer.byteBucket.allow(size, now) {
+		return true, false
+	}
+	if now.Unix()-peer.windowStart >= rateViolationWindow {
+		peer.windowStart = now.Unix()
+		peer.violations = 0
+	}
+	peer.violations++
+	if l.maxViolations > 0 && peer.violations >= l.maxViolations {
+		delete(l.peers, pid)
+		return false, true
+	}
+	return false, false
+}
+
+func (l *rateLimiter) remove(pid string) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	delete(l.peers, pid)
+}
+
+// checkRecvLimit 处理接收的广播数据前检查限流, 超过限制时在地址簿中标记节点,
+// 多次超过限制的节点断开连接并加入黑名单
+func (n *Node) checkRecvLimit(data *types.BroadCastData, pid, peerAddr string) error {
+	ok, ban := n.limiter.allow(pid, data, types.Now())
+	if ok {
+		return nil
+	}
+	log.Debug("checkRecvLimit", "over rate limit, peerAddr", peerAddr, "pid", pid)
+	n.nodeInfo.addrBook.setAddrStat(peerAddr, false)
+	if !ban {
+		return errRateLimit
+	}
+	log.Error("checkRecvLimit", "ban peer", peerAddr, "pid", pid, "banTime", n.limiter.banTime)
+	n.nodeInfo.blacklist.Add(peerAddr, n.limiter.banTime)
+	//接入的连接只能通过ip拦截
+	if ip, _, err := net.SplitHostPort(peerAddr); err == nil {
+		n.nodeInfo.blacklist.Add(ip, n.limiter.banTime)
+	}
+	n.nodeInfo.addrBook.RemoveAddr(peerAddr)
+	n.remove(pid)
+	return errRateBanned
+}
@@ -0,0 +1,81 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gossip
+
+import (
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenBucket(t *testing.T) {
+	now := time.Unix(1000, 0)
+	bucket := newTokenBucket(10, 20, now)
+	for i := 0; i < 20; i++ {
+		assert.True(t, bucket.allow(1, now))
+	}
+	assert.False(t, bucket.allow(1, now))
+	now = now.Add(100 * time.Millisecond)
+	assert.True(t, bucket.allow(1, now))
+	assert.False(t, bucket.allow(1, now))
+	//超过容量的消息在桶满时允许通过
+	now = now.Add(10 * time.Second)
+	assert.True(t, bucket.allow(100, now))
+	assert.False(t, bucket.allow(1, now))
+	//不限制
+	bucket = newTokenBucket(0, 0, now)
+	assert.True(t, bucket.allow(100, now))
+}
+
+func TestRateLimiter(t *testing.T) {
+	limiter := newRateLimiter(&subConfig{TxRateLimit: 1, BlockRateLimit: 1, QueryRateLimit: 1, MaxRateViolations: 3})
+	now := time.Unix(1000, 0)
+	tx := &types.BroadCastData{Value: &types.BroadCastData_Tx{Tx: &types.P2PTx{Tx: &types.Transaction{}}}}
+	block := &types.BroadCastData{Value: &types.BroadCastData_Block{Block: &types.P2PBlock{Block: &types.Block{}}}}
+	query := &types.BroadCastData{Value: &types.BroadCastData_Query{Query: &types.P2PQueryData{}}}
+
+	//每种消息独立限流
+	for i := 0; i < 2; i++ {
+		for _, data := range []*types.BroadCastData{tx, block, query} {
+			ok, ban := limiter.allow("pid1", data, now)
+			assert.True(t, ok)
+			assert.False(t, ban)
+		}
+	}
+	ok, ban := limiter.allow("pid1", tx, now)
+	assert.False(t, ok)
+	assert.False(t, ban)
+	//其他节点不受影响
+	ok, _ = limiter.allow("pid2", tx, now)
+	assert.True(t, ok)
+
+	//超过窗口时间后重新计数
+	ok, ban = limiter.allow("pid1", tx, now)
+	assert.False(t, ok || ban)
+	now = now.Add(time.Duration(rateViolationWindow) * time.Second)
+	for i := 0; i < 2; i++ {
+		ok, _ = limiter.allow("pid1", tx, now)
+		assert.True(t, ok)
+	}
+	for i := 0; i < 2; i++ {
+		ok, ban = limiter.allow("pid1", tx, now)
+		assert.False(t, ok || ban)
+	}
+	ok, ban = limiter.allow("pid1", tx, now)
+	assert.False(t, ok)
+	assert.True(t, ban)
+	assert.Equal(t, 1, len(limiter.peers))
+	assert.Equal(t, int64(defaultRateBanTime), limiter.banTime)
+
+	limiter.remove("pid2")
+	assert.Equal(t, 0, len(limiter.peers))
+	limiter = newRateLimiter(&subConfig{DisableRateLimit: true, TxRateLimit: 1})
+	for i := 0; i < 10; i++ {
+		ok, _ = limiter.allow("pid1", tx, now)
+		assert.True(t, ok)
+	}
+}
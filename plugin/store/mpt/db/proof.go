@@ -38,6 +38,10 @@ import (
 // nodes of the longest existing prefix of the key (at least the root node), ending
 // with the node that proves the absence of the key.
 func (t *Trie) Prove(key []byte, fromLevel uint, proofDb dbm.DB) error {
+	return t.prove(key, fromLevel, func(hash, enc []byte) { proofDb.Set(hash, enc) })
+}
+
+func (t *Trie) prove(key []byte, fromLevel uint, write func(hash, enc []byte)) error {
 	// Collect all nodes on the path to key.
 	key = keybytesToHex(key)
 	nodes := []node{}
@@ -84,7 +88,7 @@ func (t *Trie) Prove(key []byte, fromLevel uint, proofDb dbm.DB) error {
 				if !ok {
 					hash = createHashNode(common.Sha3(enc))
 				}
-				proofDb.Set(hash.GetHash(), enc)
+				write(hash.GetHash(), enc)
 			}
 		}
 	}
@@ -106,10 +110,17 @@ func (t *SecureTrie) Prove(key []byte, fromLevel uint, proofDb dbm.DB) error {
 // key in a trie with the given root hash. VerifyProof returns an error if the
 // proof contains invalid trie nodes or the wrong value.
 func VerifyProof(rootHash common.Hash, key []byte, proofDb dbm.DB) (value []byte, nodes int, err error) {
+	return verifyProof(rootHash, key, func(hash []byte) []byte {
+		buf, _ := proofDb.Get(hash)
+		return buf
+	})
+}
+
+func verifyProof(rootHash common.Hash, key []byte, getNode func(hash []byte) []byte) (value []byte, nodes int, err error) {
 	key = keybytesToHex(key)
 	wantHash := rootHash
 	for i := 0; ; i++ {
-		buf, _ := proofDb.Get(wantHash[:])
+		buf := getNode(wantHash[:])
 		if buf == nil {
 			return nil, i, fmt.Errorf("proof node %d (hash %064x) missing", i, wantHash)
 		}
@@ -141,6 +152,9 @@ func get(tn node, key []byte) ([]byte, node) {
 			tn = n.Val
 			key = key[len(n.Key):]
 		case *fullNode:
+			if len(key) == 0 {
+				return nil, nil
+			}
 			tn = n.Children[key[0]]
 			key = key[1:]
 		case hashNode:
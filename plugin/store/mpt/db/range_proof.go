@@ -0,0 +1,346 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mpt
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/33cn/chain33/common"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	// ErrProofMismatch 证明中的键值对与状态树不一致
+	ErrProofMismatch = errors.New("ErrProofMismatch")
+	// ErrSecureRangeProof key经过sha3计算后不支持区间证明
+	ErrSecureRangeProof = errors.New("ErrSecureRangeProof")
+	// ErrRangeTruncated 区间内的键值对超过上限, 需要缩小区间分批获取
+	ErrRangeTruncated = errors.New("ErrRangeTruncated")
+)
+
+// MaxRangeProofKVs 一次区间证明最多包含的键值对数
+const MaxRangeProofKVs = 10000
+
+// RangeProof 状态树中[Start, End)区间的证明, Start为空表示从第一个key开始, End为空表示到最后一个key;
+// Proof包含区间涉及到的全部节点, 缺少任一节点都会导致验证失败, 所以KVs既不能伪造也不能遗漏
+type RangeProof struct {
+	Start []byte
+	End   []byte
+	KVs   []*types.KeyValue
+	Proof [][]byte
+}
+
+// ProveKey 构造key的证明, key不存在时证明包含能够说明key不存在的路径
+func (t *Trie) ProveKey(key []byte) ([][]byte, error) {
+	var proof [][]byte
+	if t.root == nil {
+		return proof, nil
+	}
+	err := t.prove(key, 0, func(hash, enc []byte) { proof = append(proof, enc) })
+	if err != nil {
+		return nil, err
+	}
+	return proof, nil
+}
+
+// ProveRange 构造[start, end)区间的证明, 返回区间内按key排序的全部键值对;
+// 键值对超过maxKVs时停止遍历并返回ErrRangeTruncated, maxKVs不大于0或者超过MaxRangeProofKVs时取MaxRangeProofKVs
+func (t *Trie) ProveRange(start, end []byte, maxKVs int) (*RangeProof, error) {
+	rp := &RangeProof{Start: start, End: end}
+	if t.root == nil {
+		return rp, nil
+	}
+	if maxKVs <= 0 || maxKVs > MaxRangeProofKVs {
+		maxKVs = MaxRangeProofKVs
+	}
+	r := newKeyRange(start, end)
+	hasher := newHasher(0, 0, nil)
+	defer returnHasherToPool(hasher)
+	err := t.proveRange(hasher, r, t.root, nil, true, maxKVs, rp)
+	if err != nil {
+		return nil, err
+	}
+	sortKVs(rp.KVs)
+	return rp, nil
+}
+
+func (t *Trie) proveRange(hasher *hasher, r *keyRange, tn node, path []byte, isRoot bool, maxKVs int, rp *RangeProof) error {
+	switch n := tn.(type) {
+	case nil:
+		return nil
+	case hashNode:
+		resolved, err := t.resolveHash(n, path)
+		if err != nil {
+			return err
+		}
+		return t.proveRange(hasher, r, resolved, path, false, maxKVs, rp)
+	case valueNode:
+		if err := r.collect(path, n, &rp.KVs); err != nil {
+			return err
+		}
+		if len(rp.KVs) > maxKVs {
+			return ErrRangeTruncated
+		}
+		return nil
+	case *shortNode:
+		rp.Proof = appendProofNode(hasher, rp.Proof, n, isRoot)
+		path = concat(path, n.Key...)
+		if !hasTerm(path) && !r.intersect(path) {
+			return nil
+		}
+		return t.proveRange(hasher, r, n.Val, path, false, maxKVs, rp)
+	case *fullNode:
+		rp.Proof = appendProofNode(hasher, rp.Proof, n, isRoot)
+		for i, child := range n.Children {
+			childPath := concat(path, byte(i))
+			if child == nil || (i < 16 && !r.intersect(childPath)) {
+				continue
+			}
+			if err := t.proveRange(hasher, r, child, childPath, false, maxKVs, rp); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("%T: invalid node: %v", tn, tn)
+	}
+}
+
+// appendProofNode 与Prove一致, 只有以hash形式被父节点引用的节点(以及根节点)需要放入证明
+func appendProofNode(hasher *hasher, proof [][]byte, n node, isRoot bool) [][]byte {
+	collapsed, _, _ := hasher.hashChildren(n, nil)
+	hn, _ := hasher.store(collapsed, nil, false)
+	if _, ok := hn.(hashNode); ok || isRoot {
+		proof = append(proof, types.Encode(collapsed.create()))
+	}
+	return proof
+}
+
+// VerifyKeyProof 无状态验证key的证明, 只依赖根哈希和证明本身;
+// key存在时返回对应的value, 返回的value为空表示证明了key不存在
+func VerifyKeyProof(rootHash common.Hash, key []byte, proof [][]byte) ([]byte, error) {
+	if rootHash == emptyRoot || rootHash == (common.Hash{}) {
+		return nil, nil
+	}
+	nodes := proofNodes(proof)
+	value, _, err := verifyProof(rootHash, key, func(hash []byte) []byte {
+		return nodes[common.BytesToHash(hash)]
+	})
+	return value, err
+}
+
+// VerifyRangeProof 无状态验证区间证明, 证明中的KVs必须恰好是状态树中[Start, End)区间内的全部键值对;
+// Start和End由证明方提供, 调用者需要确认与请求的区间一致
+func VerifyRangeProof(rootHash common.Hash, rp *RangeProof) error {
+	var kvs []*types.KeyValue
+	if rootHash != emptyRoot && rootHash != (common.Hash{}) {
+		v := &rangeVerifier{keyRange: newKeyRange(rp.Start, rp.End), nodes: proofNodes(rp.Proof)}
+		if err := v.walk(createHashNode(rootHash[:]), nil); err != nil {
+			return err
+		}
+		kvs = v.kvs
+		sortKVs(kvs)
+	}
+	if len(kvs) != len(rp.KVs) {
+		return ErrProofMismatch
+	}
+	for i, kv := range kvs {
+		if rp.KVs[i] == nil || !bytes.Equal(kv.Key, rp.KVs[i].Key) || !bytes.Equal(kv.Value, rp.KVs[i].Value) {
+			return ErrProofMismatch
+		}
+	}
+	return nil
+}
+
+type rangeVerifier struct {
+	*keyRange
+	nodes map[common.Hash][]byte
+	kvs   []*types.KeyValue
+}
+
+func (v *rangeVerifier) walk(tn node, path []byte) error {
+	switch n := tn.(type) {
+	case nil:
+		return nil
+	case hashNode:
+		hash := common.BytesToHash(n.GetHash())
+		buf, ok := v.nodes[hash]
+		if !ok {
+			return fmt.Errorf("proof node (hash %064x) missing", hash)
+		}
+		resolved, err := decodeNode(hash[:], buf, 0)
+		if err != nil {
+			return fmt.Errorf("bad proof node: %v", err)
+		}
+		return v.walk(resolved, path)
+	case valueNode:
+		return v.collect(path, n, &v.kvs)
+	case *shortNode:
+		path = concat(path, n.Key...)
+		if !hasTerm(path) && !v.intersect(path) {
+			return nil
+		}
+		return v.walk(n.Val, path)
+	case *fullNode:
+		for i, child := range n.Children {
+			childPath := concat(path, byte(i))
+			if child == nil || (i < 16 && !v.intersect(childPath)) {
+				continue
+			}
+			if err := v.walk(child, childPath); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("%T: invalid node: %v", tn, tn)
+	}
+}
+
+// keyRange 以半字节路径表示的key区间[start, end)
+type keyRange struct {
+	start, end       []byte
+	startHex, endHex []byte
+}
+
+func newKeyRange(start, end []byte) *keyRange {
+	r := &keyRange{start: start, end: end}
+	if start != nil {
+		r.startHex = keybytesToHex(start)
+		r.startHex = r.startHex[:len(r.startHex)-1]
+	}
+	if end != nil {
+		r.endHex = keybytesToHex(end)
+		r.endHex = r.endHex[:len(r.endHex)-1]
+	}
+	return r
+}
+
+// intersect 以path为前缀的key是否可能落在区间内, 宁可多判断为相交也不能遗漏
+func (r *keyRange) intersect(path []byte) bool {
+	if r.start != nil {
+		m := minLen(path, r.startHex)
+		if bytes.Compare(path[:m], r.startHex[:m]) < 0 {
+			return false
+		}
+	}
+	if r.end != nil {
+		m := minLen(path, r.endHex)
+		c := bytes.Compare(path[:m], r.endHex[:m])
+		// 以end为前缀的key都不小于end
+		if c > 0 || (c == 0 && len(path) >= len(r.endHex)) {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *keyRange) contains(key []byte) bool {
+	if r.start != nil && bytes.Compare(key, r.start) < 0 {
+		return false
+	}
+	return r.end == nil || bytes.Compare(key, r.end) < 0
+}
+
+// collect 叶子节点的path必须以终止符结尾并且是完整的字节
+func (r *keyRange) collect(path []byte, n valueNode, kvs *[]*types.KeyValue) error {
+	if !hasTerm(path) || len(path)%2 != 1 {
+		return fmt.Errorf("invalid value node path %x", path)
+	}
+	key := hexToKeybytes(path)
+	if r.contains(key) {
+		*kvs = append(*kvs, &types.KeyValue{Key: key, Value: common.CopyBytes(n.GetValue())})
+	}
+	return nil
+}
+
+func minLen(a, b []byte) int {
+	if len(a) < len(b) {
+		return len(a)
+	}
+	return len(b)
+}
+
+func sortKVs(kvs []*types.KeyValue) {
+	sort.Slice(kvs, func(i, j int) bool { return bytes.Compare(kvs[i].Key, kvs[j].Key) < 0 })
+}
+
+func proofNodes(proof [][]byte) map[common.Hash][]byte {
+	nodes := make(map[common.Hash][]byte, len(proof))
+	for _, enc := range proof {
+		nodes[common.BytesToHash(common.Sha3(enc))] = enc
+	}
+	return nodes
+}
+
+// prefixEnd 返回比所有以prefix开头的key都大的最小key, prefix全为0xff时返回nil
+func prefixEnd(prefix []byte) []byte {
+	end := common.CopyBytes(prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+	return nil
+}
+
+// GetKeyProof 获取statehash下key的证明, 可以用VerifyKVProof无状态验证
+func GetKeyProof(db dbm.DB, statehash []byte, key []byte) ([]byte, [][]byte, error) {
+	trie, err := NewEx(common.BytesToHash(statehash), NewDatabase(db))
+	if err != nil {
+		mptlog.Info("GetKeyProof can not find a trie")
+		return nil, nil, err
+	}
+	if enableSecure {
+		key = common.Sha3(key)
+	}
+	value, err := trie.Trie.TryGet(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	proof, err := trie.ProveKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	return value, proof, nil
+}
+
+// VerifyKVProof 验证statehash下key对应的value, value为空时验证key不存在
+func VerifyKVProof(statehash []byte, key, value []byte, proof [][]byte) error {
+	if enableSecure {
+		key = common.Sha3(key)
+	}
+	v, err := VerifyKeyProof(common.BytesToHash(statehash), key, proof)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(v, value) {
+		return ErrProofMismatch
+	}
+	return nil
+}
+
+// GetRangeProof 获取statehash下[start, end)区间内全部键值对及其证明, 键值对超过maxKVs时返回ErrRangeTruncated
+func GetRangeProof(db dbm.DB, statehash, start, end []byte, maxKVs int) (*RangeProof, error) {
+	if enableSecure {
+		return nil, ErrSecureRangeProof
+	}
+	trie, err := NewEx(common.BytesToHash(statehash), NewDatabase(db))
+	if err != nil {
+		mptlog.Info("GetRangeProof can not find a trie")
+		return nil, err
+	}
+	return trie.ProveRange(start, end, maxKVs)
+}
+
+// GetPrefixProof 获取statehash下以prefix开头的全部键值对及其证明, 键值对超过maxKVs时返回ErrRangeTruncated
+func GetPrefixProof(db dbm.DB, statehash, prefix []byte, maxKVs int) (*RangeProof, error) {
+	return GetRangeProof(db, statehash, prefix, prefixEnd(prefix), maxKVs)
+}
@@ -0,0 +1,245 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mpt
+
+import (
+	"bytes"
+	"fmt"
+	mrand "math/rand"
+	"sort"
+	"testing"
+
+	"github.com/33cn/chain33/common"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+)
+
+func TestKeyProof(t *testing.T) {
+	trie, vals := randomTrie(500)
+	root := trie.Hash()
+	for _, kv := range vals {
+		proof, err := trie.ProveKey(kv.k)
+		if err != nil {
+			t.Fatalf("failed to prove key %x: %v", kv.k, err)
+		}
+		val, err := VerifyKeyProof(root, kv.k, proof)
+		if err != nil {
+			t.Fatalf("failed to verify proof for key %x: %v", kv.k, err)
+		}
+		if !bytes.Equal(val, kv.v) {
+			t.Fatalf("verified value mismatch for key %x: have %x, want %x", kv.k, val, kv.v)
+		}
+	}
+	// 不存在的key
+	for i := 0; i < 100; i++ {
+		key := randBytes(32)
+		if _, ok := vals[string(key)]; ok {
+			continue
+		}
+		proof, err := trie.ProveKey(key)
+		if err != nil {
+			t.Fatalf("failed to prove missing key %x: %v", key, err)
+		}
+		val, err := VerifyKeyProof(root, key, proof)
+		if err != nil || val != nil {
+			t.Fatalf("expected absence of key %x, got value %x err %v", key, val, err)
+		}
+	}
+	// 去掉任何一个节点都无法验证
+	for _, kv := range vals {
+		proof, _ := trie.ProveKey(kv.k)
+		for i := range proof {
+			broken := append(append([][]byte{}, proof[:i]...), proof[i+1:]...)
+			if _, err := VerifyKeyProof(root, kv.k, broken); err == nil {
+				t.Fatalf("expected error for proof of key %x without node %d", kv.k, i)
+			}
+		}
+		break
+	}
+	val, err := VerifyKeyProof(emptyRoot, []byte("k"), nil)
+	if err != nil || val != nil {
+		t.Fatalf("expected empty trie contains nothing, got %x %v", val, err)
+	}
+}
+
+// rangeTestTrie 构造变长并且互为前缀的key, 与状态数据库中的key类似
+func rangeTestTrie() (*Trie, []*types.KeyValue) {
+	trie := newEmpty()
+	var kvs []*types.KeyValue
+	for i := 0; i < 300; i++ {
+		var key []byte
+		switch i % 3 {
+		case 0:
+			key = []byte(fmt.Sprintf("mavl-coins-bty-%d", i))
+		case 1:
+			key = []byte(fmt.Sprintf("mavl-coins-bty-exec-%d", i))
+		default:
+			key = randBytes(1 + mrand.Intn(8))
+		}
+		value := randBytes(1 + mrand.Intn(40))
+		trie.Update(key, value)
+		kvs = append(kvs, &types.KeyValue{Key: key, Value: value})
+	}
+	for _, key := range []string{"mavl-coins-bty-", "mavl-coins-bty-1", "mavl-coins-bty-10"} {
+		trie.Update([]byte(key), []byte(key))
+		kvs = append(kvs, &types.KeyValue{Key: []byte(key), Value: []byte(key)})
+	}
+	uniq := make(map[string]*types.KeyValue)
+	for _, kv := range kvs {
+		uniq[string(kv.Key)] = kv
+	}
+	kvs = kvs[:0]
+	for _, kv := range uniq {
+		kvs = append(kvs, kv)
+	}
+	sortKVs(kvs)
+	return trie, kvs
+}
+
+func expectedRange(kvs []*types.KeyValue, start, end []byte) []*types.KeyValue {
+	var result []*types.KeyValue
+	r := newKeyRange(start, end)
+	for _, kv := range kvs {
+		if r.contains(kv.Key) {
+			result = append(result, kv)
+		}
+	}
+	return result
+}
+
+func TestRangeProof(t *testing.T) {
+	trie, kvs := rangeTestTrie()
+	root := trie.Hash()
+	ranges := [][2][]byte{
+		{nil, nil},
+		{[]byte("mavl-coins-bty-"), prefixEnd([]byte("mavl-coins-bty-"))},
+		{[]byte("mavl-coins-bty-1"), []byte("mavl-coins-bty-2")},
+		{[]byte("mavl-coins-bty-10"), []byte("mavl-coins-bty-100")},
+		{nil, []byte("mavl")},
+		{[]byte("mavl-coins-bty-exec-"), nil},
+		{[]byte("zzz"), []byte("zzz")},
+	}
+	for i := 0; i < 50; i++ {
+		a, b := kvs[mrand.Intn(len(kvs))].Key, kvs[mrand.Intn(len(kvs))].Key
+		if bytes.Compare(a, b) > 0 {
+			a, b = b, a
+		}
+		ranges = append(ranges, [2][]byte{a, b}, [2][]byte{a, randBytes(3)})
+	}
+	for _, r := range ranges {
+		rp, err := trie.ProveRange(r[0], r[1], 0)
+		if err != nil {
+			t.Fatalf("failed to prove range [%x, %x): %v", r[0], r[1], err)
+		}
+		expected := expectedRange(kvs, r[0], r[1])
+		if len(rp.KVs) != len(expected) {
+			t.Fatalf("range [%x, %x) have %d kvs, want %d", r[0], r[1], len(rp.KVs), len(expected))
+		}
+		for i := range expected {
+			if !bytes.Equal(rp.KVs[i].Key, expected[i].Key) || !bytes.Equal(rp.KVs[i].Value, expected[i].Value) {
+				t.Fatalf("range [%x, %x) kv %d mismatch", r[0], r[1], i)
+			}
+		}
+		if err := VerifyRangeProof(root, rp); err != nil {
+			t.Fatalf("failed to verify range [%x, %x): %v", r[0], r[1], err)
+		}
+		if len(rp.KVs) == 0 {
+			continue
+		}
+		// 遗漏键值对
+		omitted := *rp
+		omitted.KVs = rp.KVs[1:]
+		if err := VerifyRangeProof(root, &omitted); err != ErrProofMismatch {
+			t.Fatalf("expected omitted kv detected in range [%x, %x), got %v", r[0], r[1], err)
+		}
+		// 篡改value
+		forged := *rp
+		forged.KVs = append([]*types.KeyValue{{Key: rp.KVs[0].Key, Value: []byte("forged")}}, rp.KVs[1:]...)
+		if err := VerifyRangeProof(root, &forged); err != ErrProofMismatch {
+			t.Fatalf("expected forged kv detected in range [%x, %x), got %v", r[0], r[1], err)
+		}
+		// 缺少节点
+		if len(rp.Proof) > 1 {
+			missing := *rp
+			missing.Proof = rp.Proof[:len(rp.Proof)-1]
+			if err := VerifyRangeProof(root, &missing); err == nil {
+				t.Fatalf("expected missing node detected in range [%x, %x)", r[0], r[1])
+			}
+		}
+	}
+	// 遍历整个状态树时同样受上限约束
+	if _, err := trie.ProveRange(nil, nil, len(kvs)-1); err != ErrRangeTruncated {
+		t.Fatalf("expected truncated whole trie, got %v", err)
+	}
+}
+
+func TestStoreProof(t *testing.T) {
+	db, _ := dbm.NewGoMemDB("gomemdb", "", 128)
+	_, kvs := rangeTestTrie()
+	hash, err := SetKVPair(db, &types.StoreSet{StateHash: emptyRoot[:], KV: kvs}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, kv := range kvs[:20] {
+		value, proof, err := GetKeyProof(db, hash, kv.Key)
+		if err != nil || !bytes.Equal(value, kv.Value) {
+			t.Fatalf("GetKeyProof %s: have %x %v, want %x", kv.Key, value, err, kv.Value)
+		}
+		if err := VerifyKVProof(hash, kv.Key, kv.Value, proof); err != nil {
+			t.Fatalf("VerifyKVProof %s: %v", kv.Key, err)
+		}
+		if err := VerifyKVProof(hash, kv.Key, []byte("forged"), proof); err != ErrProofMismatch {
+			t.Fatalf("VerifyKVProof forged %s: %v", kv.Key, err)
+		}
+	}
+	value, proof, err := GetKeyProof(db, hash, []byte("mavl-coins-bty-missing"))
+	if err != nil || value != nil {
+		t.Fatalf("GetKeyProof missing key: %x %v", value, err)
+	}
+	if err := VerifyKVProof(hash, []byte("mavl-coins-bty-missing"), nil, proof); err != nil {
+		t.Fatalf("VerifyKVProof missing key: %v", err)
+	}
+
+	prefix := []byte("mavl-coins-bty-exec-")
+	rp, err := GetPrefixProof(db, hash, prefix, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var keys []string
+	for _, kv := range rp.KVs {
+		if !bytes.HasPrefix(kv.Key, prefix) {
+			t.Fatalf("unexpected key %s with prefix %s", kv.Key, prefix)
+		}
+		keys = append(keys, string(kv.Key))
+	}
+	if len(keys) != 100 || !sort.StringsAreSorted(keys) {
+		t.Fatalf("have %d keys with prefix %s, want 100 sorted", len(keys), prefix)
+	}
+	if err := VerifyRangeProof(common.BytesToHash(hash), rp); err != nil {
+		t.Fatalf("VerifyRangeProof prefix %s: %v", prefix, err)
+	}
+	// 键值对超过上限
+	if _, err := GetPrefixProof(db, hash, prefix, 99); err != ErrRangeTruncated {
+		t.Fatalf("expected truncated prefix %s, got %v", prefix, err)
+	}
+	if rp, err := GetPrefixProof(db, hash, prefix, 100); err != nil || len(rp.KVs) != 100 {
+		t.Fatalf("GetPrefixProof with 100 kvs limit: %v", err)
+	}
+}
+
+func TestPrefixEnd(t *testing.T) {
+	cases := []struct{ prefix, end []byte }{
+		{[]byte("abc"), []byte("abd")},
+		{[]byte{0x01, 0xff}, []byte{0x02}},
+		{[]byte{0xff, 0xff}, nil},
+		{[]byte{}, nil},
+	}
+	for _, c := range cases {
+		if end := prefixEnd(c.prefix); !bytes.Equal(end, c.end) {
+			t.Errorf("prefixEnd(%x) = %x, want %x", c.prefix, end, c.end)
+		}
+	}
+}
@@ -169,10 +169,46 @@ func (mpts *Store) IterateRangeByStateHash(statehash []byte, start []byte, end [
 	mpt.IterateRangeByStateHash(mpts.GetDB(), statehash, start, end, ascending, fn)
 }
 
-// ProcEvent not support message
+// GetKeyProof 获取statehash下key的value及其证明, value为空时证明key不存在, 可以用mpt.VerifyKVProof无状态验证
+func (mpts *Store) GetKeyProof(statehash []byte, key []byte) ([]byte, [][]byte, error) {
+	return mpt.GetKeyProof(mpts.GetDB(), statehash, key)
+}
+
+// GetRangeProof 获取statehash下[start, end)区间内全部键值对及其证明, 可以用mpt.VerifyRangeProof无状态验证;
+// 键值对超过maxKVs时返回mpt.ErrRangeTruncated
+func (mpts *Store) GetRangeProof(statehash []byte, start []byte, end []byte, maxKVs int) (*mpt.RangeProof, error) {
+	return mpt.GetRangeProof(mpts.GetDB(), statehash, start, end, maxKVs)
+}
+
+// GetPrefixProof 获取statehash下以prefix开头的全部键值对及其证明
+func (mpts *Store) GetPrefixProof(statehash []byte, prefix []byte, maxKVs int) (*mpt.RangeProof, error) {
+	return mpt.GetPrefixProof(mpts.GetDB(), statehash, prefix, maxKVs)
+}
+
+// ProcEvent 处理区间证明的查询, 其他消息不支持
 func (mpts *Store) ProcEvent(msg *queue.Message) {
 	if msg == nil {
 		return
 	}
-	msg.ReplyErr("Store", types.ErrActionNotSupport)
+	if msg.Ty != EventStoreGetRangeProof {
+		msg.ReplyErr("Store", types.ErrActionNotSupport)
+		return
+	}
+	req, ok := msg.GetData().(*ReqRangeProof)
+	if !ok {
+		msg.ReplyErr("Store", types.ErrInvalidParam)
+		return
+	}
+	var rp *mpt.RangeProof
+	var err error
+	if req.Prefix != nil {
+		rp, err = mpts.GetPrefixProof(req.StateHash, req.Prefix, int(req.MaxKVs))
+	} else {
+		rp, err = mpts.GetRangeProof(req.StateHash, req.Start, req.End, int(req.MaxKVs))
+	}
+	if err != nil {
+		msg.ReplyErr("Store", err)
+		return
+	}
+	msg.Reply(mpts.GetQueueClient().NewMessage("", EventStoreGetRangeProof, rp))
 }
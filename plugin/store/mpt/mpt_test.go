@@ -13,8 +13,10 @@ import (
 	"time"
 
 	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/queue"
 	drivers "github.com/33cn/chain33/system/store"
 	"github.com/33cn/chain33/types"
+	mpt "github.com/33cn/plugin/plugin/store/mpt/db"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -319,3 +321,60 @@ func BenchmarkCommit(b *testing.B) {
 	fmt.Println("mpt BenchmarkCommit cost time is", end.Sub(start), "num is", b.N)
 	b.StopTimer()
 }
+
+func TestKvdbProof(t *testing.T) {
+	dir, err := ioutil.TempDir("", "example")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir) // clean up
+	var storeCfg = newStoreCfg(dir)
+	store := New(storeCfg, nil, nil).(*Store)
+	assert.NotNil(t, store)
+	defer store.Close()
+
+	var kv []*types.KeyValue
+	kv = append(kv, &types.KeyValue{Key: []byte("mavl-coins-bty-k1"), Value: []byte("v1")})
+	kv = append(kv, &types.KeyValue{Key: []byte("mavl-coins-bty-k2"), Value: []byte("v2")})
+	kv = append(kv, &types.KeyValue{Key: []byte("mavl-token-k3"), Value: []byte("v3")})
+	datas := &types.StoreSet{
+		StateHash: drivers.EmptyRoot[:],
+		KV:        kv,
+		Height:    0}
+	hash, err := store.Set(datas, true)
+	assert.Nil(t, err)
+
+	value, proof, err := store.GetKeyProof(hash, []byte("mavl-coins-bty-k1"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("v1"), value)
+	assert.Nil(t, mpt.VerifyKVProof(hash, []byte("mavl-coins-bty-k1"), []byte("v1"), proof))
+	assert.Equal(t, mpt.ErrProofMismatch, mpt.VerifyKVProof(hash, []byte("mavl-coins-bty-k1"), []byte("v2"), proof))
+
+	value, proof, err = store.GetKeyProof(hash, []byte("mavl-coins-bty-k4"))
+	assert.Nil(t, err)
+	assert.Nil(t, value)
+	assert.Nil(t, mpt.VerifyKVProof(hash, []byte("mavl-coins-bty-k4"), nil, proof))
+
+	rp, err := store.GetPrefixProof(hash, []byte("mavl-coins-bty-"), 0)
+	assert.Nil(t, err)
+	assert.Equal(t, kv[:2], rp.KVs)
+	assert.Nil(t, mpt.VerifyRangeProof(common.BytesToHash(hash), rp))
+
+	rp, err = store.GetRangeProof(hash, []byte("mavl-coins-bty-k2"), nil, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, kv[1:], rp.KVs)
+	assert.Nil(t, mpt.VerifyRangeProof(common.BytesToHash(hash), rp))
+
+	// 通过消息队列查询区间证明
+	q := queue.New("channel")
+	defer q.Close()
+	store.SetQueueClient(q.Client())
+	client := q.Client()
+	rp, err = GetRangeProof(client, &ReqRangeProof{StateHash: hash, Prefix: []byte("mavl-coins-bty-")})
+	assert.Nil(t, err)
+	assert.Equal(t, kv[:2], rp.KVs)
+	assert.Nil(t, mpt.VerifyRangeProof(common.BytesToHash(hash), rp))
+	rp, err = GetRangeProof(client, &ReqRangeProof{StateHash: hash, Start: []byte("mavl-coins-bty-k2")})
+	assert.Nil(t, err)
+	assert.Equal(t, kv[1:], rp.KVs)
+	_, err = GetRangeProof(client, &ReqRangeProof{StateHash: hash, MaxKVs: 2})
+	assert.EqualError(t, err, mpt.ErrRangeTruncated.Error())
+}
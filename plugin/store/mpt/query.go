@@ -0,0 +1,43 @@
+package mpt
+
+import (
+	"errors"
+
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/types"
+	mpt "github.com/33cn/plugin/plugin/store/mpt/db"
+)
+
+// EventStoreGetRangeProof 查询statehash下区间内的键值对及其证明, 只有mpt store处理
+const EventStoreGetRangeProof = 3101
+
+// ReqRangeProof 区间证明请求, Prefix不为空时查询以Prefix开头的键值对, 否则查询[Start, End);
+// MaxKVs为0时使用mpt.MaxRangeProofKVs, 键值对超过上限时返回mpt.ErrRangeTruncated
+type ReqRangeProof struct {
+	StateHash []byte
+	Start     []byte
+	End       []byte
+	Prefix    []byte
+	MaxKVs    int32
+}
+
+// GetRangeProof 通过消息队列查询区间证明, 返回的证明可以用mpt.VerifyRangeProof无状态验证
+func GetRangeProof(client queue.Client, req *ReqRangeProof) (*mpt.RangeProof, error) {
+	msg := client.NewMessage("store", EventStoreGetRangeProof, req)
+	err := client.Send(msg, true)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Wait(msg)
+	if err != nil {
+		return nil, err
+	}
+	switch reply := resp.GetData().(type) {
+	case *mpt.RangeProof:
+		return reply, nil
+	case *types.Reply:
+		return nil, errors.New(string(reply.GetMsg()))
+	default:
+		return nil, types.ErrTypeAsset
+	}
+}